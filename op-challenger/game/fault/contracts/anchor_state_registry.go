@@ -0,0 +1,49 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	methodAnchors = "anchors"
+)
+
+type AnchorStateRegistryContract struct {
+	metrics     metrics.ContractMetricer
+	multiCaller *batching.MultiCaller
+	contract    *batching.BoundContract
+}
+
+func NewAnchorStateRegistryContract(metrics metrics.ContractMetricer, addr common.Address, caller *batching.MultiCaller) *AnchorStateRegistryContract {
+	contractAbi := snapshots.LoadAnchorStateRegistryABI()
+	return &AnchorStateRegistryContract{
+		metrics:     metrics,
+		multiCaller: caller,
+		contract:    batching.NewBoundContract(contractAbi, addr),
+	}
+}
+
+func (a *AnchorStateRegistryContract) Addr() common.Address {
+	return a.contract.Addr()
+}
+
+// GetAnchorRoot returns the current anchor root and the L2 block number it commits to for games of gameType.
+func (a *AnchorStateRegistryContract) GetAnchorRoot(ctx context.Context, block rpcblock.Block, gameType uint32) (common.Hash, uint64, error) {
+	defer a.metrics.StartContractRequest("GetAnchorRoot")()
+	result, err := a.multiCaller.SingleCall(ctx, block, a.contract.Call(methodAnchors, gameType))
+	if err != nil {
+		return common.Hash{}, 0, fmt.Errorf("failed to retrieve anchor root: %w", err)
+	}
+	blockNum := result.GetBigInt(1)
+	if !blockNum.IsUint64() {
+		return common.Hash{}, 0, fmt.Errorf("anchor block number too big for uint64 %v", blockNum)
+	}
+	return result.GetHash(0), blockNum.Uint64(), nil
+}
@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	batchingTest "github.com/ethereum-optimism/optimism/op-service/sources/batching/test"
+	"github.com/ethereum-optimism/optimism/packages/contracts-bedrock/snapshots"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	anchorStateRegistry = common.HexToAddress("0x1F0E7F8bF4e0F6b0E4a9A8Fbb3D7eCDd1A0b5C64")
+)
+
+func TestAnchorStateRegistry_GetAnchorRoot(t *testing.T) {
+	stubRpc, registry := setupAnchorStateRegistryTest(t)
+	block := rpcblock.ByNumber(482)
+	gameType := uint32(1)
+	root := common.Hash{0xaa}
+	blockNum := uint64(2983294824)
+
+	stubRpc.SetResponse(anchorStateRegistry, methodAnchors, block, []interface{}{gameType}, []interface{}{root, new(big.Int).SetUint64(blockNum)})
+
+	actualRoot, actualBlockNum, err := registry.GetAnchorRoot(context.Background(), block, gameType)
+	require.NoError(t, err)
+	require.Equal(t, root, actualRoot)
+	require.Equal(t, blockNum, actualBlockNum)
+}
+
+func setupAnchorStateRegistryTest(t *testing.T) (*batchingTest.AbiBasedRpc, *AnchorStateRegistryContract) {
+	registryAbi := snapshots.LoadAnchorStateRegistryABI()
+	stubRpc := batchingTest.NewAbiBasedRpc(t, anchorStateRegistry, registryAbi)
+	caller := batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize)
+	registry := NewAnchorStateRegistryContract(contractMetrics.NoopContractMetrics, anchorStateRegistry, caller)
+	return stubRpc, registry
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	monitor "github.com/ethereum-optimism/optimism/op-dispute-mon"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/flags"
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+)
+
+func ExportGames(ctx *cli.Context) (err error) {
+	logger, err := setupLogging(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, err := flags.NewConfigFromCLI(ctx)
+	if err != nil {
+		return err
+	}
+	var out io.Writer = os.Stdout
+	if path := ctx.String(flags.ExportOutputFlag.Name); path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if closeErr := file.Close(); closeErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to close output file: %w", closeErr))
+			}
+		}()
+		out = file
+	}
+	return monitor.ExportGames(ctx.Context, logger, cfg, out)
+}
+
+var ExportGamesCommand = &cli.Command{
+	Name:        "export-games",
+	Usage:       "Export the games in the game window and their classification as CSV",
+	Description: "Runs a single monitoring pass and writes the address, L2 block, root claim, status and agreement of each game as CSV",
+	Action:      ExportGames,
+	Flags:       cliapp.ProtectFlags(append([]cli.Flag{flags.ExportOutputFlag}, flags.Flags...)),
+}
@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
 	"github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum/go-ethereum/common"
@@ -160,6 +165,25 @@ func TestMonitorInterval(t *testing.T) {
 	})
 }
 
+func TestMonitorIntervalJitter(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.MonitorIntervalJitter)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--monitor-interval-jitter", "0.1"))
+		require.Equal(t, 0.1, cfg.MonitorIntervalJitter)
+	})
+
+	t.Run("TooHigh", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"monitor interval jitter must be at least 0 and less than 1",
+			addRequiredArgs("--monitor-interval-jitter", "1"))
+	})
+}
+
 func TestGameWindow(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
@@ -177,6 +201,29 @@ func TestGameWindow(t *testing.T) {
 	})
 }
 
+func TestAdditionalGameFactories(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.AdditionalGameFactories)
+	})
+
+	t.Run("MultiValue", func(t *testing.T) {
+		addr1 := common.Address{0xaa}
+		addr2 := common.Address{0xbb}
+		cfg := configForArgs(t, addRequiredArgs(
+			"--additional-game-factory-addresses", addr1.Hex(),
+			"--additional-game-factory-addresses", addr2.Hex(),
+		))
+		require.Equal(t, []common.Address{addr1, addr2}, cfg.AdditionalGameFactories)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid additional game factory address: invalid address: 0xnope",
+			addRequiredArgs("--additional-game-factory-addresses", "0xnope"))
+	})
+}
+
 func TestIgnoredGames(t *testing.T) {
 	t.Run("NotRequired", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
@@ -212,6 +259,52 @@ func TestIgnoredGames(t *testing.T) {
 	})
 }
 
+func TestTrustedProposers(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.TrustedProposers)
+	})
+
+	t.Run("MultiValue", func(t *testing.T) {
+		addr1 := common.Address{0xaa}
+		addr2 := common.Address{0xbb}
+		cfg := configForArgs(t, addRequiredArgs(
+			"--trusted-proposers", addr1.Hex(),
+			"--trusted-proposers", addr2.Hex(),
+		))
+		require.Equal(t, []common.Address{addr1, addr2}, cfg.TrustedProposers)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid trusted proposer address: invalid address: 0xnope",
+			addRequiredArgs("--trusted-proposers", "0xnope"))
+	})
+}
+
+func TestBenignGames(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.BenignGames)
+	})
+
+	t.Run("MultiValue", func(t *testing.T) {
+		addr1 := common.Address{0xaa}
+		addr2 := common.Address{0xbb}
+		cfg := configForArgs(t, addRequiredArgs(
+			"--benign-games", addr1.Hex(),
+			"--benign-games", addr2.Hex(),
+		))
+		require.Equal(t, []common.Address{addr1, addr2}, cfg.BenignGames)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid benign game address: invalid address: 0xnope",
+			addRequiredArgs("--benign-games", "0xnope"))
+	})
+}
+
 func TestMaxConcurrency(t *testing.T) {
 	t.Run("Valid", func(t *testing.T) {
 		expected := uint(345)
@@ -234,6 +327,924 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestGameStatuses(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.GameStatuses)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--game-statuses", "in-progress"))
+		require.Equal(t, []gameTypes.GameStatus{gameTypes.GameStatusInProgress}, cfg.GameStatuses)
+	})
+
+	t.Run("ValidCommaSeparated", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--game-statuses", "challenger-won,defender-won"))
+		require.Equal(t, []gameTypes.GameStatus{gameTypes.GameStatusChallengerWon, gameTypes.GameStatusDefenderWon}, cfg.GameStatuses)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid game status: resolved",
+			addRequiredArgs("--game-statuses", "resolved"))
+	})
+}
+
+func TestGameTypes(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.GameTypes)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--game-types", "0", "--game-types", "1"))
+		require.Equal(t, []uint32{0, 1}, cfg.GameTypes)
+	})
+
+	t.Run("ValidCommaSeparated", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--game-types", "0,254"))
+		require.Equal(t, []uint32{0, 254}, cfg.GameTypes)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -game-types",
+			addRequiredArgs("--game-types", "abc"))
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"4294967296\" for flag -game-types",
+			addRequiredArgs("--game-types", "4294967296"))
+	})
+}
+
+func TestMinBond(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.MinBond)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--min-bond", "80000000000000000000"))
+		expected, _ := new(big.Int).SetString("80000000000000000000", 10)
+		require.Equal(t, expected, cfg.MinBond)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid min bond: abc",
+			addRequiredArgs("--min-bond", "abc"))
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"min bond must not be negative",
+			addRequiredArgs("--min-bond", "-1"))
+	})
+}
+
+func TestSampleRate(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultSampleRate, cfg.SampleRate)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--sample-rate", "0.25"))
+		require.Equal(t, 0.25, cfg.SampleRate)
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"sample rate must be greater than 0 and at most 1",
+			addRequiredArgs("--sample-rate", "0"))
+	})
+
+	t.Run("TooHigh", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"sample rate must be greater than 0 and at most 1",
+			addRequiredArgs("--sample-rate", "1.5"))
+	})
+}
+
+func TestDisagreementSmoothing(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultDisagreementSmoothing, cfg.DisagreementSmoothing)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--disagreement-smoothing", "0.25"))
+		require.Equal(t, 0.25, cfg.DisagreementSmoothing)
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"disagreement rate smoothing must be greater than 0 and at most 1",
+			addRequiredArgs("--disagreement-smoothing", "0"))
+	})
+}
+
+func TestChainRollupRpcs(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.ChainRollupRpcs)
+	})
+
+	t.Run("MultiValue", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--chain-rollup-rpcs", "10=http://chain-a",
+			"--chain-rollup-rpcs", "20=http://chain-b",
+		))
+		require.Equal(t, map[uint64]string{10: "http://chain-a", 20: "http://chain-b"}, cfg.ChainRollupRpcs)
+	})
+
+	t.Run("MissingURL", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid chain rollup rpc, expected <chain-id>=<url>: 10",
+			addRequiredArgs("--chain-rollup-rpcs", "10"))
+	})
+
+	t.Run("ZeroChainID", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid chain rollup rpc: l2 chain id must not be zero",
+			addRequiredArgs("--chain-rollup-rpcs", "0=http://chain-a"))
+	})
+}
+
+func TestGameFactoryChainIDs(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.GameFactoryChainIDs)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		factory := common.Address{0xfa}
+		cfg := configForArgs(t, addRequiredArgs("--game-factory-chain-ids", factory.Hex()+"=10"))
+		require.Equal(t, map[common.Address]uint64{factory: 10}, cfg.GameFactoryChainIDs)
+	})
+
+	t.Run("InvalidFactory", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid game factory chain id: invalid address: 0xnope",
+			addRequiredArgs("--game-factory-chain-ids", "0xnope=10"))
+	})
+
+	t.Run("InvalidChainID", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid game factory chain id: invalid l2 chain id abc",
+			addRequiredArgs("--game-factory-chain-ids", common.Address{0xfa}.Hex()+"=abc"))
+	})
+}
+
+func TestChainOutputDomains(t *testing.T) {
+	domain := common.Hash{0xdd}
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.ChainOutputDomains)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--chain-rollup-rpcs", "10=http://chain-a",
+			"--chain-output-domains", "10="+domain.Hex(),
+		))
+		require.Equal(t, map[uint64]common.Hash{10: domain}, cfg.ChainOutputDomains)
+	})
+
+	t.Run("InvalidDomain", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid chain output domain: invalid hash: 0xdd",
+			addRequiredArgs(
+				"--chain-rollup-rpcs", "10=http://chain-a",
+				"--chain-output-domains", "10=0xdd",
+			))
+	})
+
+	t.Run("UnknownChain", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid chain output domain: no chain-rollup-rpcs configured for l2 chain 10",
+			addRequiredArgs("--chain-output-domains", "10="+domain.Hex()))
+	})
+}
+
+func TestArchiveRollupRpc(t *testing.T) {
+	t.Run("NotRequired", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.ArchiveRollupRpc)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		url := "http://archive:8545"
+		cfg := configForArgs(t, addRequiredArgs("--archive-rollup-rpc", url))
+		require.Equal(t, url, cfg.ArchiveRollupRpc)
+	})
+}
+
+func TestRollupRpcRateLimit(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.RollupRpcRateLimit)
+		require.Equal(t, config.DefaultRollupRpcRateBurst, cfg.RollupRpcRateBurst)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--rollup-rpc-rate-limit", "2.5", "--rollup-rpc-rate-burst", "4"))
+		require.Equal(t, 2.5, cfg.RollupRpcRateLimit)
+		require.Equal(t, uint(4), cfg.RollupRpcRateBurst)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -rollup-rpc-rate-limit",
+			addRequiredArgs("--rollup-rpc-rate-limit", "abc"))
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"rollup rpc rate limit must not be negative",
+			addRequiredArgs("--rollup-rpc-rate-limit", "-1"))
+	})
+
+	t.Run("ZeroBurst", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"rollup-rpc-rate-burst must not be 0 when rollup-rpc-rate-limit is set",
+			addRequiredArgs("--rollup-rpc-rate-limit", "1", "--rollup-rpc-rate-burst", "0"))
+	})
+}
+
+func TestMaxDeferredCycles(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultMaxDeferredCycles, cfg.MaxDeferredCycles)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-deferred-cycles", "25"))
+		require.Equal(t, uint(25), cfg.MaxDeferredCycles)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -max-deferred-cycles",
+			addRequiredArgs("--max-deferred-cycles", "abc"))
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"max-deferred-cycles must not be 0",
+			addRequiredArgs("--max-deferred-cycles", "0"))
+	})
+}
+
+func TestOutputForkBlock(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.OutputForkBlock)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--output-fork-block", "1234"))
+		require.NotNil(t, cfg.OutputForkBlock)
+		require.Equal(t, uint64(1234), *cfg.OutputForkBlock)
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--output-fork-block", "0"))
+		require.NotNil(t, cfg.OutputForkBlock)
+		require.Zero(t, *cfg.OutputForkBlock)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -output-fork-block",
+			addRequiredArgs("--output-fork-block", "abc"))
+	})
+}
+
+func TestPinnedL1Block(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.PinnedL1Block)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--pinned-l1-block", "1234"))
+		require.NotNil(t, cfg.PinnedL1Block)
+		require.Equal(t, uint64(1234), *cfg.PinnedL1Block)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -pinned-l1-block",
+			addRequiredArgs("--pinned-l1-block", "abc"))
+	})
+}
+
+func TestMaxL2BlockNumber(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.MaxL2BlockNumber)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-l2-block-number", "1000000000"))
+		require.Equal(t, uint64(1_000_000_000), cfg.MaxL2BlockNumber)
+	})
+}
+
+func TestAgreementHead(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultAgreementHead, cfg.AgreementHead)
+	})
+
+	for _, head := range types.AgreementHeads {
+		head := head
+		t.Run(fmt.Sprintf("Valid-%v", head), func(t *testing.T) {
+			cfg := configForArgs(t, addRequiredArgs("--agreement-head", head.String()))
+			require.Equal(t, head, cfg.AgreementHead)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"unknown agreement head: \"unsafe\"",
+			addRequiredArgs("--agreement-head", "unsafe"))
+	})
+}
+
+func TestGameGrouping(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultGameGrouping, cfg.GameGrouping)
+	})
+
+	for _, grouping := range types.GameGroupings {
+		grouping := grouping
+		t.Run(fmt.Sprintf("Valid-%v", grouping), func(t *testing.T) {
+			cfg := configForArgs(t, addRequiredArgs("--game-grouping", grouping.String()))
+			require.Equal(t, grouping, cfg.GameGrouping)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"unknown game grouping: \"color\"",
+			addRequiredArgs("--game-grouping", "color"))
+	})
+}
+
+func TestMetadataTimeout(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultMetadataTimeout, cfg.MetadataTimeout)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--metadata-timeout=10s"))
+		require.Equal(t, 10*time.Second, cfg.MetadataTimeout)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--metadata-timeout=0"))
+		require.Zero(t, cfg.MetadataTimeout)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -metadata-timeout",
+			addRequiredArgs("--metadata-timeout", "abc"))
+	})
+}
+
+func TestComparisonTimeout(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultComparisonTimeout, cfg.ComparisonTimeout)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--comparison-timeout=10s"))
+		require.Equal(t, 10*time.Second, cfg.ComparisonTimeout)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--comparison-timeout=0"))
+		require.Zero(t, cfg.ComparisonTimeout)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -comparison-timeout",
+			addRequiredArgs("--comparison-timeout", "abc"))
+	})
+}
+
+func TestClockSkewTolerance(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultClockSkewTolerance, cfg.ClockSkewTolerance)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--clock-skew-tolerance=2m"))
+		require.Equal(t, 2*time.Minute, cfg.ClockSkewTolerance)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -clock-skew-tolerance",
+			addRequiredArgs("--clock-skew-tolerance", "abc"))
+	})
+}
+
+func TestCycleDeadline(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.CycleDeadline)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--cycle-deadline=5m"))
+		require.Equal(t, 5*time.Minute, cfg.CycleDeadline)
+	})
+}
+
+func TestShutdownGracePeriod(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, config.DefaultShutdownGracePeriod, cfg.ShutdownGracePeriod)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--shutdown-grace-period=10s"))
+		require.Equal(t, 10*time.Second, cfg.ShutdownGracePeriod)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -shutdown-grace-period",
+			addRequiredArgs("--shutdown-grace-period", "abc"))
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.BackfillWindow)
+		require.Equal(t, config.DefaultBackfillInterval, cfg.BackfillInterval)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--game-window=24h", "--backfill-window=720h", "--backfill-interval=2s"))
+		require.Equal(t, 720*time.Hour, cfg.BackfillWindow)
+		require.Equal(t, 2*time.Second, cfg.BackfillInterval)
+	})
+
+	t.Run("WindowNotLongerThanGameWindow", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"backfill-window must be longer than game-window",
+			addRequiredArgs("--game-window=24h", "--backfill-window=24h"))
+	})
+
+	t.Run("ZeroInterval", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"backfill-interval must not be 0 when backfill-window is set",
+			addRequiredArgs("--game-window=24h", "--backfill-window=48h", "--backfill-interval=0s"))
+	})
+}
+
+func TestStartupGraceCycles(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.StartupGraceCycles)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--startup-grace-cycles=2"))
+		require.Equal(t, uint(2), cfg.StartupGraceCycles)
+	})
+}
+
+func TestErrorRateThreshold(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.ErrorRateThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--error-rate-threshold", "0.2"))
+		require.Equal(t, 0.2, cfg.ErrorRateThreshold)
+	})
+
+	t.Run("TooHigh", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"error rate threshold must be between 0 and 1",
+			addRequiredArgs("--error-rate-threshold", "1.5"))
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.CircuitBreakerThreshold)
+		require.Equal(t, config.DefaultCircuitBreakerCooldown, cfg.CircuitBreakerCooldown)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--circuit-breaker-threshold=5", "--circuit-breaker-cooldown=2m"))
+		require.Equal(t, uint(5), cfg.CircuitBreakerThreshold)
+		require.Equal(t, 2*time.Minute, cfg.CircuitBreakerCooldown)
+	})
+
+	t.Run("ZeroCooldown", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"circuit-breaker-cooldown must not be 0 when circuit-breaker-threshold is set",
+			addRequiredArgs("--circuit-breaker-threshold=5", "--circuit-breaker-cooldown=0s"))
+	})
+}
+
+func TestMaxClockSkew(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.MaxClockSkew)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-clock-skew=2m"))
+		require.Equal(t, 2*time.Minute, cfg.MaxClockSkew)
+	})
+}
+
+func TestSelfCheckInterval(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.SelfCheckInterval)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--self-check-interval=10"))
+		require.Equal(t, uint(10), cfg.SelfCheckInterval)
+	})
+}
+
+func TestMaxAffordableBond(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.MaxAffordableBond)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--max-affordable-bond", "80000000000000000000"))
+		expected, _ := new(big.Int).SetString("80000000000000000000", 10)
+		require.Equal(t, expected, cfg.MaxAffordableBond)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid max affordable bond: abc",
+			addRequiredArgs("--max-affordable-bond", "abc"))
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid max affordable bond: -1",
+			addRequiredArgs("--max-affordable-bond", "-1"))
+	})
+}
+
+func TestHighActivityThreshold(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.HighActivityThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--high-activity-threshold=200"))
+		require.Equal(t, uint(200), cfg.HighActivityThreshold)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -high-activity-threshold",
+			addRequiredArgs("--high-activity-threshold", "abc"))
+	})
+}
+
+func TestProposerSilenceThreshold(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.ProposerSilenceThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--proposer-silence-threshold=6h"))
+		require.Equal(t, 6*time.Hour, cfg.ProposerSilenceThreshold)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -proposer-silence-threshold",
+			addRequiredArgs("--proposer-silence-threshold", "abc"))
+	})
+}
+
+func TestResolvedCacheGrace(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Zero(t, cfg.ResolvedCacheGrace)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--resolved-cache-grace", "1h"))
+		require.Equal(t, time.Hour, cfg.ResolvedCacheGrace)
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.DryRun)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--dry-run"))
+		require.True(t, cfg.DryRun)
+	})
+}
+
+func TestMetadataFromEvents(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.MetadataFromEvents)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--metadata-from-events"))
+		require.True(t, cfg.MetadataFromEvents)
+	})
+}
+
+func TestIncrementalDetection(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.IncrementalDetection)
+		require.Equal(t, config.DefaultFullRescanInterval, cfg.FullRescanInterval)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--incremental-detection", "--full-rescan-interval=100"))
+		require.True(t, cfg.IncrementalDetection)
+		require.Equal(t, uint(100), cfg.FullRescanInterval)
+	})
+
+	t.Run("ZeroRescanInterval", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--incremental-detection", "--full-rescan-interval=0"))
+		require.ErrorIs(t, cfg.Check(), config.ErrMissingFullRescanInterval)
+	})
+
+	t.Run("WithResolvedCache", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--incremental-detection", "--resolved-cache-grace=1m"))
+		require.ErrorIs(t, cfg.Check(), config.ErrIncrementalWithResolvedCache)
+	})
+}
+
+func TestCycleEvents(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.CycleEvents)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--cycle-events"))
+		require.True(t, cfg.CycleEvents)
+	})
+}
+
+func TestStatusSocket(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.StatusSocket)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--status-socket", "/tmp/dispute-mon.sock"))
+		require.Equal(t, "/tmp/dispute-mon.sock", cfg.StatusSocket)
+	})
+}
+
+func TestStatusSigningKey(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Nil(t, cfg.StatusSigningKey)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		seed := common.Hash{0x01, 0x02}
+		cfg := configForArgs(t, addRequiredArgs("--status-signing-key", seed.Hex()))
+		require.Equal(t, ed25519.NewKeyFromSeed(seed[:]), cfg.StatusSigningKey)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid status signing key",
+			addRequiredArgs("--status-signing-key", "0x1234"))
+	})
+}
+
+func TestOverridesFile(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.OverridesFile)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--overrides-file", "/tmp/overrides.json"))
+		require.Equal(t, "/tmp/overrides.json", cfg.OverridesFile)
+	})
+}
+
+func TestExpectedBondToken(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, common.Address{}, cfg.ExpectedBondToken)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xee}
+		cfg := configForArgs(t, addRequiredArgs("--expected-bond-token", addr.Hex()))
+		require.Equal(t, addr, cfg.ExpectedBondToken)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid expected bond token address: invalid address: 0xnope",
+			addRequiredArgs("--expected-bond-token", "0xnope"))
+	})
+}
+
+func TestAnchorFreshness(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, common.Address{}, cfg.AnchorStateRegistry)
+		require.Zero(t, cfg.AnchorGameType)
+		require.Zero(t, cfg.AnchorAgeThreshold)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xa5}
+		cfg := configForArgs(t, addRequiredArgs("--anchor-state-registry", addr.Hex(), "--anchor-game-type", "1", "--anchor-age-threshold", "24h"))
+		require.Equal(t, addr, cfg.AnchorStateRegistry)
+		require.Equal(t, uint32(1), cfg.AnchorGameType)
+		require.Equal(t, 24*time.Hour, cfg.AnchorAgeThreshold)
+	})
+
+	t.Run("InvalidRegistry", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid anchor state registry address: invalid address: 0xnope",
+			addRequiredArgs("--anchor-state-registry", "0xnope"))
+	})
+
+	t.Run("InvalidGameType", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid anchor game type: 4294967296",
+			addRequiredArgs("--anchor-game-type", "4294967296"))
+	})
+}
+
+func TestResultRouting(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.ResultLogSeverities)
+		require.Empty(t, cfg.ResultsPath)
+		require.Empty(t, cfg.ResultsPathSeverities)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--result-log-severities", "warning,critical",
+			"--results-path", "/tmp/results.jsonl",
+			"--results-path-severities", "critical"))
+		require.Equal(t, []results.Severity{results.SeverityWarning, results.SeverityCritical}, cfg.ResultLogSeverities)
+		require.Equal(t, "/tmp/results.jsonl", cfg.ResultsPath)
+		require.Equal(t, []results.Severity{results.SeverityCritical}, cfg.ResultsPathSeverities)
+	})
+
+	t.Run("InvalidLogSeverity", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid result severity: urgent", addRequiredArgs("--result-log-severities", "urgent"))
+	})
+
+	t.Run("InvalidPathSeverity", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid result severity: urgent", addRequiredArgs("--results-path-severities", "info,urgent"))
+	})
+}
+
+func TestCanary(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, common.Address{}, cfg.CanaryGame)
+		require.False(t, cfg.CanaryAgreeWithClaim)
+	})
+
+	t.Run("DefaultClassification", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--canary-game", common.Address{0xca}.Hex()))
+		require.True(t, cfg.CanaryAgreeWithClaim)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xca}
+		cfg := configForArgs(t, addRequiredArgs("--canary-game", addr.Hex(), "--canary-classification", "disagree"))
+		require.Equal(t, addr, cfg.CanaryGame)
+		require.False(t, cfg.CanaryAgreeWithClaim)
+	})
+
+	t.Run("InvalidGame", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid canary game address: invalid address: 0xnope",
+			addRequiredArgs("--canary-game", "0xnope"))
+	})
+
+	t.Run("InvalidClassification", func(t *testing.T) {
+		verifyArgsInvalid(t,
+			"invalid canary classification: maybe",
+			addRequiredArgs("--canary-game", common.Address{0xca}.Hex(), "--canary-classification", "maybe"))
+	})
+}
+
+func TestTransitions(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.TransitionsPath)
+		require.Zero(t, cfg.TransitionsMaxSize)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--transitions-path", "/tmp/transitions.jsonl",
+			"--transitions-max-size", "1048576"))
+		require.Equal(t, "/tmp/transitions.jsonl", cfg.TransitionsPath)
+		require.Equal(t, uint64(1048576), cfg.TransitionsMaxSize)
+	})
+}
+
+func TestHistory(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Empty(t, cfg.HistoryPath)
+		require.Zero(t, cfg.HistoryMaxSize)
+		require.False(t, cfg.HistoryRotateDaily)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(
+			"--history-path", "/tmp/history.jsonl",
+			"--history-max-size", "1048576",
+			"--history-rotate-daily"))
+		require.Equal(t, "/tmp/history.jsonl", cfg.HistoryPath)
+		require.Equal(t, uint64(1048576), cfg.HistoryMaxSize)
+		require.True(t, cfg.HistoryRotateDaily)
+	})
+
+	t.Run("InvalidMaxSize", func(t *testing.T) {
+		verifyArgsInvalid(
+			t,
+			"invalid value \"abc\" for flag -history-max-size",
+			addRequiredArgs("--history-max-size", "abc"))
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := dryRunWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
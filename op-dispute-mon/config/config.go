@@ -1,21 +1,48 @@
 package config
 
 import (
+	"crypto/ed25519"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
-	ErrMissingL1EthRPC           = errors.New("missing l1 eth rpc url")
-	ErrMissingGameFactoryAddress = errors.New("missing game factory address")
-	ErrMissingRollupRpc          = errors.New("missing rollup rpc url")
-	ErrMissingMaxConcurrency     = errors.New("missing max concurrency")
+	ErrMissingL1EthRPC              = errors.New("missing l1 eth rpc url")
+	ErrMissingGameFactoryAddress    = errors.New("missing game factory address")
+	ErrDuplicateGameFactory         = errors.New("duplicate game factory address")
+	ErrMissingRollupRpc             = errors.New("missing rollup rpc url")
+	ErrMissingMaxConcurrency        = errors.New("missing max concurrency")
+	ErrMissingMaxDeferredCycles     = errors.New("missing max deferred cycles")
+	ErrInvalidAgreementHead         = errors.New("invalid agreement head")
+	ErrNegativeMinBond              = errors.New("min bond must not be negative")
+	ErrInvalidSampleRate            = errors.New("sample rate must be greater than 0 and at most 1")
+	ErrInvalidRollupRpcRateLimit    = errors.New("rollup rpc rate limit must not be negative")
+	ErrMissingRollupRpcRateBurst    = errors.New("missing rollup rpc rate burst")
+	ErrInvalidL2ChainID             = errors.New("l2 chain id must not be zero")
+	ErrInvalidBackfillWindow        = errors.New("backfill window must be longer than game window")
+	ErrMissingBackfillInterval      = errors.New("missing backfill interval")
+	ErrMissingFullRescanInterval    = errors.New("missing full rescan interval")
+	ErrIncrementalWithResolvedCache = errors.New("incremental detection and the resolved cache can't both be enabled")
+	ErrMissingResultTopic           = errors.New("missing result topic")
+	ErrMissingBreakerCooldown       = errors.New("missing circuit breaker cooldown")
+	ErrUnknownOutputDomainChain     = errors.New("output domain configured for unknown l2 chain")
+	ErrUnknownFinalityChain         = errors.New("finality predicate configured for unknown l2 chain")
+	ErrInvalidDisagreementSmoothing = errors.New("disagreement rate smoothing must be greater than 0 and at most 1")
+	ErrInvalidGameGrouping          = errors.New("invalid game grouping")
+	ErrInvalidErrorRateThreshold    = errors.New("error rate threshold must be between 0 and 1")
+	ErrInvalidIntervalJitter        = errors.New("monitor interval jitter must be at least 0 and less than 1")
 )
 
 const (
@@ -30,6 +57,50 @@ const (
 
 	//DefaultMaxConcurrency is the default number of threads to use when fetching game data
 	DefaultMaxConcurrency = uint(5)
+
+	// DefaultMaxDeferredCycles is the default number of consecutive cycles a game may dispute a block
+	// beyond the rollup node's output range before it is considered stuck.
+	DefaultMaxDeferredCycles = uint(10)
+
+	// DefaultAgreementHead is the default rollup node head games are classified against.
+	DefaultAgreementHead = types.AgreementHeadSafe
+
+	// DefaultGameGrouping is the default key games are grouped by for grouped agreement counts.
+	DefaultGameGrouping = types.GameGroupingNone
+
+	// DefaultMetadataTimeout is the default maximum time allowed to load a game's metadata and claims.
+	DefaultMetadataTimeout = time.Minute
+	// DefaultComparisonTimeout is the default maximum time allowed to compare a game's root claim
+	// against the rollup node.
+	DefaultComparisonTimeout = 30 * time.Second
+
+	// DefaultClockSkewTolerance is the default amount a game's creation timestamp may be in the future
+	// before it is reported.
+	DefaultClockSkewTolerance = 30 * time.Second
+
+	// DefaultSampleRate is the default fraction of games to monitor.
+	DefaultSampleRate = 1.0
+
+	// DefaultRollupRpcRateBurst is the default number of rollup node output requests allowed at once
+	// when a rate limit is configured.
+	DefaultRollupRpcRateBurst = uint(10)
+
+	// DefaultShutdownGracePeriod is the default maximum time to wait for an in-flight
+	// monitoring cycle to complete when shutting down.
+	DefaultShutdownGracePeriod = time.Minute
+
+	// DefaultBackfillInterval is the default minimum time between games loaded by the backfill.
+	DefaultBackfillInterval = 10 * time.Second
+
+	// DefaultFullRescanInterval is the default number of monitoring cycles between full rescans when incremental
+	// detection is enabled. Bond credits and withdrawals of resolved games are only refreshed by a full rescan.
+	DefaultFullRescanInterval = uint(20)
+
+	// DefaultCircuitBreakerCooldown is the default time monitoring is paused for once the circuit breaker opens.
+	DefaultCircuitBreakerCooldown = 5 * time.Minute
+
+	// DefaultDisagreementSmoothing is the default weight given to the latest cycle in the smoothed disagreement rate.
+	DefaultDisagreementSmoothing = 0.1
 )
 
 // Config is a well typed config that is parsed from the CLI params.
@@ -38,12 +109,113 @@ type Config struct {
 	L1EthRpc           string         // L1 RPC Url
 	GameFactoryAddress common.Address // Address of the dispute game factory
 
+	AdditionalGameFactories []common.Address // Addresses of further dispute game factories to monitor games from
+
 	HonestActors    []common.Address // List of honest actors to monitor claims for.
 	RollupRpc       string           // The rollup node RPC URL.
 	MonitorInterval time.Duration    // Frequency to check for new games to monitor.
 	GameWindow      time.Duration    // Maximum window to look for games to monitor.
 	IgnoredGames    []common.Address // Games to exclude from monitoring
 	MaxConcurrency  uint             // Maximum number of threads to use when fetching game data
+	GameTypes       []uint32         // Game types to monitor. Empty to monitor all game types.
+	MinBond         *big.Int         // Minimum root claim bond for a game to be monitored. nil to monitor all games.
+	SampleRate      float64          // Fraction of games to monitor, selected by game address. 1 to monitor all games.
+
+	MonitorIntervalJitter float64 // Maximum fraction the monitor interval is randomly varied by to spread load across instances. 0 to disable.
+
+	GameStatuses []gameTypes.GameStatus // Game statuses to monitor. Empty to monitor games of all statuses.
+
+	TrustedProposers []common.Address // Proposers whose in progress disagreements are counted but not alerted on.
+
+	BenignGames []common.Address // Games known to disagree that are reported as ignored and never alerted on.
+
+	DisagreementSmoothing float64 // Weight given to the latest cycle in the smoothed disagreement rate, between 0 and 1.
+
+	GameGrouping types.GameGrouping // Key games are grouped by when reporting grouped agreement counts. None to disable.
+
+	ChainRollupRpcs     map[uint64]string         // Rollup node RPC URLs for further L2 chains, keyed by chain ID.
+	GameFactoryChainIDs map[common.Address]uint64 // L2 chain disputed by each game factory. Unlisted factories dispute the chain of RollupRpc.
+
+	ChainOutputDomains map[uint64]common.Hash // Domain each L2 chain's root claims commit to, keyed by chain ID. Chains without a domain are compared against the plain output root.
+
+	FinalityPredicates map[uint64]extract.FinalityPredicate // Decides whether a disputed L2 block is final in place of AgreementHead, keyed by chain ID with 0 for the chain of RollupRpc. Not configurable from the CLI.
+
+	ArchiveRollupRpc string // Rollup node RPC URL used for outputs whose state the rollup node has pruned. Empty to disable.
+
+	RollupRpcRateLimit float64 // Maximum rollup node output requests per second. 0 to disable.
+	RollupRpcRateBurst uint    // Maximum rollup node output requests allowed at once when rate limited.
+
+	MaxDeferredCycles uint                // Maximum consecutive cycles a game may be beyond the output range before being considered stuck
+	AgreementHead     types.AgreementHead // Rollup node head games are classified against. Newer games are pending.
+	OutputForkBlock   *uint64             // First L2 block using the upgraded output root format. nil if no upgrade is in progress.
+	PinnedL1Block     *uint64             // Finalized L1 block all on-chain reads are made at. nil to read at the latest block.
+	MaxL2BlockNumber  uint64              // Largest L2 block a game may plausibly dispute. Games beyond it or disputing block 0 are not classified. 0 to disable the upper bound.
+
+	MetadataTimeout     time.Duration // Maximum time allowed to load a game's metadata and claims. 0 to disable.
+	ComparisonTimeout   time.Duration // Maximum time allowed to compare a game's root claim against the rollup node. 0 to disable.
+	ClockSkewTolerance  time.Duration // Maximum time a game's creation timestamp may be in the future before it is reported
+	MaxClockSkew        time.Duration // Maximum divergence of local time from the latest L1 block before L1 time is used. 0 to disable.
+	ShutdownGracePeriod time.Duration // Maximum time to wait for an in-flight monitoring cycle to complete on shutdown
+	CycleDeadline       time.Duration // Maximum time allowed to load games in a monitoring cycle before it is cancelled. 0 to disable.
+
+	StartupGraceCycles uint // Monitoring cycles after startup in which games are loaded but not reported, so transient results don't alert. 0 to disable.
+
+	ErrorRateThreshold float64 // Fraction of games failing to load in a cycle above which a warning is logged. 0 to disable.
+
+	SelfCheckInterval uint // Monitoring cycles between checks that the rollup node returns the same finalized output twice. 0 to disable.
+
+	ResolvedCacheGrace time.Duration // Time a resolved game's classification must remain unchanged before it is no longer re-evaluated. 0 to disable.
+
+	MetadataFromEvents bool // Load each game's status from events rather than contract calls
+
+	IncrementalDetection bool // Only load games that may have changed since the last processed L1 block, reusing the data of resolved games. Can't be used with ResolvedCacheGrace.
+	FullRescanInterval   uint // Monitoring cycles between full rescans of every game when incremental detection is enabled. Must not be 0 if incremental detection is enabled.
+
+	DryRun bool // Run all monitoring logic but discard metrics, logging game classifications instead
+
+	BackfillWindow   time.Duration // Maximum age of games older than GameWindow to check once in the background. 0 to disable.
+	BackfillInterval time.Duration // Minimum time between games loaded by the backfill.
+
+	CircuitBreakerThreshold uint          // Consecutive cycles in which no game could be loaded before monitoring is paused. 0 to disable.
+	CircuitBreakerCooldown  time.Duration // Time monitoring is paused for once the circuit breaker opens.
+
+	CycleEvents bool // Write a JSON event summarising each monitoring cycle to stdout
+
+	StatusSocket     string             // Path of a UNIX socket to serve the latest status summary on. Empty to disable.
+	StatusSigningKey ed25519.PrivateKey // Key to sign each served status summary with. nil to serve unsigned summaries.
+
+	OverridesFile string // Path of a JSON file of per-game classification overrides, reloaded when changed. Empty to disable.
+
+	CanaryGame           common.Address // Game that must always be classified as expected to validate monitoring. Zero to disable.
+	CanaryAgreeWithClaim bool           // Whether the canary game is expected to agree with its root claim.
+
+	ExpectedBondToken common.Address // DelayedWETH contract games are expected to hold bonds in. Zero to disable.
+
+	ProposerSilenceThreshold time.Duration // Time without a new game before the proposer is reported as silent. 0 to disable.
+
+	HighActivityThreshold uint // Moves and steps in an in-progress game above which it is reported as high activity. 0 to disable.
+
+	MaxAffordableBond *big.Int // Bond required for the next move in a game above which it is reported as unaffordable. nil to disable.
+
+	AnchorStateRegistry common.Address // AnchorStateRegistry contract to report the age of the anchor state from. Zero to disable.
+	AnchorGameType      uint32         // Game type to report the age of the anchor state for.
+	AnchorAgeThreshold  time.Duration  // Age of the anchor state above which it is reported as stale. 0 to disable.
+
+	ResultSeverity        results.SeverityFunc // Derives the severity of each game result. nil to use results.DefaultSeverity. Not configurable from the CLI.
+	ResultLogSeverities   []results.Severity   // Severities of game results to log each cycle. Empty to disable.
+	ResultsPath           string               // Path of a file to append game results to as JSON lines. Empty to disable.
+	ResultsPathSeverities []results.Severity   // Severities of game results to append to ResultsPath. Empty for all severities.
+	ResultProducer        results.Producer     // Kafka producer to publish every game result with. nil to disable. Not configurable from the CLI.
+	ResultTopic           string               // Kafka topic to publish game results to. Required if ResultProducer is set. Not configurable from the CLI.
+
+	DetectionRules []types.DetectionRule // Custom rules evaluated against each game after the standard monitors. Not configurable from the CLI.
+
+	HistoryPath        string // Path of a file to append the result of each monitoring cycle to. Empty to disable.
+	HistoryMaxSize     uint64 // Size in bytes at which the history file is rotated. 0 to disable.
+	HistoryRotateDaily bool   // Rotate the history file each UTC day
+
+	TransitionsPath    string // Path of a file to append each change in a game's classification to. Empty to disable.
+	TransitionsMaxSize uint64 // Size in bytes at which the transitions file is rotated. 0 to disable.
 
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
@@ -58,6 +230,27 @@ func NewConfig(gameFactoryAddress common.Address, l1EthRpc string, rollupRpc str
 		MonitorInterval: DefaultMonitorInterval,
 		GameWindow:      DefaultGameWindow,
 		MaxConcurrency:  DefaultMaxConcurrency,
+		SampleRate:      DefaultSampleRate,
+
+		DisagreementSmoothing: DefaultDisagreementSmoothing,
+
+		GameGrouping: DefaultGameGrouping,
+
+		RollupRpcRateBurst: DefaultRollupRpcRateBurst,
+
+		MaxDeferredCycles: DefaultMaxDeferredCycles,
+		AgreementHead:     DefaultAgreementHead,
+
+		MetadataTimeout:     DefaultMetadataTimeout,
+		ComparisonTimeout:   DefaultComparisonTimeout,
+		ClockSkewTolerance:  DefaultClockSkewTolerance,
+		ShutdownGracePeriod: DefaultShutdownGracePeriod,
+
+		BackfillInterval: DefaultBackfillInterval,
+
+		FullRescanInterval: DefaultFullRescanInterval,
+
+		CircuitBreakerCooldown: DefaultCircuitBreakerCooldown,
 
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
@@ -74,9 +267,88 @@ func (c Config) Check() error {
 	if c.GameFactoryAddress == (common.Address{}) {
 		return ErrMissingGameFactoryAddress
 	}
+	factories := map[common.Address]bool{c.GameFactoryAddress: true}
+	for _, factory := range c.AdditionalGameFactories {
+		if factories[factory] {
+			return fmt.Errorf("%w: %v", ErrDuplicateGameFactory, factory)
+		}
+		factories[factory] = true
+	}
+	for chainID, rpc := range c.ChainRollupRpcs {
+		if chainID == 0 {
+			return ErrInvalidL2ChainID
+		}
+		if rpc == "" {
+			return fmt.Errorf("%w for l2 chain %v", ErrMissingRollupRpc, chainID)
+		}
+	}
+	for factory, chainID := range c.GameFactoryChainIDs {
+		if chainID == 0 {
+			return fmt.Errorf("%w: factory %v", ErrInvalidL2ChainID, factory)
+		}
+	}
+	for chainID := range c.ChainOutputDomains {
+		if _, ok := c.ChainRollupRpcs[chainID]; !ok {
+			return fmt.Errorf("%w: %v", ErrUnknownOutputDomainChain, chainID)
+		}
+	}
+	for chainID := range c.FinalityPredicates {
+		if _, ok := c.ChainRollupRpcs[chainID]; !ok && chainID != 0 {
+			return fmt.Errorf("%w: %v", ErrUnknownFinalityChain, chainID)
+		}
+	}
 	if c.MaxConcurrency == 0 {
 		return ErrMissingMaxConcurrency
 	}
+	if c.MaxDeferredCycles == 0 {
+		return ErrMissingMaxDeferredCycles
+	}
+	if c.RollupRpcRateLimit < 0 {
+		return ErrInvalidRollupRpcRateLimit
+	}
+	if c.RollupRpcRateLimit > 0 && c.RollupRpcRateBurst == 0 {
+		return ErrMissingRollupRpcRateBurst
+	}
+	if c.BackfillWindow != 0 && c.BackfillWindow <= c.GameWindow {
+		return ErrInvalidBackfillWindow
+	}
+	if c.BackfillWindow != 0 && c.BackfillInterval == 0 {
+		return ErrMissingBackfillInterval
+	}
+	if c.IncrementalDetection && c.FullRescanInterval == 0 {
+		return ErrMissingFullRescanInterval
+	}
+	// Both cache the classification of resolved games so only one may be used
+	if c.IncrementalDetection && c.ResolvedCacheGrace != 0 {
+		return ErrIncrementalWithResolvedCache
+	}
+	if c.ResultProducer != nil && c.ResultTopic == "" {
+		return ErrMissingResultTopic
+	}
+	if c.CircuitBreakerThreshold != 0 && c.CircuitBreakerCooldown == 0 {
+		return ErrMissingBreakerCooldown
+	}
+	if c.MinBond != nil && c.MinBond.Sign() < 0 {
+		return ErrNegativeMinBond
+	}
+	if c.SampleRate <= 0 || c.SampleRate > 1 {
+		return ErrInvalidSampleRate
+	}
+	if c.DisagreementSmoothing <= 0 || c.DisagreementSmoothing > 1 {
+		return ErrInvalidDisagreementSmoothing
+	}
+	if c.ErrorRateThreshold < 0 || c.ErrorRateThreshold > 1 {
+		return ErrInvalidErrorRateThreshold
+	}
+	if c.MonitorIntervalJitter < 0 || c.MonitorIntervalJitter >= 1 {
+		return ErrInvalidIntervalJitter
+	}
+	if !types.ValidAgreementHead(c.AgreementHead) {
+		return fmt.Errorf("%w: %v", ErrInvalidAgreementHead, c.AgreementHead)
+	}
+	if !types.ValidGameGrouping(c.GameGrouping) {
+		return fmt.Errorf("%w: %v", ErrInvalidGameGrouping, c.GameGrouping)
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return fmt.Errorf("metrics config: %w", err)
 	}
@@ -1,10 +1,15 @@
 package config
 
 import (
+	"context"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,6 +39,83 @@ func TestGameFactoryAddressRequired(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingGameFactoryAddress)
 }
 
+func TestAdditionalGameFactories(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig()
+		config.AdditionalGameFactories = []common.Address{{0xaa}, {0xbb}}
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("DuplicatesGameFactory", func(t *testing.T) {
+		config := validConfig()
+		config.AdditionalGameFactories = []common.Address{validGameFactoryAddress}
+		require.ErrorIs(t, config.Check(), ErrDuplicateGameFactory)
+	})
+
+	t.Run("Duplicated", func(t *testing.T) {
+		config := validConfig()
+		config.AdditionalGameFactories = []common.Address{{0xaa}, {0xaa}}
+		require.ErrorIs(t, config.Check(), ErrDuplicateGameFactory)
+	})
+}
+
+func TestL2ChainIDs(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		config := validConfig()
+		config.ChainRollupRpcs = map[uint64]string{10: "http://localhost:9546"}
+		config.GameFactoryChainIDs = map[common.Address]uint64{{0xaa}: 10}
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("ZeroRollupRpcChainID", func(t *testing.T) {
+		config := validConfig()
+		config.ChainRollupRpcs = map[uint64]string{0: "http://localhost:9546"}
+		require.ErrorIs(t, config.Check(), ErrInvalidL2ChainID)
+	})
+
+	t.Run("MissingChainRollupRpc", func(t *testing.T) {
+		config := validConfig()
+		config.ChainRollupRpcs = map[uint64]string{10: ""}
+		require.ErrorIs(t, config.Check(), ErrMissingRollupRpc)
+	})
+
+	t.Run("ZeroFactoryChainID", func(t *testing.T) {
+		config := validConfig()
+		config.GameFactoryChainIDs = map[common.Address]uint64{{0xaa}: 0}
+		require.ErrorIs(t, config.Check(), ErrInvalidL2ChainID)
+	})
+
+	t.Run("OutputDomain", func(t *testing.T) {
+		config := validConfig()
+		config.ChainRollupRpcs = map[uint64]string{10: "http://localhost:9546"}
+		config.ChainOutputDomains = map[uint64]common.Hash{10: {0xdd}}
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("OutputDomainUnknownChain", func(t *testing.T) {
+		config := validConfig()
+		config.ChainOutputDomains = map[uint64]common.Hash{10: {0xdd}}
+		require.ErrorIs(t, config.Check(), ErrUnknownOutputDomainChain)
+	})
+
+	t.Run("FinalityPredicate", func(t *testing.T) {
+		config := validConfig()
+		config.ChainRollupRpcs = map[uint64]string{10: "http://localhost:9546"}
+		config.FinalityPredicates = map[uint64]extract.FinalityPredicate{0: finalized, 10: finalized}
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("FinalityPredicateUnknownChain", func(t *testing.T) {
+		config := validConfig()
+		config.FinalityPredicates = map[uint64]extract.FinalityPredicate{10: finalized}
+		require.ErrorIs(t, config.Check(), ErrUnknownFinalityChain)
+	})
+}
+
+func finalized(_ context.Context, _ uint64) (bool, error) {
+	return true, nil
+}
+
 func TestRollupRpcRequired(t *testing.T) {
 	config := validConfig()
 	config.RollupRpc = ""
@@ -45,3 +127,191 @@ func TestMaxConcurrencyRequired(t *testing.T) {
 	config.MaxConcurrency = 0
 	require.ErrorIs(t, config.Check(), ErrMissingMaxConcurrency)
 }
+
+func TestRollupRpcRateLimit(t *testing.T) {
+	config := validConfig()
+	config.RollupRpcRateLimit = -1
+	require.ErrorIs(t, config.Check(), ErrInvalidRollupRpcRateLimit)
+
+	config.RollupRpcRateLimit = 5
+	config.RollupRpcRateBurst = 0
+	require.ErrorIs(t, config.Check(), ErrMissingRollupRpcRateBurst)
+
+	config.RollupRpcRateLimit = 0
+	require.NoError(t, config.Check())
+}
+
+func TestBackfill(t *testing.T) {
+	config := validConfig()
+	config.BackfillWindow = config.GameWindow
+	require.ErrorIs(t, config.Check(), ErrInvalidBackfillWindow)
+
+	config.BackfillWindow = config.GameWindow + time.Hour
+	config.BackfillInterval = 0
+	require.ErrorIs(t, config.Check(), ErrMissingBackfillInterval)
+
+	config.BackfillInterval = time.Second
+	require.NoError(t, config.Check())
+
+	config.BackfillWindow = 0
+	config.BackfillInterval = 0
+	require.NoError(t, config.Check())
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	config := validConfig()
+	config.CircuitBreakerThreshold = 3
+	config.CircuitBreakerCooldown = 0
+	require.ErrorIs(t, config.Check(), ErrMissingBreakerCooldown)
+
+	config.CircuitBreakerCooldown = time.Minute
+	require.NoError(t, config.Check())
+
+	config.CircuitBreakerThreshold = 0
+	config.CircuitBreakerCooldown = 0
+	require.NoError(t, config.Check())
+}
+
+func TestMinBondNotNegative(t *testing.T) {
+	config := validConfig()
+	config.MinBond = big.NewInt(-1)
+	require.ErrorIs(t, config.Check(), ErrNegativeMinBond)
+
+	config.MinBond = big.NewInt(0)
+	require.NoError(t, config.Check())
+}
+
+func TestSampleRateValid(t *testing.T) {
+	for _, rate := range []float64{0, -0.5, 1.5} {
+		config := validConfig()
+		config.SampleRate = rate
+		require.ErrorIs(t, config.Check(), ErrInvalidSampleRate)
+	}
+
+	config := validConfig()
+	config.SampleRate = 0.1
+	require.NoError(t, config.Check())
+}
+
+func TestMonitorIntervalJitterValid(t *testing.T) {
+	for _, jitter := range []float64{-0.5, 1, 1.5} {
+		config := validConfig()
+		config.MonitorIntervalJitter = jitter
+		require.ErrorIs(t, config.Check(), ErrInvalidIntervalJitter)
+	}
+
+	for _, jitter := range []float64{0, 0.1, 0.9} {
+		config := validConfig()
+		config.MonitorIntervalJitter = jitter
+		require.NoError(t, config.Check())
+	}
+}
+
+func TestErrorRateThresholdValid(t *testing.T) {
+	for _, threshold := range []float64{-0.5, 1.5} {
+		config := validConfig()
+		config.ErrorRateThreshold = threshold
+		require.ErrorIs(t, config.Check(), ErrInvalidErrorRateThreshold)
+	}
+
+	for _, threshold := range []float64{0, 0.1, 1} {
+		config := validConfig()
+		config.ErrorRateThreshold = threshold
+		require.NoError(t, config.Check())
+	}
+}
+
+func TestDisagreementSmoothingValid(t *testing.T) {
+	for _, smoothing := range []float64{0, -0.5, 1.5} {
+		config := validConfig()
+		config.DisagreementSmoothing = smoothing
+		require.ErrorIs(t, config.Check(), ErrInvalidDisagreementSmoothing)
+	}
+
+	config := validConfig()
+	config.DisagreementSmoothing = 1
+	require.NoError(t, config.Check())
+}
+
+func TestGameGroupingValid(t *testing.T) {
+	for _, grouping := range types.GameGroupings {
+		grouping := grouping
+		t.Run(grouping.String(), func(t *testing.T) {
+			config := validConfig()
+			config.GameGrouping = grouping
+			require.NoError(t, config.Check())
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		config := validConfig()
+		config.GameGrouping = "color"
+		require.ErrorIs(t, config.Check(), ErrInvalidGameGrouping)
+	})
+}
+
+func TestAgreementHeadValid(t *testing.T) {
+	for _, head := range types.AgreementHeads {
+		head := head
+		t.Run(head.String(), func(t *testing.T) {
+			config := validConfig()
+			config.AgreementHead = head
+			require.NoError(t, config.Check())
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		config := validConfig()
+		config.AgreementHead = "unsafe"
+		require.ErrorIs(t, config.Check(), ErrInvalidAgreementHead)
+	})
+}
+
+func TestResultTopicRequired(t *testing.T) {
+	config := validConfig()
+	config.ResultProducer = &stubProducer{}
+	require.ErrorIs(t, config.Check(), ErrMissingResultTopic)
+
+	config.ResultTopic = "results"
+	require.NoError(t, config.Check())
+}
+
+type stubProducer struct{}
+
+func (s *stubProducer) Produce(_ context.Context, _ string, _ []byte, _ []byte) error {
+	return nil
+}
+
+func TestFullRescanIntervalRequired(t *testing.T) {
+	config := validConfig()
+	config.IncrementalDetection = true
+	config.FullRescanInterval = 0
+	require.ErrorIs(t, config.Check(), ErrMissingFullRescanInterval)
+
+	config.FullRescanInterval = 1
+	require.NoError(t, config.Check())
+
+	config.IncrementalDetection = false
+	config.FullRescanInterval = 0
+	require.NoError(t, config.Check())
+}
+
+func TestIncrementalDetectionExcludesResolvedCache(t *testing.T) {
+	config := validConfig()
+	config.IncrementalDetection = true
+	config.ResolvedCacheGrace = time.Minute
+	require.ErrorIs(t, config.Check(), ErrIncrementalWithResolvedCache)
+
+	config.ResolvedCacheGrace = 0
+	require.NoError(t, config.Check())
+
+	config.IncrementalDetection = false
+	config.ResolvedCacheGrace = time.Minute
+	require.NoError(t, config.Check())
+}
+
+func TestMaxDeferredCyclesRequired(t *testing.T) {
+	config := validConfig()
+	config.MaxDeferredCycles = 0
+	require.ErrorIs(t, config.Check(), ErrMissingMaxDeferredCycles)
+}
@@ -1,18 +1,28 @@
 package flags
 
 import (
+	"crypto/ed25519"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
 
 	challengerFlags "github.com/ethereum-optimism/optimism/op-challenger/flags"
 	"github.com/ethereum-optimism/optimism/op-service/flags"
 	"github.com/urfave/cli/v2"
 
+	challengerTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/oppprof"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 const (
@@ -53,6 +63,11 @@ var (
 		EnvVars: prefixEnvVars("MONITOR_INTERVAL"),
 		Value:   config.DefaultMonitorInterval,
 	}
+	MonitorIntervalJitterFlag = &cli.Float64Flag{
+		Name:    "monitor-interval-jitter",
+		Usage:   "Maximum fraction, less than 1, the monitor interval is randomly increased or decreased by each cycle so instances sharing an RPC provider spread their load. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("MONITOR_INTERVAL_JITTER"),
+	}
 	GameWindowFlag = &cli.DurationFlag{
 		Name: "game-window",
 		Usage: "The time window which the monitor will consider games to report on. " +
@@ -60,19 +75,342 @@ var (
 		EnvVars: prefixEnvVars("GAME_WINDOW"),
 		Value:   config.DefaultGameWindow,
 	}
+	AdditionalGameFactoriesFlag = &cli.StringSliceFlag{
+		Name:    "additional-game-factory-addresses",
+		Usage:   "List of further dispute game factory addresses to monitor games from, in addition to the game factory address.",
+		EnvVars: prefixEnvVars("ADDITIONAL_GAME_FACTORY_ADDRESSES"),
+	}
 	IgnoredGamesFlag = &cli.StringSliceFlag{
 		Name:    "ignored-games",
 		Usage:   "List of game addresses to exclude from monitoring.",
 		EnvVars: prefixEnvVars("IGNORED_GAMES"),
 	}
+	TrustedProposersFlag = &cli.StringSliceFlag{
+		Name:    "trusted-proposers",
+		Usage:   "List of proposer addresses whose in progress games are still counted when they disagree, but don't log warnings or reset the cycles since the last disagreement.",
+		EnvVars: prefixEnvVars("TRUSTED_PROPOSERS"),
+	}
+	BenignGamesFlag = &cli.StringSliceFlag{
+		Name:    "benign-games",
+		Usage:   "List of game addresses known to disagree, such as test games. They are still loaded but are reported as ignored rather than as disagreements.",
+		EnvVars: prefixEnvVars("BENIGN_GAMES"),
+	}
 	MaxConcurrencyFlag = &cli.UintFlag{
 		Name:    "max-concurrency",
 		Usage:   "Maximum number of threads to use when fetching game data",
 		EnvVars: prefixEnvVars("MAX_CONCURRENCY"),
 		Value:   config.DefaultMaxConcurrency,
 	}
+	GameTypesFlag = &cli.UintSliceFlag{
+		Name:    "game-types",
+		Usage:   "List of game types to monitor. Games of other types are excluded from monitoring. Monitors all game types if not set.",
+		EnvVars: prefixEnvVars("GAME_TYPES"),
+	}
+	GameStatusesFlag = &cli.StringSliceFlag{
+		Name:    "game-statuses",
+		Usage:   "List of game statuses to monitor. Games with other statuses are excluded from monitoring. Monitors games of all statuses if not set. Valid options: " + openum.EnumString(types.GameStatusNames),
+		EnvVars: prefixEnvVars("GAME_STATUSES"),
+	}
+	MinBondFlag = &cli.StringFlag{
+		Name:    "min-bond",
+		Usage:   "Minimum bond in wei posted on a game's root claim for the game to be monitored. Monitors games regardless of bond if not set.",
+		EnvVars: prefixEnvVars("MIN_BOND"),
+	}
+	SampleRateFlag = &cli.Float64Flag{
+		Name:    "sample-rate",
+		Usage:   "Fraction of games to monitor, between 0 and 1. Games are selected by address so the same games are monitored each cycle.",
+		EnvVars: prefixEnvVars("SAMPLE_RATE"),
+		Value:   config.DefaultSampleRate,
+	}
+	DisagreementSmoothingFlag = &cli.Float64Flag{
+		Name:    "disagreement-smoothing",
+		Usage:   "Weight given to the latest cycle in the smoothed disagreement rate, between 0 and 1. Lower values smooth more.",
+		EnvVars: prefixEnvVars("DISAGREEMENT_SMOOTHING"),
+		Value:   config.DefaultDisagreementSmoothing,
+	}
+	GameGroupingFlag = &cli.GenericFlag{
+		Name:    "game-grouping",
+		Usage:   "Key games are grouped by when reporting grouped agreement counts. Valid options: " + openum.EnumString(types.GameGroupings),
+		EnvVars: prefixEnvVars("GAME_GROUPING"),
+		Value: func() *types.GameGrouping {
+			grouping := config.DefaultGameGrouping
+			return &grouping
+		}(),
+	}
+	ArchiveRollupRpcFlag = &cli.StringFlag{
+		Name:    "archive-rollup-rpc",
+		Usage:   "HTTP provider URL for an archive rollup node, used for outputs whose state the rollup node has pruned",
+		EnvVars: prefixEnvVars("ARCHIVE_ROLLUP_RPC"),
+	}
+	ChainRollupRpcsFlag = &cli.StringSliceFlag{
+		Name:    "chain-rollup-rpcs",
+		Usage:   "List of rollup node RPC URLs for further L2 chains, each in the form <chain-id>=<url>.",
+		EnvVars: prefixEnvVars("CHAIN_ROLLUP_RPCS"),
+	}
+	GameFactoryChainIDsFlag = &cli.StringSliceFlag{
+		Name:    "game-factory-chain-ids",
+		Usage:   "List of the L2 chain disputed by game factories, each in the form <factory-address>=<chain-id>. Games from unlisted factories are checked against the rollup rpc.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_CHAIN_IDS"),
+	}
+	ChainOutputDomainsFlag = &cli.StringSliceFlag{
+		Name:    "chain-output-domains",
+		Usage:   "List of the domain root claims commit to for further L2 chains, each in the form <chain-id>=<domain>. Chains without a domain are compared against the plain output root.",
+		EnvVars: prefixEnvVars("CHAIN_OUTPUT_DOMAINS"),
+	}
+	RollupRpcRateLimitFlag = &cli.Float64Flag{
+		Name:    "rollup-rpc-rate-limit",
+		Usage:   "Maximum number of output requests per second to send to the rollup node. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("ROLLUP_RPC_RATE_LIMIT"),
+	}
+	RollupRpcRateBurstFlag = &cli.UintFlag{
+		Name:    "rollup-rpc-rate-burst",
+		Usage:   "Maximum number of output requests allowed at once when the rollup node requests are rate limited.",
+		EnvVars: prefixEnvVars("ROLLUP_RPC_RATE_BURST"),
+		Value:   config.DefaultRollupRpcRateBurst,
+	}
+	MaxDeferredCyclesFlag = &cli.UintFlag{
+		Name:    "max-deferred-cycles",
+		Usage:   "Maximum number of consecutive cycles a game may dispute a block beyond the rollup node's output range before it is considered stuck.",
+		EnvVars: prefixEnvVars("MAX_DEFERRED_CYCLES"),
+		Value:   config.DefaultMaxDeferredCycles,
+	}
+	AgreementHeadFlag = &cli.GenericFlag{
+		Name:    "agreement-head",
+		Usage:   "Rollup node head to classify games against. Games disputing newer blocks are pending. Valid options: " + openum.EnumString(types.AgreementHeads),
+		EnvVars: prefixEnvVars("AGREEMENT_HEAD"),
+		Value: func() *types.AgreementHead {
+			head := config.DefaultAgreementHead
+			return &head
+		}(),
+	}
+	OutputForkBlockFlag = &cli.Uint64Flag{
+		Name:    "output-fork-block",
+		Usage:   "First L2 block using an upgraded output root format. Games disputing earlier blocks are compared against the legacy output root format. Only required while migrating.",
+		EnvVars: prefixEnvVars("OUTPUT_FORK_BLOCK"),
+	}
+	PinnedL1BlockFlag = &cli.Uint64Flag{
+		Name:    "pinned-l1-block",
+		Usage:   "Finalized L1 block number to make all on-chain reads at, so every cycle evaluates the same snapshot of L1 state. Defaults to the latest block.",
+		EnvVars: prefixEnvVars("PINNED_L1_BLOCK"),
+	}
+	MaxL2BlockNumberFlag = &cli.Uint64Flag{
+		Name:    "max-l2-block-number",
+		Usage:   "Largest L2 block number a game may plausibly dispute. Games disputing a later block or block 0 are reported and not classified. Set to 0 to only reject block 0.",
+		EnvVars: prefixEnvVars("MAX_L2_BLOCK_NUMBER"),
+	}
+	MetadataTimeoutFlag = &cli.DurationFlag{
+		Name:    "metadata-timeout",
+		Usage:   "Maximum time allowed to load a game's metadata and claims. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("METADATA_TIMEOUT"),
+		Value:   config.DefaultMetadataTimeout,
+	}
+	ComparisonTimeoutFlag = &cli.DurationFlag{
+		Name:    "comparison-timeout",
+		Usage:   "Maximum time allowed to compare a game's root claim against the rollup node. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("COMPARISON_TIMEOUT"),
+		Value:   config.DefaultComparisonTimeout,
+	}
+	ClockSkewToleranceFlag = &cli.DurationFlag{
+		Name:    "clock-skew-tolerance",
+		Usage:   "Maximum time a game's creation timestamp may be in the future before it is reported.",
+		EnvVars: prefixEnvVars("CLOCK_SKEW_TOLERANCE"),
+		Value:   config.DefaultClockSkewTolerance,
+	}
+	MaxClockSkewFlag = &cli.DurationFlag{
+		Name:    "max-clock-skew",
+		Usage:   "Maximum divergence of local time from the latest L1 block timestamp before time based classifications use L1 time instead. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("MAX_CLOCK_SKEW"),
+	}
+	StartupGraceCyclesFlag = &cli.UintFlag{
+		Name:    "startup-grace-cycles",
+		Usage:   "Number of monitoring cycles after startup in which games are loaded but not reported, so transient failures while connections and caches are cold don't alert. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("STARTUP_GRACE_CYCLES"),
+	}
+	ErrorRateThresholdFlag = &cli.Float64Flag{
+		Name:    "error-rate-threshold",
+		Usage:   "Fraction of games failing to load in a monitoring cycle above which a warning is logged. Ignored games are excluded. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("ERROR_RATE_THRESHOLD"),
+	}
+	SelfCheckIntervalFlag = &cli.UintFlag{
+		Name:    "self-check-interval",
+		Usage:   "Number of monitoring cycles between checks that the rollup node returns identical outputs when its finalized head is requested twice. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("SELF_CHECK_INTERVAL"),
+	}
+	CycleDeadlineFlag = &cli.DurationFlag{
+		Name:    "cycle-deadline",
+		Usage:   "Maximum time allowed to load games in a monitoring cycle. Cycles exceeding it are cancelled without reporting partial results. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("CYCLE_DEADLINE"),
+	}
+	ShutdownGracePeriodFlag = &cli.DurationFlag{
+		Name:    "shutdown-grace-period",
+		Usage:   "Maximum time to wait for an in-flight monitoring cycle to complete when shutting down.",
+		EnvVars: prefixEnvVars("SHUTDOWN_GRACE_PERIOD"),
+		Value:   config.DefaultShutdownGracePeriod,
+	}
+	BackfillWindowFlag = &cli.DurationFlag{
+		Name: "backfill-window",
+		Usage: "Maximum age of games older than the game window to check once in the background on startup. " +
+			"Must be longer than the game window. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("BACKFILL_WINDOW"),
+	}
+	BackfillIntervalFlag = &cli.DurationFlag{
+		Name:    "backfill-interval",
+		Usage:   "Minimum time between games loaded by the backfill, limiting the load it adds to the rollup node.",
+		EnvVars: prefixEnvVars("BACKFILL_INTERVAL"),
+		Value:   config.DefaultBackfillInterval,
+	}
+	CircuitBreakerThresholdFlag = &cli.UintFlag{
+		Name:    "circuit-breaker-threshold",
+		Usage:   "Number of consecutive cycles in which no game could be loaded before monitoring is paused. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("CIRCUIT_BREAKER_THRESHOLD"),
+	}
+	CircuitBreakerCooldownFlag = &cli.DurationFlag{
+		Name:    "circuit-breaker-cooldown",
+		Usage:   "Time monitoring is paused for once the circuit breaker opens, before a single cycle is retried.",
+		EnvVars: prefixEnvVars("CIRCUIT_BREAKER_COOLDOWN"),
+		Value:   config.DefaultCircuitBreakerCooldown,
+	}
+	ProposerSilenceThresholdFlag = &cli.DurationFlag{
+		Name:    "proposer-silence-threshold",
+		Usage:   "Time without a new game appearing before the proposer is reported as silent. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("PROPOSER_SILENCE_THRESHOLD"),
+	}
+	HighActivityThresholdFlag = &cli.UintFlag{
+		Name:    "high-activity-threshold",
+		Usage:   "Number of moves and steps in an in-progress game above which it is reported as high activity. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("HIGH_ACTIVITY_THRESHOLD"),
+	}
+	MaxAffordableBondFlag = &cli.StringFlag{
+		Name:    "max-affordable-bond",
+		Usage:   "Bond in wei required for the next move in an in-progress game above which it is reported as unaffordable. Disabled if not set.",
+		EnvVars: prefixEnvVars("MAX_AFFORDABLE_BOND"),
+	}
+	MetadataFromEventsFlag = &cli.BoolFlag{
+		Name:    "metadata-from-events",
+		Usage:   "Load each game's status from the events emitted by the game rather than contract calls. Reduces calls to L1 providers with cheap log queries.",
+		EnvVars: prefixEnvVars("METADATA_FROM_EVENTS"),
+	}
+	IncrementalDetectionFlag = &cli.BoolFlag{
+		Name:    "incremental-detection",
+		Usage:   "Only load games that may have changed since the last processed L1 block. Games already resolved are not loaded again. Can't be used with --resolved-cache-grace.",
+		EnvVars: prefixEnvVars("INCREMENTAL_DETECTION"),
+	}
+	FullRescanIntervalFlag = &cli.UintFlag{
+		Name:    "full-rescan-interval",
+		Usage:   "Number of monitoring cycles between full rescans of every game when incremental detection is enabled. Bond credits and withdrawals of resolved games are only refreshed by a full rescan.",
+		EnvVars: prefixEnvVars("FULL_RESCAN_INTERVAL"),
+		Value:   config.DefaultFullRescanInterval,
+	}
+	CycleEventsFlag = &cli.BoolFlag{
+		Name:    "cycle-events",
+		Usage:   "Write a single JSON event summarising each monitoring cycle to stdout, in addition to the regular logs.",
+		EnvVars: prefixEnvVars("CYCLE_EVENTS"),
+	}
+	StatusSocketFlag = &cli.StringFlag{
+		Name:    "status-socket",
+		Usage:   "Path of a UNIX domain socket to serve a summary of the latest monitoring cycle on. Disabled if not set.",
+		EnvVars: prefixEnvVars("STATUS_SOCKET"),
+	}
+	StatusSigningKeyFlag = &cli.StringFlag{
+		Name:    "status-signing-key",
+		Usage:   "Hex encoded 32 byte ed25519 private key seed to sign each status summary with, so consumers can verify its integrity. Summaries are unsigned if not set.",
+		EnvVars: prefixEnvVars("STATUS_SIGNING_KEY"),
+	}
+	OverridesFileFlag = &cli.StringFlag{
+		Name:    "overrides-file",
+		Usage:   "Path of a JSON file mapping game addresses to \"suppress\", \"agree\" or \"disagree\" to override their classification. Reloaded when changed. Disabled if not set.",
+		EnvVars: prefixEnvVars("OVERRIDES_FILE"),
+	}
+	CanaryGameFlag = &cli.StringFlag{
+		Name:    "canary-game",
+		Usage:   "Address of a game with a known classification that must be classified as expected every cycle. Disabled if not set.",
+		EnvVars: prefixEnvVars("CANARY_GAME"),
+	}
+	CanaryClassificationFlag = &cli.StringFlag{
+		Name:    "canary-classification",
+		Usage:   "Expected classification of the canary game, either \"agree\" or \"disagree\" with its root claim.",
+		EnvVars: prefixEnvVars("CANARY_CLASSIFICATION"),
+		Value:   "agree",
+	}
+	ExpectedBondTokenFlag = &cli.StringFlag{
+		Name:    "expected-bond-token",
+		Usage:   "Address of the DelayedWETH contract games are expected to hold bonds in. Games using any other bond token are reported. Disabled if not set.",
+		EnvVars: prefixEnvVars("EXPECTED_BOND_TOKEN"),
+	}
+	AnchorStateRegistryFlag = &cli.StringFlag{
+		Name:    "anchor-state-registry",
+		Usage:   "Address of the AnchorStateRegistry contract to report the age of the anchor state from. Disabled if not set.",
+		EnvVars: prefixEnvVars("ANCHOR_STATE_REGISTRY"),
+	}
+	AnchorGameTypeFlag = &cli.UintFlag{
+		Name:    "anchor-game-type",
+		Usage:   "Game type to report the age of the anchor state for.",
+		EnvVars: prefixEnvVars("ANCHOR_GAME_TYPE"),
+	}
+	AnchorAgeThresholdFlag = &cli.DurationFlag{
+		Name:    "anchor-age-threshold",
+		Usage:   "Age of the anchor state's L2 block above which the anchor is reported as stale. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("ANCHOR_AGE_THRESHOLD"),
+	}
+	ResultLogSeveritiesFlag = &cli.StringSliceFlag{
+		Name:    "result-log-severities",
+		Usage:   "List of severities of game results to log each cycle. Valid options: info, warning, critical. Disabled if not set.",
+		EnvVars: prefixEnvVars("RESULT_LOG_SEVERITIES"),
+	}
+	ResultsPathFlag = &cli.StringFlag{
+		Name:    "results-path",
+		Usage:   "Path of a file to append the result of each game monitored in a cycle to as JSON lines. Disabled if not set.",
+		EnvVars: prefixEnvVars("RESULTS_PATH"),
+	}
+	ResultsPathSeveritiesFlag = &cli.StringSliceFlag{
+		Name:    "results-path-severities",
+		Usage:   "List of severities of game results to append to the results path. Valid options: info, warning, critical. All severities if not set.",
+		EnvVars: prefixEnvVars("RESULTS_PATH_SEVERITIES"),
+	}
+	HistoryPathFlag = &cli.StringFlag{
+		Name:    "history-path",
+		Usage:   "Path of a file to append the result of each monitoring cycle to as JSON lines. Disabled if not set.",
+		EnvVars: prefixEnvVars("HISTORY_PATH"),
+	}
+	HistoryMaxSizeFlag = &cli.Uint64Flag{
+		Name:    "history-max-size",
+		Usage:   "Size in bytes at which the history file is rotated. Set to 0 to disable size based rotation.",
+		EnvVars: prefixEnvVars("HISTORY_MAX_SIZE"),
+	}
+	HistoryRotateDailyFlag = &cli.BoolFlag{
+		Name:    "history-rotate-daily",
+		Usage:   "Rotate the history file at the start of each UTC day.",
+		EnvVars: prefixEnvVars("HISTORY_ROTATE_DAILY"),
+	}
+	TransitionsPathFlag = &cli.StringFlag{
+		Name:    "transitions-path",
+		Usage:   "Path of a file to append each change in a game's classification to as JSON lines. Disabled if not set.",
+		EnvVars: prefixEnvVars("TRANSITIONS_PATH"),
+	}
+	TransitionsMaxSizeFlag = &cli.Uint64Flag{
+		Name:    "transitions-max-size",
+		Usage:   "Size in bytes at which the transitions file is rotated. Set to 0 to disable rotation.",
+		EnvVars: prefixEnvVars("TRANSITIONS_MAX_SIZE"),
+	}
+	ResolvedCacheGraceFlag = &cli.DurationFlag{
+		Name:    "resolved-cache-grace",
+		Usage:   "Time a resolved game's classification must remain unchanged before it is cached and no longer re-evaluated each cycle. Set to 0 to disable.",
+		EnvVars: prefixEnvVars("RESOLVED_CACHE_GRACE"),
+	}
+	DryRunFlag = &cli.BoolFlag{
+		Name:    "dry-run",
+		Usage:   "Run all monitoring logic without recording metrics, logging game classifications instead. Useful to validate config before going live.",
+		EnvVars: prefixEnvVars("DRY_RUN"),
+	}
 )
 
+// ExportOutputFlag is only used by the export-games command so is not included in Flags.
+var ExportOutputFlag = &cli.StringFlag{
+	Name:    "output",
+	Usage:   "Path of the file to write exported games to as CSV. Written to stdout if not set.",
+	EnvVars: prefixEnvVars("EXPORT_OUTPUT"),
+}
+
 // requiredFlags are checked by [CheckRequired]
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
@@ -85,9 +423,69 @@ var optionalFlags = []cli.Flag{
 	NetworkFlag,
 	HonestActorsFlag,
 	MonitorIntervalFlag,
+	MonitorIntervalJitterFlag,
 	GameWindowFlag,
+	AdditionalGameFactoriesFlag,
 	IgnoredGamesFlag,
+	BenignGamesFlag,
+	TrustedProposersFlag,
 	MaxConcurrencyFlag,
+	GameTypesFlag,
+	GameStatusesFlag,
+	MinBondFlag,
+	SampleRateFlag,
+	DisagreementSmoothingFlag,
+	GameGroupingFlag,
+	ChainRollupRpcsFlag,
+	GameFactoryChainIDsFlag,
+	ChainOutputDomainsFlag,
+	ArchiveRollupRpcFlag,
+	RollupRpcRateLimitFlag,
+	RollupRpcRateBurstFlag,
+	MaxDeferredCyclesFlag,
+	AgreementHeadFlag,
+	OutputForkBlockFlag,
+	PinnedL1BlockFlag,
+	MaxL2BlockNumberFlag,
+	MetadataTimeoutFlag,
+	ComparisonTimeoutFlag,
+	ClockSkewToleranceFlag,
+	MaxClockSkewFlag,
+	StartupGraceCyclesFlag,
+	ErrorRateThresholdFlag,
+	SelfCheckIntervalFlag,
+	ShutdownGracePeriodFlag,
+	CycleDeadlineFlag,
+	BackfillWindowFlag,
+	BackfillIntervalFlag,
+	CircuitBreakerThresholdFlag,
+	CircuitBreakerCooldownFlag,
+	ProposerSilenceThresholdFlag,
+	HighActivityThresholdFlag,
+	MaxAffordableBondFlag,
+	DryRunFlag,
+	MetadataFromEventsFlag,
+	IncrementalDetectionFlag,
+	FullRescanIntervalFlag,
+	CycleEventsFlag,
+	StatusSocketFlag,
+	StatusSigningKeyFlag,
+	OverridesFileFlag,
+	CanaryGameFlag,
+	CanaryClassificationFlag,
+	ExpectedBondTokenFlag,
+	AnchorStateRegistryFlag,
+	AnchorGameTypeFlag,
+	AnchorAgeThresholdFlag,
+	ResultLogSeveritiesFlag,
+	ResultsPathFlag,
+	ResultsPathSeveritiesFlag,
+	HistoryPathFlag,
+	HistoryMaxSizeFlag,
+	HistoryRotateDailyFlag,
+	TransitionsPathFlag,
+	TransitionsMaxSizeFlag,
+	ResolvedCacheGraceFlag,
 }
 
 func init() {
@@ -131,6 +529,76 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		}
 	}
 
+	var additionalFactories []common.Address
+	if ctx.IsSet(AdditionalGameFactoriesFlag.Name) {
+		for _, addrStr := range ctx.StringSlice(AdditionalGameFactoriesFlag.Name) {
+			factory, err := opservice.ParseAddress(addrStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid additional game factory address: %w", err)
+			}
+			additionalFactories = append(additionalFactories, factory)
+		}
+	}
+
+	var chainRollupRpcs map[uint64]string
+	if ctx.IsSet(ChainRollupRpcsFlag.Name) {
+		chainRollupRpcs = make(map[uint64]string)
+	}
+	for _, entry := range ctx.StringSlice(ChainRollupRpcsFlag.Name) {
+		chainIDStr, rpc, ok := strings.Cut(entry, "=")
+		if !ok || rpc == "" {
+			return nil, fmt.Errorf("invalid chain rollup rpc, expected <chain-id>=<url>: %v", entry)
+		}
+		chainID, err := parseL2ChainID(chainIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain rollup rpc: %w", err)
+		}
+		chainRollupRpcs[chainID] = rpc
+	}
+
+	var factoryChainIDs map[common.Address]uint64
+	if ctx.IsSet(GameFactoryChainIDsFlag.Name) {
+		factoryChainIDs = make(map[common.Address]uint64)
+	}
+	for _, entry := range ctx.StringSlice(GameFactoryChainIDsFlag.Name) {
+		factoryStr, chainIDStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid game factory chain id, expected <factory-address>=<chain-id>: %v", entry)
+		}
+		factory, err := opservice.ParseAddress(factoryStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid game factory chain id: %w", err)
+		}
+		chainID, err := parseL2ChainID(chainIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid game factory chain id: %w", err)
+		}
+		factoryChainIDs[factory] = chainID
+	}
+
+	var chainOutputDomains map[uint64]common.Hash
+	if ctx.IsSet(ChainOutputDomainsFlag.Name) {
+		chainOutputDomains = make(map[uint64]common.Hash)
+	}
+	for _, entry := range ctx.StringSlice(ChainOutputDomainsFlag.Name) {
+		chainIDStr, domainStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid chain output domain, expected <chain-id>=<domain>: %v", entry)
+		}
+		chainID, err := parseL2ChainID(chainIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain output domain: %w", err)
+		}
+		domain, err := parseHash(domainStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain output domain: %w", err)
+		}
+		if _, ok := chainRollupRpcs[chainID]; !ok {
+			return nil, fmt.Errorf("invalid chain output domain: no %v configured for l2 chain %v", ChainRollupRpcsFlag.Name, chainID)
+		}
+		chainOutputDomains[chainID] = domain
+	}
+
 	var ignoredGames []common.Address
 	if ctx.IsSet(IgnoredGamesFlag.Name) {
 		for _, addrStr := range ctx.StringSlice(IgnoredGamesFlag.Name) {
@@ -142,11 +610,191 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		}
 	}
 
+	var trustedProposers []common.Address
+	if ctx.IsSet(TrustedProposersFlag.Name) {
+		for _, addrStr := range ctx.StringSlice(TrustedProposersFlag.Name) {
+			proposer, err := opservice.ParseAddress(addrStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proposer address: %w", err)
+			}
+			trustedProposers = append(trustedProposers, proposer)
+		}
+	}
+
+	var benignGames []common.Address
+	if ctx.IsSet(BenignGamesFlag.Name) {
+		for _, addrStr := range ctx.StringSlice(BenignGamesFlag.Name) {
+			game, err := opservice.ParseAddress(addrStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid benign game address: %w", err)
+			}
+			benignGames = append(benignGames, game)
+		}
+	}
+
+	var gameTypes []uint32
+	if ctx.IsSet(GameTypesFlag.Name) {
+		for _, gameType := range ctx.UintSlice(GameTypesFlag.Name) {
+			gameTypes = append(gameTypes, uint32(gameType))
+		}
+	}
+
+	var gameStatuses []challengerTypes.GameStatus
+	if ctx.IsSet(GameStatusesFlag.Name) {
+		for _, name := range ctx.StringSlice(GameStatusesFlag.Name) {
+			status, ok := types.GameStatusName(name).GameStatus()
+			if !ok {
+				return nil, fmt.Errorf("invalid game status: %v", name)
+			}
+			gameStatuses = append(gameStatuses, status)
+		}
+	}
+
+	var statusSigningKey ed25519.PrivateKey
+	if ctx.IsSet(StatusSigningKeyFlag.Name) {
+		seed, err := hexutil.Decode(ctx.String(StatusSigningKeyFlag.Name))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid status signing key: must be a hex encoded %v byte seed", ed25519.SeedSize)
+		}
+		statusSigningKey = ed25519.NewKeyFromSeed(seed)
+	}
+
+	var minBond *big.Int
+	if ctx.IsSet(MinBondFlag.Name) {
+		bond, ok := new(big.Int).SetString(ctx.String(MinBondFlag.Name), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid min bond: %v", ctx.String(MinBondFlag.Name))
+		}
+		if bond.Sign() < 0 {
+			return nil, config.ErrNegativeMinBond
+		}
+		minBond = bond
+	}
+
+	var maxAffordableBond *big.Int
+	if ctx.IsSet(MaxAffordableBondFlag.Name) {
+		bond, ok := new(big.Int).SetString(ctx.String(MaxAffordableBondFlag.Name), 10)
+		if !ok || bond.Sign() < 0 {
+			return nil, fmt.Errorf("invalid max affordable bond: %v", ctx.String(MaxAffordableBondFlag.Name))
+		}
+		maxAffordableBond = bond
+	}
+
+	sampleRate := ctx.Float64(SampleRateFlag.Name)
+	if sampleRate <= 0 || sampleRate > 1 {
+		return nil, config.ErrInvalidSampleRate
+	}
+
+	monitorIntervalJitter := ctx.Float64(MonitorIntervalJitterFlag.Name)
+	if monitorIntervalJitter < 0 || monitorIntervalJitter >= 1 {
+		return nil, config.ErrInvalidIntervalJitter
+	}
+
+	errorRateThreshold := ctx.Float64(ErrorRateThresholdFlag.Name)
+	if errorRateThreshold < 0 || errorRateThreshold > 1 {
+		return nil, config.ErrInvalidErrorRateThreshold
+	}
+
+	disagreementSmoothing := ctx.Float64(DisagreementSmoothingFlag.Name)
+	if disagreementSmoothing <= 0 || disagreementSmoothing > 1 {
+		return nil, config.ErrInvalidDisagreementSmoothing
+	}
+
 	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
 	if maxConcurrency == 0 {
 		return nil, fmt.Errorf("%v must not be 0", MaxConcurrencyFlag.Name)
 	}
 
+	rollupRpcRateLimit := ctx.Float64(RollupRpcRateLimitFlag.Name)
+	if rollupRpcRateLimit < 0 {
+		return nil, config.ErrInvalidRollupRpcRateLimit
+	}
+	rollupRpcRateBurst := ctx.Uint(RollupRpcRateBurstFlag.Name)
+	if rollupRpcRateLimit > 0 && rollupRpcRateBurst == 0 {
+		return nil, fmt.Errorf("%v must not be 0 when %v is set", RollupRpcRateBurstFlag.Name, RollupRpcRateLimitFlag.Name)
+	}
+
+	backfillWindow := ctx.Duration(BackfillWindowFlag.Name)
+	if backfillWindow != 0 && backfillWindow <= ctx.Duration(GameWindowFlag.Name) {
+		return nil, fmt.Errorf("%v must be longer than %v", BackfillWindowFlag.Name, GameWindowFlag.Name)
+	}
+	backfillInterval := ctx.Duration(BackfillIntervalFlag.Name)
+	if backfillWindow != 0 && backfillInterval == 0 {
+		return nil, fmt.Errorf("%v must not be 0 when %v is set", BackfillIntervalFlag.Name, BackfillWindowFlag.Name)
+	}
+
+	circuitBreakerThreshold := ctx.Uint(CircuitBreakerThresholdFlag.Name)
+	circuitBreakerCooldown := ctx.Duration(CircuitBreakerCooldownFlag.Name)
+	if circuitBreakerThreshold != 0 && circuitBreakerCooldown == 0 {
+		return nil, fmt.Errorf("%v must not be 0 when %v is set", CircuitBreakerCooldownFlag.Name, CircuitBreakerThresholdFlag.Name)
+	}
+
+	maxDeferredCycles := ctx.Uint(MaxDeferredCyclesFlag.Name)
+	if maxDeferredCycles == 0 {
+		return nil, fmt.Errorf("%v must not be 0", MaxDeferredCyclesFlag.Name)
+	}
+
+	var outputForkBlock *uint64
+	if ctx.IsSet(OutputForkBlockFlag.Name) {
+		forkBlock := ctx.Uint64(OutputForkBlockFlag.Name)
+		outputForkBlock = &forkBlock
+	}
+
+	var pinnedL1Block *uint64
+	if ctx.IsSet(PinnedL1BlockFlag.Name) {
+		pinned := ctx.Uint64(PinnedL1BlockFlag.Name)
+		pinnedL1Block = &pinned
+	}
+
+	var canaryGame common.Address
+	var canaryAgree bool
+	if ctx.IsSet(CanaryGameFlag.Name) {
+		game, err := opservice.ParseAddress(ctx.String(CanaryGameFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid canary game address: %w", err)
+		}
+		canaryGame = game
+		switch classification := ctx.String(CanaryClassificationFlag.Name); classification {
+		case "agree":
+			canaryAgree = true
+		case "disagree":
+			canaryAgree = false
+		default:
+			return nil, fmt.Errorf("invalid canary classification: %v", classification)
+		}
+	}
+
+	var expectedBondToken common.Address
+	if ctx.IsSet(ExpectedBondTokenFlag.Name) {
+		token, err := opservice.ParseAddress(ctx.String(ExpectedBondTokenFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid expected bond token address: %w", err)
+		}
+		expectedBondToken = token
+	}
+
+	var anchorStateRegistry common.Address
+	if ctx.IsSet(AnchorStateRegistryFlag.Name) {
+		registry, err := opservice.ParseAddress(ctx.String(AnchorStateRegistryFlag.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor state registry address: %w", err)
+		}
+		anchorStateRegistry = registry
+	}
+	anchorGameType := ctx.Uint(AnchorGameTypeFlag.Name)
+	if anchorGameType > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid anchor game type: %v", anchorGameType)
+	}
+
+	resultLogSeverities, err := parseSeverities(ctx.StringSlice(ResultLogSeveritiesFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	resultsPathSeverities, err := parseSeverities(ctx.StringSlice(ResultsPathSeveritiesFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
 
@@ -155,13 +803,137 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		GameFactoryAddress: gameFactoryAddress,
 		RollupRpc:          ctx.String(RollupRpcFlag.Name),
 
+		AdditionalGameFactories: additionalFactories,
+
 		HonestActors:    actors,
 		MonitorInterval: ctx.Duration(MonitorIntervalFlag.Name),
 		GameWindow:      ctx.Duration(GameWindowFlag.Name),
 		IgnoredGames:    ignoredGames,
 		MaxConcurrency:  maxConcurrency,
+		GameTypes:       gameTypes,
+		MinBond:         minBond,
+		SampleRate:      sampleRate,
+
+		MonitorIntervalJitter: monitorIntervalJitter,
+
+		GameStatuses: gameStatuses,
+
+		TrustedProposers: trustedProposers,
+
+		BenignGames: benignGames,
+
+		DisagreementSmoothing: disagreementSmoothing,
+
+		GameGrouping: *ctx.Generic(GameGroupingFlag.Name).(*types.GameGrouping),
+
+		ChainRollupRpcs:     chainRollupRpcs,
+		GameFactoryChainIDs: factoryChainIDs,
+		ChainOutputDomains:  chainOutputDomains,
+
+		ArchiveRollupRpc: ctx.String(ArchiveRollupRpcFlag.Name),
+
+		RollupRpcRateLimit: rollupRpcRateLimit,
+		RollupRpcRateBurst: rollupRpcRateBurst,
+
+		MaxDeferredCycles: maxDeferredCycles,
+		AgreementHead:     *ctx.Generic(AgreementHeadFlag.Name).(*types.AgreementHead),
+		OutputForkBlock:   outputForkBlock,
+		PinnedL1Block:     pinnedL1Block,
+		MaxL2BlockNumber:  ctx.Uint64(MaxL2BlockNumberFlag.Name),
+
+		MetadataTimeout:     ctx.Duration(MetadataTimeoutFlag.Name),
+		ComparisonTimeout:   ctx.Duration(ComparisonTimeoutFlag.Name),
+		ClockSkewTolerance:  ctx.Duration(ClockSkewToleranceFlag.Name),
+		MaxClockSkew:        ctx.Duration(MaxClockSkewFlag.Name),
+		ShutdownGracePeriod: ctx.Duration(ShutdownGracePeriodFlag.Name),
+		CycleDeadline:       ctx.Duration(CycleDeadlineFlag.Name),
+
+		StartupGraceCycles: ctx.Uint(StartupGraceCyclesFlag.Name),
+
+		ErrorRateThreshold: errorRateThreshold,
+
+		SelfCheckInterval: ctx.Uint(SelfCheckIntervalFlag.Name),
+
+		ResolvedCacheGrace: ctx.Duration(ResolvedCacheGraceFlag.Name),
+
+		MetadataFromEvents: ctx.Bool(MetadataFromEventsFlag.Name),
+
+		IncrementalDetection: ctx.Bool(IncrementalDetectionFlag.Name),
+		FullRescanInterval:   ctx.Uint(FullRescanIntervalFlag.Name),
+
+		DryRun: ctx.Bool(DryRunFlag.Name),
+
+		BackfillWindow:   backfillWindow,
+		BackfillInterval: backfillInterval,
+
+		CircuitBreakerThreshold: circuitBreakerThreshold,
+		CircuitBreakerCooldown:  circuitBreakerCooldown,
+
+		CycleEvents: ctx.Bool(CycleEventsFlag.Name),
+
+		StatusSocket:     ctx.String(StatusSocketFlag.Name),
+		StatusSigningKey: statusSigningKey,
+
+		OverridesFile: ctx.String(OverridesFileFlag.Name),
+
+		CanaryGame:           canaryGame,
+		CanaryAgreeWithClaim: canaryAgree,
+
+		ExpectedBondToken: expectedBondToken,
+
+		ProposerSilenceThreshold: ctx.Duration(ProposerSilenceThresholdFlag.Name),
+
+		HighActivityThreshold: ctx.Uint(HighActivityThresholdFlag.Name),
+
+		MaxAffordableBond: maxAffordableBond,
+
+		AnchorStateRegistry: anchorStateRegistry,
+		AnchorGameType:      uint32(anchorGameType),
+		AnchorAgeThreshold:  ctx.Duration(AnchorAgeThresholdFlag.Name),
+
+		ResultLogSeverities:   resultLogSeverities,
+		ResultsPath:           ctx.String(ResultsPathFlag.Name),
+		ResultsPathSeverities: resultsPathSeverities,
+
+		HistoryPath:        ctx.String(HistoryPathFlag.Name),
+		HistoryMaxSize:     ctx.Uint64(HistoryMaxSizeFlag.Name),
+		HistoryRotateDaily: ctx.Bool(HistoryRotateDailyFlag.Name),
+
+		TransitionsPath:    ctx.String(TransitionsPathFlag.Name),
+		TransitionsMaxSize: ctx.Uint64(TransitionsMaxSizeFlag.Name),
 
 		MetricsConfig: metricsConfig,
 		PprofConfig:   pprofConfig,
 	}, nil
 }
+
+func parseL2ChainID(value string) (uint64, error) {
+	chainID, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid l2 chain id %v: %w", value, err)
+	}
+	if chainID == 0 {
+		return 0, config.ErrInvalidL2ChainID
+	}
+	return chainID, nil
+}
+
+func parseHash(value string) (common.Hash, error) {
+	b, err := hexutil.Decode(value)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid hash: %v", value)
+	}
+	return common.BytesToHash(b), nil
+}
+
+func parseSeverities(names []string) ([]results.Severity, error) {
+	var severities []results.Severity
+	for _, name := range names {
+		severity, err := results.ParseSeverity(name)
+		if err != nil {
+			return nil, err
+		}
+		severities = append(severities, severity)
+	}
+	return severities, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,9 @@ import (
 
 const Namespace = "op_dispute_mon"
 
+// gamesPerCycleBuckets are the histogram buckets for the number of games in a monitoring cycle.
+var gamesPerCycleBuckets = []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
 type ResolutionStatus uint8
 
 const (
@@ -67,6 +71,16 @@ const (
 	DisagreeChallengerWins
 )
 
+// ProjectedOutcome is the outcome an in progress game would have if it resolved with the current claims.
+type ProjectedOutcome uint8
+
+const (
+	// ProjectedOutcomeFavorable means the game would resolve in line with the reference node.
+	ProjectedOutcomeFavorable ProjectedOutcome = iota
+	// ProjectedOutcomeUnfavorable means the game would resolve against the reference node.
+	ProjectedOutcomeUnfavorable
+)
+
 type ClaimStatus struct {
 	resolved     bool
 	clockExpired bool
@@ -183,6 +197,139 @@ type Metricer interface {
 
 	RecordL2Challenges(agreement bool, count int)
 
+	RecordBeyondOutputRangeGames(count int)
+
+	RecordAgreementByBlockAge(bucket string, status string, count int)
+
+	RecordCyclesSinceLastDisagreement(count int)
+
+	RecordGameResolutionLatency(d time.Duration)
+
+	RecordProjectedOutcome(outcome ProjectedOutcome, count int)
+
+	RecordUnknownStatusGames(raw uint8, count int)
+
+	RecordHonestActorStanding(favorable, unfavorable int)
+
+	RecordStuckDeferredGames(count int)
+
+	RecordPendingGames(count int)
+
+	RecordFutureTimestampGames(count int)
+
+	RecordSingleflightCoalesced()
+
+	RecordFilteredGames(count int)
+
+	RecordHistoryWriteErrors()
+
+	RecordRootMismatchDetails()
+
+	RecordRateLimited(count int)
+
+	RecordMaxGamesPerBlockBucket(count int)
+
+	RecordRollupUnhealthy()
+
+	RecordRollupInternalInconsistency()
+
+	RecordGameAgreementByFactory(factory common.Address, status GameAgreementStatus, count int)
+
+	RecordSampledGames(processed, total int)
+
+	RecordResultPublishErrors()
+
+	RecordArchiveFallbackCount(count int)
+
+	RecordIndeterminateGames(count int)
+
+	RecordRollupInconsistentGames(count int)
+
+	RecordClaimAgreement(agree, disagree int)
+
+	RecordCanaryFailure(failed bool)
+
+	RecordRootClaimChanged(count int)
+
+	RecordUnexpectedBondToken(count int)
+
+	RecordGamesPerCycle(n int)
+	RecordFailedGamesPerCycle(n int)
+
+	RecordCycleErrorRate(rate float64)
+
+	RecordNextPollDelay(d time.Duration)
+
+	RecordUnknownChainGames(chainID uint64)
+
+	RecordTimeSinceLastFavorableResolution(dur time.Duration)
+
+	RecordGameLatencyPercentiles(p50, p90, p99 time.Duration)
+
+	RecordGameAgreementByL1Chain(chainID uint64, status GameAgreementStatus, count int)
+
+	RecordGameAgreementByGroup(group string, status GameAgreementStatus, count int)
+
+	RecordDuplicateGameClaims(groups int)
+
+	RecordBackfillGames(processed, incorrect, failed int)
+
+	RecordMaxDistinctClaimsPerBlock(count int)
+
+	RecordCircuitBreakerState(open bool)
+
+	RecordCycleDiff(added, removed, changed int)
+
+	RecordProposerSilence(silent bool)
+
+	RecordValueAtRisk(status GameAgreementStatus, wei *big.Int)
+
+	RecordVanishedGames(count int)
+
+	RecordRollupAheadRatio(ratio float64)
+
+	RecordOurTurnPending(count int)
+
+	RecordHighActivityGames(count int)
+
+	RecordDisagreementRateEWMA(rate float64)
+
+	RecordClockSkew(seconds float64)
+
+	RecordSelfConsistencyFailure()
+
+	RecordIncrementalGamesProcessed(count int)
+
+	RecordImplausibleBlockGames(count int)
+
+	RecordProjectionAccuracy(projected, actual ProjectedOutcome, count int)
+
+	RecordAtRiskGames(count int)
+
+	RecordCrossChainRootGames(count int)
+
+	RecordUnaffordableMoveGames(count int)
+
+	RecordContractCacheReuse(reused, created int)
+
+	RecordIncorrectResolutions(count int)
+
+	RecordCycleTimeouts()
+
+	RecordGamesByStatus(status string, count int)
+
+	RecordPrematureResolutions(count int)
+
+	RecordInProgressGameAge(d time.Duration)
+
+	RecordCustomRule(name string, bucket string, count int)
+
+	RecordProposalLag(blocks float64)
+
+	RecordAnchorAge(d time.Duration)
+
+	RecordDepthAnomalies(count int)
+
 	caching.Metrics
 	contractMetrics.ContractMetricer
 }
@@ -226,6 +373,139 @@ type Metrics struct {
 
 	requiredCollateral  prometheus.GaugeVec
 	availableCollateral prometheus.GaugeVec
+
+	beyondOutputRangeGames prometheus.Gauge
+
+	agreementByBlockAge prometheus.GaugeVec
+
+	cyclesSinceLastDisagreement prometheus.Gauge
+
+	gameResolutionLatency prometheus.Histogram
+
+	projectedOutcomes prometheus.GaugeVec
+
+	unknownStatusGames prometheus.GaugeVec
+
+	honestActorStanding prometheus.GaugeVec
+
+	stuckDeferredGames prometheus.Gauge
+
+	pendingGames prometheus.Gauge
+
+	futureTimestampGames prometheus.Gauge
+
+	singleflightCoalesced prometheus.Counter
+
+	filteredGames prometheus.Gauge
+
+	historyWriteErrors prometheus.Counter
+
+	rootMismatchDetails prometheus.Counter
+
+	rateLimited prometheus.Counter
+
+	maxGamesPerBlockBucket prometheus.Gauge
+
+	rollupUnhealthy prometheus.Counter
+
+	rollupInternalInconsistency prometheus.Counter
+
+	gamesAgreementByFactory prometheus.GaugeVec
+
+	sampledGames prometheus.GaugeVec
+
+	resultPublishErrors prometheus.Counter
+
+	archiveFallbackGames prometheus.Gauge
+
+	indeterminateGames prometheus.Gauge
+
+	rollupInconsistentGames prometheus.Gauge
+
+	claimAgreement prometheus.GaugeVec
+
+	canaryFailure prometheus.Gauge
+
+	rootClaimChanged prometheus.Gauge
+
+	unexpectedBondToken prometheus.Gauge
+
+	gamesPerCycle       prometheus.Histogram
+	failedGamesPerCycle prometheus.Histogram
+
+	cycleErrorRate prometheus.Gauge
+
+	nextPollDelay prometheus.Gauge
+
+	unknownChainGames prometheus.CounterVec
+
+	timeSinceFavorableResolution prometheus.Gauge
+
+	gameLatency prometheus.GaugeVec
+
+	gamesAgreementByL1Chain prometheus.GaugeVec
+
+	gamesAgreementByGroup prometheus.GaugeVec
+
+	duplicateGameClaims prometheus.Gauge
+
+	backfillGames prometheus.GaugeVec
+
+	maxDistinctClaimsPerBlock prometheus.Gauge
+
+	circuitBreakerOpen prometheus.Gauge
+
+	cycleDiff prometheus.GaugeVec
+
+	proposerSilence prometheus.Gauge
+
+	valueAtRisk prometheus.GaugeVec
+
+	vanishedGames prometheus.Gauge
+
+	rollupAheadRatio prometheus.Gauge
+
+	ourTurnPending prometheus.Gauge
+
+	highActivityGames prometheus.Gauge
+
+	disagreementRateEWMA prometheus.Gauge
+
+	clockSkew prometheus.Gauge
+
+	selfConsistencyFailures prometheus.Counter
+
+	incrementalGamesProcessed prometheus.Gauge
+
+	implausibleBlockGames prometheus.Gauge
+
+	projectionAccuracy prometheus.GaugeVec
+
+	atRiskGames prometheus.Gauge
+
+	crossChainRootGames prometheus.Gauge
+
+	unaffordableMoveGames prometheus.Gauge
+
+	contractCacheReuse prometheus.GaugeVec
+
+	incorrectResolutions prometheus.Gauge
+
+	cycleTimeouts prometheus.Counter
+
+	gamesByStatus prometheus.GaugeVec
+
+	prematureResolutions prometheus.Gauge
+
+	inProgressGameAge prometheus.Histogram
+
+	customRules prometheus.GaugeVec
+
+	proposalLag prometheus.Histogram
+
+	anchorAge prometheus.Gauge
+
+	depthAnomalies prometheus.Gauge
 }
 
 func (m *Metrics) Registry() *prometheus.Registry {
@@ -386,6 +666,412 @@ func NewMetrics() *Metrics {
 			// An l2 block number challenge with an agreement means the challenge was invalid.
 			"root_agreement",
 		}),
+		beyondOutputRangeGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "beyond_output_range_games",
+			Help:      "Number of games disputing a block beyond the latest block the reference node has an output for",
+		}),
+		agreementByBlockAge: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "games_agreement_by_block_age",
+			Help:      "Number of games broken down by root agreement and how far the disputed block was behind the reference node's safe head",
+		}, []string{
+			"age",
+			"root_agreement",
+		}),
+		cyclesSinceLastDisagreement: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cycles_since_last_disagreement",
+			Help:      "Number of consecutive monitoring cycles that found no games disagreeing with the reference node",
+		}),
+		gameResolutionLatency: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "game_resolution_latency_seconds",
+			Help:      "Time taken for games to go from creation to resolution",
+			Buckets: []float64{
+				(1 * time.Hour).Seconds(),
+				(12 * time.Hour).Seconds(),
+				(24 * time.Hour).Seconds(),
+				(48 * time.Hour).Seconds(),
+				(72 * time.Hour).Seconds(),
+				(84 * time.Hour).Seconds(),
+				(96 * time.Hour).Seconds(),
+				(120 * time.Hour).Seconds(),
+				(168 * time.Hour).Seconds(),
+			},
+		}),
+		projectedOutcomes: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "projected_outcomes",
+			Help:      "Number of in progress games by whether the currently leading claim would resolve in line with the reference node",
+		}, []string{
+			"outcome",
+		}),
+		unknownStatusGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "unknown_status_games",
+			Help:      "Number of games with a status value the monitor does not recognise",
+		}, []string{
+			"status",
+		}),
+		honestActorStanding: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "honest_actor_standing",
+			Help:      "Number of resolved games by whether the result matched the reference node. Favorable games either agree with the root claim and the defender won or disagree and the challenger won",
+		}, []string{
+			"outcome",
+		}),
+		stuckDeferredGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "stuck_deferred_games",
+			Help:      "Number of games that have remained beyond the reference node's output range for more than the maximum deferred cycles",
+		}),
+		pendingGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "pending_games",
+			Help:      "Number of games disputing a block newer than the reference node's agreement head that can't be classified yet",
+		}),
+		futureTimestampGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "future_timestamp_games",
+			Help:      "Number of games with a creation timestamp in the future, beyond the allowed clock skew",
+		}),
+		singleflightCoalesced: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rollup_calls_coalesced_total",
+			Help:      "Number of rollup node output requests served by an identical in-flight request",
+		}),
+		filteredGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "filtered_games",
+			Help:      "Number of games excluded from monitoring by the game type or minimum bond filter",
+		}),
+		historyWriteErrors: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "history_write_errors_total",
+			Help:      "Number of monitoring cycles that could not be written to the history file",
+		}),
+		rootMismatchDetails: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "root_mismatch_details_total",
+			Help:      "Number of root claim mismatches logged with the details of the conflicting output",
+		}),
+		rateLimited: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rollup_rate_limited_total",
+			Help:      "Number of rollup node output requests skipped because the rate limit would delay them beyond their deadline",
+		}),
+		maxGamesPerBlockBucket: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "max_games_per_block_bucket",
+			Help:      "Maximum number of games disputing blocks within a single range of 1000 L2 blocks",
+		}),
+		rollupUnhealthy: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rollup_unhealthy_total",
+			Help:      "Number of monitoring cycles skipped because the rollup node was unreachable",
+		}),
+		rollupInternalInconsistency: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rollup_internal_inconsistency_total",
+			Help:      "Number of outputs returned by the rollup node where the output root doesn't match the reported block",
+		}),
+		gamesAgreementByFactory: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "games_agreement_by_factory",
+			Help:      "Number of games from each dispute game factory broken down by whether the result agrees with the reference node",
+		}, []string{
+			"factory",
+			"status",
+			"completion",
+			"result_correctness",
+			"root_agreement",
+		}),
+		sampledGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "sampled_games",
+			Help:      "Number of games selected for monitoring by the sample rate, out of the total number of games",
+		}, []string{
+			"selection",
+		}),
+		resultPublishErrors: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "result_publish_errors",
+			Help:      "Number of game results that could not be published to the result sink",
+		}),
+		archiveFallbackGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "archive_fallback_games",
+			Help:      "Number of games with outputs fetched from the archive rollup node because the rollup node pruned the disputed block's state",
+		}),
+		indeterminateGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "indeterminate_games",
+			Help:      "Number of games that can't be classified because the rollup node has no output for the disputed block",
+		}),
+		rollupInconsistentGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "rollup_inconsistent_games",
+			Help:      "Number of games that aren't classified because the rollup node's output root is inconsistent with its block",
+		}),
+		claimAgreement: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "claim_agreement",
+			Help:      "Number of output root claims in in-progress games broken down by whether they agree with the reference node",
+		}, []string{
+			"root_agreement",
+		}),
+		canaryFailure: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "canary_failure",
+			Help:      "1 if the canary game was not classified as expected in the latest cycle, otherwise 0",
+		}),
+		rootClaimChanged: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "root_claim_changed",
+			Help:      "Number of games with a root claim that changed since the previous monitoring cycle",
+		}),
+		unexpectedBondToken: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "unexpected_bond_token",
+			Help:      "Number of games holding bonds in a token other than the expected DelayedWETH contract",
+		}),
+		gamesPerCycle: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "games_per_cycle",
+			Help:      "Number of games processed in each monitoring cycle",
+			Buckets:   gamesPerCycleBuckets,
+		}),
+		failedGamesPerCycle: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "failed_games_per_cycle",
+			Help:      "Number of games skipped due to errors in each monitoring cycle",
+			Buckets:   gamesPerCycleBuckets,
+		}),
+		cycleErrorRate: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cycle_error_rate",
+			Help:      "Fraction of games that failed to load in the latest monitoring cycle, excluding ignored games",
+		}),
+		nextPollDelay: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "next_poll_delay_seconds",
+			Help:      "Delay in seconds until the next monitoring cycle, including any jitter",
+		}),
+		unknownChainGames: *factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "unknown_chain_games",
+			Help:      "Number of times a game was skipped because no rollup node is configured for its L2 chain",
+		}, []string{
+			"chain_id",
+		}),
+		timeSinceFavorableResolution: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "time_since_last_favorable_resolution_seconds",
+			Help:      "Time since a game was last observed resolving in line with the reference node",
+		}),
+		gameLatency: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_latency_seconds",
+			Help:      "Percentiles of the time taken to load and check each game in the last monitoring cycle",
+		}, []string{
+			"quantile",
+		}),
+		gamesAgreementByL1Chain: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "games_agreement_by_l1_chain",
+			Help:      "Number of games anchored to each L1 chain broken down by whether the result agrees with the reference node",
+		}, []string{
+			"l1_chain_id",
+			"status",
+			"completion",
+			"result_correctness",
+			"root_agreement",
+		}),
+		gamesAgreementByGroup: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "games_agreement_by_group",
+			Help:      "Number of games in each configured group broken down by whether the result agrees with the reference node",
+		}, []string{
+			"group",
+			"status",
+			"completion",
+			"result_correctness",
+			"root_agreement",
+		}),
+		duplicateGameClaims: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "duplicate_game_claims",
+			Help:      "Number of groups of games claiming the same root claim for the same L2 block",
+		}),
+		backfillGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "backfill_games",
+			Help:      "Number of games older than the game window checked by the backfill, by outcome",
+		}, []string{"outcome"}),
+		maxDistinctClaimsPerBlock: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "max_distinct_claims_per_block",
+			Help:      "Highest number of distinct root claims made across games for a single disputed L2 block",
+		}),
+		circuitBreakerOpen: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "circuit_breaker_open",
+			Help:      "1 if monitoring is paused or being retried after consecutive failed cycles, otherwise 0",
+		}),
+		cycleDiff: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cycle_diff",
+			Help:      "Number of games added, removed or reclassified since the previous monitoring cycle",
+		}, []string{"change"}),
+		proposerSilence: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "proposer_silence",
+			Help:      "1 if no new game has appeared for longer than the proposer silence threshold, otherwise 0",
+		}),
+		valueAtRisk: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "value_at_risk",
+			Help:      "Total bond in ether posted in games that disagree with the reference node, by agreement status",
+		}, []string{
+			"status",
+			"completion",
+			"result_correctness",
+			"root_agreement",
+		}),
+		vanishedGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "vanished_games",
+			Help:      "Number of games that no longer existed when their data was loaded in the last cycle",
+		}),
+		rollupAheadRatio: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "rollup_ahead_ratio",
+			Help:      "Fraction of games whose disputed block the rollup node's safe head had reached when the game was checked",
+		}),
+		ourTurnPending: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "our_turn_pending",
+			Help:      "Number of in-progress games where an opponent made the most recent move against the honest actors",
+		}),
+		highActivityGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "high_activity_games",
+			Help:      "Number of in-progress games with more moves and steps than the high activity threshold",
+		}),
+		disagreementRateEWMA: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "disagreement_rate_ewma",
+			Help:      "Exponentially weighted moving average of the fraction of resolved games disagreeing with the rollup node",
+		}),
+		clockSkew: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "clock_skew_seconds",
+			Help:      "Seconds local time is ahead of the latest L1 block timestamp. Negative if local time is behind",
+		}),
+		selfConsistencyFailures: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "rollup_self_consistency_failures_total",
+			Help:      "Number of times the rollup node returned different outputs when the same finalized block was requested twice",
+		}),
+		incrementalGamesProcessed: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "incremental_games_processed",
+			Help:      "Number of games loaded in the latest cycle when only games that may have changed are loaded",
+		}),
+		implausibleBlockGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "implausible_block_games",
+			Help:      "Number of games disputing block 0 or a block beyond the plausible range, which are not classified",
+		}),
+		projectionAccuracy: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "projection_accuracy",
+			Help:      "Number of games resolved in the latest cycle by the outcome projected in the previous cycle and the actual outcome",
+		}, []string{
+			"projected",
+			"actual",
+		}),
+		atRiskGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "at_risk_games",
+			Help:      "Number of games resolved as defender wins or in progress that disagree with the reference node",
+		}),
+		crossChainRootGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cross_chain_root_games",
+			Help:      "Number of games whose root claim matches the output of a different L2 chain than the one disputed",
+		}),
+		unaffordableMoveGames: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "unaffordable_move_games",
+			Help:      "Number of in-progress games where the bond required for the next move exceeds the affordable bond",
+		}),
+		contractCacheReuse: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "contract_cache_reuse",
+			Help:      "Number of game contract bindings reused from the cache or newly created in the latest monitoring cycle",
+		}, []string{"result"}),
+		incorrectResolutions: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "incorrect_resolutions",
+			Help:      "Number of resolved games where the winner contradicts the reference node",
+		}),
+		cycleTimeouts: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "cycle_timeouts",
+			Help:      "Number of monitoring cycles cancelled for exceeding the cycle deadline",
+		}),
+		gamesByStatus: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "games_by_status",
+			Help:      "Number of games with each game status",
+		}, []string{"status"}),
+		prematureResolutions: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "premature_resolutions",
+			Help:      "Number of games resolved before the max clock duration elapsed after their creation",
+		}),
+		inProgressGameAge: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "in_progress_game_age_seconds",
+			Help:      "Time since in progress games were created, observed for each game every monitoring cycle",
+			Buckets: []float64{
+				(1 * time.Hour).Seconds(),
+				(12 * time.Hour).Seconds(),
+				(24 * time.Hour).Seconds(),
+				(48 * time.Hour).Seconds(),
+				(72 * time.Hour).Seconds(),
+				(84 * time.Hour).Seconds(),
+				(96 * time.Hour).Seconds(),
+				(120 * time.Hour).Seconds(),
+				(168 * time.Hour).Seconds(),
+			},
+		}),
+		customRules: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "custom_rule_games",
+			Help:      "Number of games matching each custom detection rule by the bucket the rule classified them in",
+		}, []string{
+			"rule",
+			"bucket",
+		}),
+		proposalLag: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "proposal_lag_blocks",
+			Help:      "Number of blocks the rollup node's safe head was ahead of the block valid in progress games propose a root for",
+			Buckets:   []float64{0, 10, 100, 500, 1000, 1800, 3600, 7200, 21600, 43200},
+		}),
+		anchorAge: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "anchor_age_seconds",
+			Help:      "Time since the timestamp of the L2 block committed to by the anchor state registry's anchor",
+		}),
+		depthAnomalies: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "depth_anomalies",
+			Help:      "Number of games with claims deeper than the game's max depth",
+		}),
 	}
 }
 
@@ -543,6 +1229,346 @@ func (m *Metrics) RecordL2Challenges(agreement bool, count int) {
 	m.l2Challenges.WithLabelValues(agree).Set(float64(count))
 }
 
+func (m *Metrics) RecordBeyondOutputRangeGames(count int) {
+	m.beyondOutputRangeGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordAgreementByBlockAge(bucket string, status string, count int) {
+	m.agreementByBlockAge.WithLabelValues(bucket, status).Set(float64(count))
+}
+
+func (m *Metrics) RecordCyclesSinceLastDisagreement(count int) {
+	m.cyclesSinceLastDisagreement.Set(float64(count))
+}
+
+func (m *Metrics) RecordGameResolutionLatency(d time.Duration) {
+	m.gameResolutionLatency.Observe(d.Seconds())
+}
+
+func projectedOutcomeLabel(outcome ProjectedOutcome) string {
+	switch outcome {
+	case ProjectedOutcomeFavorable:
+		return "favorable"
+	case ProjectedOutcomeUnfavorable:
+		return "unfavorable"
+	default:
+		panic(fmt.Errorf("unknown projected outcome: %v", outcome))
+	}
+}
+
+func (m *Metrics) RecordProjectedOutcome(outcome ProjectedOutcome, count int) {
+	m.projectedOutcomes.WithLabelValues(projectedOutcomeLabel(outcome)).Set(float64(count))
+}
+
+func (m *Metrics) RecordUnknownStatusGames(raw uint8, count int) {
+	m.unknownStatusGames.WithLabelValues(strconv.Itoa(int(raw))).Set(float64(count))
+}
+
+func (m *Metrics) RecordHonestActorStanding(favorable, unfavorable int) {
+	m.honestActorStanding.WithLabelValues("favorable").Set(float64(favorable))
+	m.honestActorStanding.WithLabelValues("unfavorable").Set(float64(unfavorable))
+}
+
+func (m *Metrics) RecordStuckDeferredGames(count int) {
+	m.stuckDeferredGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordPendingGames(count int) {
+	m.pendingGames.Set(float64(count))
+}
+
+// Snapshot is a point in time copy of metric values, keyed by metric name and labels.
+// Keys are formatted as name{label="value",...} with labels sorted by name.
+type Snapshot map[string]float64
+
+// Snapshot returns a copy of the current value of all gauges and counters.
+// Histograms are included as their sample count and sum.
+// It is safe to call concurrently with recording metrics.
+func (m *Metrics) Snapshot() (Snapshot, error) {
+	families, err := m.registry.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("failed to gather metrics: %w", err)
+	}
+	snapshot := make(Snapshot)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			var labels []string
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, fmt.Sprintf("%s=%q", label.GetName(), label.GetValue()))
+			}
+			key := func(name string) string {
+				if len(labels) == 0 {
+					return name
+				}
+				return name + "{" + strings.Join(labels, ",") + "}"
+			}
+			switch {
+			case metric.GetGauge() != nil:
+				snapshot[key(family.GetName())] = metric.GetGauge().GetValue()
+			case metric.GetCounter() != nil:
+				snapshot[key(family.GetName())] = metric.GetCounter().GetValue()
+			case metric.GetHistogram() != nil:
+				snapshot[key(family.GetName()+"_count")] = float64(metric.GetHistogram().GetSampleCount())
+				snapshot[key(family.GetName()+"_sum")] = metric.GetHistogram().GetSampleSum()
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+func (m *Metrics) RecordFutureTimestampGames(count int) {
+	m.futureTimestampGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordSingleflightCoalesced() {
+	m.singleflightCoalesced.Inc()
+}
+
+func (m *Metrics) RecordFilteredGames(count int) {
+	m.filteredGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordHistoryWriteErrors() {
+	m.historyWriteErrors.Inc()
+}
+
+func (m *Metrics) RecordRootMismatchDetails() {
+	m.rootMismatchDetails.Inc()
+}
+
+func (m *Metrics) RecordRateLimited(count int) {
+	m.rateLimited.Add(float64(count))
+}
+
+func (m *Metrics) RecordMaxGamesPerBlockBucket(count int) {
+	m.maxGamesPerBlockBucket.Set(float64(count))
+}
+
+func (m *Metrics) RecordRollupUnhealthy() {
+	m.rollupUnhealthy.Inc()
+}
+
+func (m *Metrics) RecordRollupInternalInconsistency() {
+	m.rollupInternalInconsistency.Inc()
+}
+
+func (m *Metrics) RecordGameAgreementByFactory(factory common.Address, status GameAgreementStatus, count int) {
+	m.gamesAgreementByFactory.WithLabelValues(append([]string{factory.Hex()}, labelValuesFor(status)...)...).Set(float64(count))
+}
+
+func (m *Metrics) RecordSampledGames(processed, total int) {
+	m.sampledGames.WithLabelValues("processed").Set(float64(processed))
+	m.sampledGames.WithLabelValues("total").Set(float64(total))
+}
+
+func (m *Metrics) RecordResultPublishErrors() {
+	m.resultPublishErrors.Inc()
+}
+
+func (m *Metrics) RecordArchiveFallbackCount(count int) {
+	m.archiveFallbackGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordIndeterminateGames(count int) {
+	m.indeterminateGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordRollupInconsistentGames(count int) {
+	m.rollupInconsistentGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordClaimAgreement(agree, disagree int) {
+	m.claimAgreement.WithLabelValues("agree").Set(float64(agree))
+	m.claimAgreement.WithLabelValues("disagree").Set(float64(disagree))
+}
+
+func (m *Metrics) RecordCanaryFailure(failed bool) {
+	if failed {
+		m.canaryFailure.Set(1)
+	} else {
+		m.canaryFailure.Set(0)
+	}
+}
+
+func (m *Metrics) RecordRootClaimChanged(count int) {
+	m.rootClaimChanged.Set(float64(count))
+}
+
+func (m *Metrics) RecordUnexpectedBondToken(count int) {
+	m.unexpectedBondToken.Set(float64(count))
+}
+
+func (m *Metrics) RecordGamesPerCycle(n int) {
+	m.gamesPerCycle.Observe(float64(n))
+}
+
+func (m *Metrics) RecordFailedGamesPerCycle(n int) {
+	m.failedGamesPerCycle.Observe(float64(n))
+}
+
+func (m *Metrics) RecordCycleErrorRate(rate float64) {
+	m.cycleErrorRate.Set(rate)
+}
+
+func (m *Metrics) RecordNextPollDelay(d time.Duration) {
+	m.nextPollDelay.Set(d.Seconds())
+}
+
+func (m *Metrics) RecordUnknownChainGames(chainID uint64) {
+	m.unknownChainGames.WithLabelValues(strconv.FormatUint(chainID, 10)).Inc()
+}
+
+func (m *Metrics) RecordTimeSinceLastFavorableResolution(dur time.Duration) {
+	m.timeSinceFavorableResolution.Set(dur.Seconds())
+}
+
+func (m *Metrics) RecordGameLatencyPercentiles(p50, p90, p99 time.Duration) {
+	m.gameLatency.WithLabelValues("0.5").Set(p50.Seconds())
+	m.gameLatency.WithLabelValues("0.9").Set(p90.Seconds())
+	m.gameLatency.WithLabelValues("0.99").Set(p99.Seconds())
+}
+
+func (m *Metrics) RecordGameAgreementByL1Chain(chainID uint64, status GameAgreementStatus, count int) {
+	m.gamesAgreementByL1Chain.WithLabelValues(append([]string{strconv.FormatUint(chainID, 10)}, labelValuesFor(status)...)...).Set(float64(count))
+}
+
+func (m *Metrics) RecordGameAgreementByGroup(group string, status GameAgreementStatus, count int) {
+	m.gamesAgreementByGroup.WithLabelValues(append([]string{group}, labelValuesFor(status)...)...).Set(float64(count))
+}
+
+func (m *Metrics) RecordDuplicateGameClaims(groups int) {
+	m.duplicateGameClaims.Set(float64(groups))
+}
+
+func (m *Metrics) RecordBackfillGames(processed, incorrect, failed int) {
+	m.backfillGames.WithLabelValues("processed").Set(float64(processed))
+	m.backfillGames.WithLabelValues("incorrect").Set(float64(incorrect))
+	m.backfillGames.WithLabelValues("failed").Set(float64(failed))
+}
+
+func (m *Metrics) RecordMaxDistinctClaimsPerBlock(count int) {
+	m.maxDistinctClaimsPerBlock.Set(float64(count))
+}
+
+func (m *Metrics) RecordCircuitBreakerState(open bool) {
+	if open {
+		m.circuitBreakerOpen.Set(1)
+	} else {
+		m.circuitBreakerOpen.Set(0)
+	}
+}
+
+func (m *Metrics) RecordCycleDiff(added, removed, changed int) {
+	m.cycleDiff.WithLabelValues("added").Set(float64(added))
+	m.cycleDiff.WithLabelValues("removed").Set(float64(removed))
+	m.cycleDiff.WithLabelValues("changed").Set(float64(changed))
+}
+
+func (m *Metrics) RecordProposerSilence(silent bool) {
+	if silent {
+		m.proposerSilence.Set(1)
+	} else {
+		m.proposerSilence.Set(0)
+	}
+}
+
+func (m *Metrics) RecordValueAtRisk(status GameAgreementStatus, wei *big.Int) {
+	m.valueAtRisk.WithLabelValues(labelValuesFor(status)...).Set(weiToEther(wei))
+}
+
+func (m *Metrics) RecordVanishedGames(count int) {
+	m.vanishedGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordRollupAheadRatio(ratio float64) {
+	m.rollupAheadRatio.Set(ratio)
+}
+
+func (m *Metrics) RecordOurTurnPending(count int) {
+	m.ourTurnPending.Set(float64(count))
+}
+
+func (m *Metrics) RecordHighActivityGames(count int) {
+	m.highActivityGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordDisagreementRateEWMA(rate float64) {
+	m.disagreementRateEWMA.Set(rate)
+}
+
+func (m *Metrics) RecordClockSkew(seconds float64) {
+	m.clockSkew.Set(seconds)
+}
+
+func (m *Metrics) RecordSelfConsistencyFailure() {
+	m.selfConsistencyFailures.Inc()
+}
+
+func (m *Metrics) RecordIncrementalGamesProcessed(count int) {
+	m.incrementalGamesProcessed.Set(float64(count))
+}
+
+func (m *Metrics) RecordImplausibleBlockGames(count int) {
+	m.implausibleBlockGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordAtRiskGames(count int) {
+	m.atRiskGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordCrossChainRootGames(count int) {
+	m.crossChainRootGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordUnaffordableMoveGames(count int) {
+	m.unaffordableMoveGames.Set(float64(count))
+}
+
+func (m *Metrics) RecordContractCacheReuse(reused, created int) {
+	m.contractCacheReuse.WithLabelValues("reused").Set(float64(reused))
+	m.contractCacheReuse.WithLabelValues("created").Set(float64(created))
+}
+
+func (m *Metrics) RecordIncorrectResolutions(count int) {
+	m.incorrectResolutions.Set(float64(count))
+}
+
+func (m *Metrics) RecordCycleTimeouts() {
+	m.cycleTimeouts.Inc()
+}
+
+func (m *Metrics) RecordGamesByStatus(status string, count int) {
+	m.gamesByStatus.WithLabelValues(status).Set(float64(count))
+}
+
+func (m *Metrics) RecordPrematureResolutions(count int) {
+	m.prematureResolutions.Set(float64(count))
+}
+
+func (m *Metrics) RecordInProgressGameAge(d time.Duration) {
+	m.inProgressGameAge.Observe(d.Seconds())
+}
+
+func (m *Metrics) RecordCustomRule(name string, bucket string, count int) {
+	m.customRules.WithLabelValues(name, bucket).Set(float64(count))
+}
+
+func (m *Metrics) RecordProposalLag(blocks float64) {
+	m.proposalLag.Observe(blocks)
+}
+
+func (m *Metrics) RecordAnchorAge(d time.Duration) {
+	m.anchorAge.Set(d.Seconds())
+}
+
+func (m *Metrics) RecordDepthAnomalies(count int) {
+	m.depthAnomalies.Set(float64(count))
+}
+
+func (m *Metrics) RecordProjectionAccuracy(projected, actual ProjectedOutcome, count int) {
+	m.projectionAccuracy.WithLabelValues(projectedOutcomeLabel(projected), projectedOutcomeLabel(actual)).Set(float64(count))
+}
+
 const (
 	inProgress = true
 	correct    = true
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		m := NewMetrics()
+		snapshot, err := m.Snapshot()
+		require.NoError(t, err)
+		require.Zero(t, snapshot["op_dispute_mon_up"])
+	})
+
+	t.Run("IncludesLabels", func(t *testing.T) {
+		m := NewMetrics()
+		m.RecordUp()
+		m.RecordGameAgreement(AgreeDefenderWins, 4)
+		m.RecordMonitorDuration(2 * time.Second)
+		m.RecordGamesPerCycle(0)
+		m.RecordGamesPerCycle(1)
+		m.RecordGamesPerCycle(300)
+		snapshot, err := m.Snapshot()
+		require.NoError(t, err)
+		require.Equal(t, 1.0, snapshot["op_dispute_mon_up"])
+		require.Equal(t, 4.0, snapshot[`op_dispute_mon_games_agreement{completion="complete",result_correctness="correct",root_agreement="agree",status="agree_defender_wins"}`])
+		require.Equal(t, 1.0, snapshot["op_dispute_mon_monitor_duration_seconds_count"])
+		require.Equal(t, 2.0, snapshot["op_dispute_mon_monitor_duration_seconds_sum"])
+		require.Equal(t, 3.0, snapshot["op_dispute_mon_games_per_cycle_count"])
+		require.Equal(t, 301.0, snapshot["op_dispute_mon_games_per_cycle_sum"])
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		m := NewMetrics()
+		statuses := []GameAgreementStatus{
+			AgreeChallengerAhead, DisagreeChallengerAhead, AgreeDefenderAhead, DisagreeDefenderAhead,
+			AgreeDefenderWins, DisagreeDefenderWins, AgreeChallengerWins, DisagreeChallengerWins,
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			for _, status := range statuses {
+				wg.Add(1)
+				go func(status GameAgreementStatus) {
+					defer wg.Done()
+					m.RecordGameAgreement(status, int(status)+1)
+				}(status)
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := m.Snapshot()
+				require.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		snapshot, err := m.Snapshot()
+		require.NoError(t, err)
+		require.Equal(t, 1.0, snapshot[`op_dispute_mon_games_agreement{completion="in_progress",result_correctness="incorrect",root_agreement="agree",status="agree_challenger_ahead"}`])
+		require.Equal(t, 8.0, snapshot[`op_dispute_mon_games_agreement{completion="complete",result_correctness="correct",root_agreement="disagree",status="disagree_challenger_wins"}`])
+		total := 0.0
+		for key, value := range snapshot {
+			if strings.HasPrefix(key, "op_dispute_mon_games_agreement{") {
+				total += value
+			}
+		}
+		require.Equal(t, float64(1+2+3+4+5+6+7+8), total)
+	})
+}
@@ -49,3 +49,138 @@ func (*NoopMetricsImpl) RecordFailedGames(_ int) {}
 func (*NoopMetricsImpl) RecordBondCollateral(_ common.Address, _, _ *big.Int) {}
 
 func (*NoopMetricsImpl) RecordL2Challenges(_ bool, _ int) {}
+
+func (*NoopMetricsImpl) RecordBeyondOutputRangeGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordAgreementByBlockAge(_ string, _ string, _ int) {}
+
+func (*NoopMetricsImpl) RecordCyclesSinceLastDisagreement(_ int) {}
+
+func (*NoopMetricsImpl) RecordGameResolutionLatency(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordProjectedOutcome(_ ProjectedOutcome, _ int) {}
+
+func (*NoopMetricsImpl) RecordUnknownStatusGames(_ uint8, _ int) {}
+
+func (*NoopMetricsImpl) RecordHonestActorStanding(_, _ int) {}
+
+func (*NoopMetricsImpl) RecordStuckDeferredGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordPendingGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordFutureTimestampGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordSingleflightCoalesced() {}
+
+func (*NoopMetricsImpl) RecordFilteredGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordHistoryWriteErrors() {}
+
+func (*NoopMetricsImpl) RecordRootMismatchDetails() {}
+
+func (*NoopMetricsImpl) RecordRateLimited(_ int) {}
+
+func (*NoopMetricsImpl) RecordMaxGamesPerBlockBucket(_ int) {}
+
+func (*NoopMetricsImpl) RecordRollupUnhealthy() {}
+
+func (*NoopMetricsImpl) RecordRollupInternalInconsistency() {}
+
+func (*NoopMetricsImpl) RecordGameAgreementByFactory(_ common.Address, _ GameAgreementStatus, _ int) {
+}
+
+func (*NoopMetricsImpl) RecordSampledGames(_, _ int) {}
+
+func (*NoopMetricsImpl) RecordResultPublishErrors() {}
+
+func (*NoopMetricsImpl) RecordArchiveFallbackCount(_ int) {}
+
+func (*NoopMetricsImpl) RecordIndeterminateGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordRollupInconsistentGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordClaimAgreement(_, _ int) {}
+
+func (*NoopMetricsImpl) RecordCanaryFailure(_ bool) {}
+
+func (*NoopMetricsImpl) RecordRootClaimChanged(_ int) {}
+
+func (*NoopMetricsImpl) RecordUnexpectedBondToken(_ int) {}
+
+func (*NoopMetricsImpl) RecordGamesPerCycle(_ int) {}
+
+func (*NoopMetricsImpl) RecordFailedGamesPerCycle(_ int) {}
+
+func (*NoopMetricsImpl) RecordCycleErrorRate(_ float64) {}
+
+func (*NoopMetricsImpl) RecordNextPollDelay(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordUnknownChainGames(_ uint64) {}
+
+func (*NoopMetricsImpl) RecordTimeSinceLastFavorableResolution(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordGameLatencyPercentiles(_, _, _ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordGameAgreementByL1Chain(_ uint64, _ GameAgreementStatus, _ int) {}
+
+func (*NoopMetricsImpl) RecordGameAgreementByGroup(_ string, _ GameAgreementStatus, _ int) {}
+
+func (*NoopMetricsImpl) RecordDuplicateGameClaims(_ int) {}
+
+func (*NoopMetricsImpl) RecordBackfillGames(_, _, _ int) {}
+
+func (*NoopMetricsImpl) RecordMaxDistinctClaimsPerBlock(_ int) {}
+
+func (*NoopMetricsImpl) RecordCircuitBreakerState(_ bool) {}
+
+func (*NoopMetricsImpl) RecordCycleDiff(_, _, _ int) {}
+
+func (*NoopMetricsImpl) RecordProposerSilence(_ bool) {}
+
+func (*NoopMetricsImpl) RecordValueAtRisk(_ GameAgreementStatus, _ *big.Int) {}
+
+func (*NoopMetricsImpl) RecordVanishedGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordRollupAheadRatio(_ float64) {}
+
+func (*NoopMetricsImpl) RecordOurTurnPending(_ int) {}
+
+func (*NoopMetricsImpl) RecordHighActivityGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordDisagreementRateEWMA(_ float64) {}
+
+func (*NoopMetricsImpl) RecordClockSkew(_ float64) {}
+
+func (*NoopMetricsImpl) RecordSelfConsistencyFailure() {}
+
+func (*NoopMetricsImpl) RecordIncrementalGamesProcessed(_ int) {}
+
+func (*NoopMetricsImpl) RecordImplausibleBlockGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordProjectionAccuracy(_, _ ProjectedOutcome, _ int) {}
+
+func (*NoopMetricsImpl) RecordAtRiskGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordCrossChainRootGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordUnaffordableMoveGames(_ int) {}
+
+func (*NoopMetricsImpl) RecordContractCacheReuse(_, _ int) {}
+
+func (*NoopMetricsImpl) RecordIncorrectResolutions(_ int) {}
+
+func (*NoopMetricsImpl) RecordCycleTimeouts() {}
+
+func (*NoopMetricsImpl) RecordGamesByStatus(_ string, _ int) {}
+
+func (*NoopMetricsImpl) RecordPrematureResolutions(_ int) {}
+
+func (*NoopMetricsImpl) RecordInProgressGameAge(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordCustomRule(_ string, _ string, _ int) {}
+
+func (*NoopMetricsImpl) RecordProposalLag(_ float64) {}
+
+func (*NoopMetricsImpl) RecordAnchorAge(_ time.Duration) {}
+
+func (*NoopMetricsImpl) RecordDepthAnomalies(_ int) {}
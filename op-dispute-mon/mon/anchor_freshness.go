@@ -0,0 +1,76 @@
+package mon
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type AnchorFreshnessMetrics interface {
+	RecordAnchorAge(d time.Duration)
+}
+
+// AnchorRootFetcher returns the anchor root and the L2 block number it commits to for games of gameType.
+type AnchorRootFetcher func(ctx context.Context, block rpcblock.Block, gameType uint32) (common.Hash, uint64, error)
+
+type AnchorOutputClient interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// AnchorFreshnessCheck reports the age of the anchor state registry's anchor L2 block. The anchor advances as games
+// resolve in favour of the defender so a stalled anchor indicates valid games are not being played out.
+type AnchorFreshnessCheck struct {
+	logger      log.Logger
+	metrics     AnchorFreshnessMetrics
+	clock       RClock
+	fetchAnchor AnchorRootFetcher
+	client      AnchorOutputClient
+	gameType    uint32
+	threshold   time.Duration
+}
+
+// NewAnchorFreshnessCheck creates a new AnchorFreshnessCheck for the anchor of games of gameType.
+// An anchor older than threshold is logged as stale. A zero threshold disables the log.
+func NewAnchorFreshnessCheck(logger log.Logger, metrics AnchorFreshnessMetrics, clock RClock, fetchAnchor AnchorRootFetcher, client AnchorOutputClient, gameType uint32, threshold time.Duration) *AnchorFreshnessCheck {
+	return &AnchorFreshnessCheck{
+		logger:      logger,
+		metrics:     metrics,
+		clock:       clock,
+		fetchAnchor: fetchAnchor,
+		client:      client,
+		gameType:    gameType,
+		threshold:   threshold,
+	}
+}
+
+// Check records the age of the anchor as at the L1 block blockHash.
+// Failures to load the anchor are logged but don't prevent games being monitored.
+func (c *AnchorFreshnessCheck) Check(ctx context.Context, blockHash common.Hash) {
+	root, blockNum, err := c.fetchAnchor(ctx, rpcblock.ByHash(blockHash), c.gameType)
+	if err != nil {
+		c.logger.Warn("Failed to load anchor state", "gameType", c.gameType, "err", err)
+		return
+	}
+	output, err := c.client.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		c.logger.Warn("Failed to fetch anchor block", "gameType", c.gameType, "l2BlockNum", blockNum, "err", err)
+		return
+	}
+	age := c.clock.Now().Sub(time.Unix(int64(output.BlockRef.Time), 0))
+	c.metrics.RecordAnchorAge(age)
+	if c.threshold != 0 && age > c.threshold {
+		c.logger.Warn("Anchor state is stale", "gameType", c.gameType, "root", root, "l2BlockNum", blockNum, "age", age, "threshold", c.threshold)
+	}
+}
+
+func checkAnchorFreshness(extract Extract, check *AnchorFreshnessCheck) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		check.Check(ctx, blockHash)
+		return extract(ctx, blockHash, minTimestamp)
+	}
+}
@@ -0,0 +1,104 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchorFreshnessCheck(t *testing.T) {
+	now := time.Unix(100_000, 0)
+	blockHash := common.Hash{0xbb}
+	setup := func(t *testing.T, anchorTime uint64) (*AnchorFreshnessCheck, *stubAnchorRegistry, *stubAnchorOutputClient, *stubAnchorFreshnessMetrics, *testlog.CapturingHandler) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		registry := &stubAnchorRegistry{blockNum: 500}
+		client := &stubAnchorOutputClient{times: map[uint64]uint64{500: anchorTime}}
+		metrics := &stubAnchorFreshnessMetrics{}
+		check := NewAnchorFreshnessCheck(logger, metrics, clock.NewDeterministicClock(now), registry.GetAnchorRoot, client, 1, time.Hour)
+		return check, registry, client, metrics, logs
+	}
+
+	t.Run("Fresh", func(t *testing.T) {
+		check, registry, _, metrics, logs := setup(t, 100_000-600)
+		check.Check(context.Background(), blockHash)
+		require.Equal(t, []time.Duration{10 * time.Minute}, metrics.ages)
+		require.Equal(t, rpcblock.ByHash(blockHash), registry.block)
+		require.Equal(t, uint32(1), registry.gameType)
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Anchor state is stale")))
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		check, _, _, metrics, logs := setup(t, 100_000-7200)
+		check.Check(context.Background(), blockHash)
+		require.Equal(t, []time.Duration{2 * time.Hour}, metrics.ages)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Anchor state is stale"))
+		require.NotNil(t, l)
+		require.Equal(t, uint64(500), l.AttrValue("l2BlockNum"))
+	})
+
+	t.Run("NoThreshold", func(t *testing.T) {
+		check, _, _, metrics, logs := setup(t, 100_000-7200)
+		check.threshold = 0
+		check.Check(context.Background(), blockHash)
+		require.Equal(t, []time.Duration{2 * time.Hour}, metrics.ages)
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Anchor state is stale")))
+	})
+
+	t.Run("RegistryError", func(t *testing.T) {
+		check, registry, _, metrics, logs := setup(t, 100_000)
+		registry.err = errors.New("boom")
+		check.Check(context.Background(), blockHash)
+		require.Empty(t, metrics.ages)
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Failed to load anchor state")))
+	})
+
+	t.Run("OutputError", func(t *testing.T) {
+		check, _, client, metrics, logs := setup(t, 100_000)
+		client.err = errors.New("boom")
+		check.Check(context.Background(), blockHash)
+		require.Empty(t, metrics.ages)
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Failed to fetch anchor block")))
+	})
+}
+
+type stubAnchorRegistry struct {
+	blockNum uint64
+	err      error
+	block    rpcblock.Block
+	gameType uint32
+}
+
+func (s *stubAnchorRegistry) GetAnchorRoot(_ context.Context, block rpcblock.Block, gameType uint32) (common.Hash, uint64, error) {
+	s.block = block
+	s.gameType = gameType
+	return common.Hash{0xaa}, s.blockNum, s.err
+}
+
+type stubAnchorOutputClient struct {
+	times map[uint64]uint64
+	err   error
+}
+
+func (s *stubAnchorOutputClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &eth.OutputResponse{BlockRef: eth.L2BlockRef{Number: blockNum, Time: s.times[blockNum]}}, nil
+}
+
+type stubAnchorFreshnessMetrics struct {
+	ages []time.Duration
+}
+
+func (s *stubAnchorFreshnessMetrics) RecordAnchorAge(d time.Duration) {
+	s.ages = append(s.ages, d)
+}
@@ -0,0 +1,37 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ArchiveFallbackMetrics interface {
+	RecordArchiveFallbackCount(count int)
+}
+
+// ArchiveFallbackMonitor reports games whose disputed block's state was pruned by the rollup node,
+// requiring the output to be fetched from the archive rollup node.
+// A rising count indicates the dispute backlog is aging beyond the rollup node's prune horizon.
+type ArchiveFallbackMonitor struct {
+	logger  log.Logger
+	metrics ArchiveFallbackMetrics
+}
+
+func NewArchiveFallbackMonitor(logger log.Logger, metrics ArchiveFallbackMetrics) *ArchiveFallbackMonitor {
+	return &ArchiveFallbackMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *ArchiveFallbackMonitor) CheckArchiveFallbacks(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if !game.ArchiveFallback {
+			continue
+		}
+		count++
+		m.logger.Debug("Game output fetched from archive rollup node", "game", game.Proxy, "blockNum", game.L2BlockNumber)
+	}
+	m.metrics.RecordArchiveFallbackCount(count)
+}
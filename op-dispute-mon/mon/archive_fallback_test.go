@@ -0,0 +1,33 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorArchiveFallbacks(t *testing.T) {
+	games := []*types.EnrichedGameData{
+		{L2BlockNumber: 10, ArchiveFallback: true},
+		{L2BlockNumber: 20, ArchiveFallback: true},
+		{L2BlockNumber: 5000},
+	}
+	metrics := &stubArchiveFallbackMetrics{}
+	monitor := NewArchiveFallbackMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+	monitor.CheckArchiveFallbacks(games)
+	require.Equal(t, 2, metrics.count)
+
+	monitor.CheckArchiveFallbacks(games[2:])
+	require.Zero(t, metrics.count)
+}
+
+type stubArchiveFallbackMetrics struct {
+	count int
+}
+
+func (s *stubArchiveFallbackMetrics) RecordArchiveFallbackCount(count int) {
+	s.count = count
+}
@@ -0,0 +1,133 @@
+package mon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ExtractRange loads the games created at or after minTimestamp and before maxTimestamp.
+type ExtractRange func(ctx context.Context, blockHash common.Hash, minTimestamp uint64, maxTimestamp uint64) ([]*types.EnrichedGameData, int, int, error)
+
+type BackfillMetrics interface {
+	RecordBackfillGames(processed, incorrect, failed int)
+}
+
+// backfill checks the games older than the game window once, in the background, so that the history of a long-running
+// chain can be checked without delaying the regular monitoring cycles. The extract function is expected to be rate
+// limited so the backfill doesn't compete with the regular monitoring cycles for the rollup node.
+type backfill struct {
+	logger  log.Logger
+	clock   clock.Clock
+	metrics BackfillMetrics
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	window     time.Duration
+	gameWindow time.Duration
+
+	extract          ExtractRange
+	fetchBlockNumber BlockNumberFetcher
+	fetchBlockHash   BlockHashFetcher
+}
+
+func newBackfill(
+	ctx context.Context,
+	logger log.Logger,
+	cl clock.Clock,
+	metrics BackfillMetrics,
+	window time.Duration,
+	gameWindow time.Duration,
+	extract ExtractRange,
+	fetchBlockNumber BlockNumberFetcher,
+	fetchBlockHash BlockHashFetcher,
+) *backfill {
+	return &backfill{
+		logger:           logger,
+		clock:            cl,
+		metrics:          metrics,
+		ctx:              ctx,
+		done:             make(chan struct{}),
+		window:           window,
+		gameWindow:       gameWindow,
+		extract:          extract,
+		fetchBlockNumber: fetchBlockNumber,
+		fetchBlockHash:   fetchBlockHash,
+	}
+}
+
+func (b *backfill) Start() {
+	b.ctx, b.cancel = context.WithCancel(b.ctx)
+	b.logger.Info("Starting backfill", "window", b.window)
+	go func() {
+		defer close(b.done)
+		if err := b.run(); err != nil {
+			b.logger.Error("Failed to backfill games", "err", err)
+		}
+	}()
+}
+
+// Stop cancels the backfill if it is still running and waits for it to exit.
+func (b *backfill) Stop() {
+	if b.cancel == nil {
+		// Backfill was never started
+		return
+	}
+	b.cancel()
+	<-b.done
+}
+
+func (b *backfill) run() error {
+	start := b.clock.Now()
+	blockNumber, err := b.fetchBlockNumber(b.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	blockHash, err := b.fetchBlockHash(b.ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return fmt.Errorf("failed to fetch block hash: %w", err)
+	}
+	// Games newer than the game window are checked by the regular monitoring cycles
+	minTimestamp := clock.MinCheckedTimestamp(b.clock, b.window)
+	maxTimestamp := clock.MinCheckedTimestamp(b.clock, b.gameWindow)
+	games, ignored, failed, err := b.extract(b.ctx, blockHash, minTimestamp, maxTimestamp)
+	if err != nil {
+		return fmt.Errorf("failed to load games: %w", err)
+	}
+	incorrect := 0
+	for _, game := range games {
+		if !resolvedIncorrectly(game) {
+			continue
+		}
+		incorrect++
+		b.logger.Error("Backfilled game resolved incorrectly",
+			"game", game.Proxy, "l2BlockNum", game.L2BlockNumber, "status", game.Status,
+			"rootClaim", game.RootClaim, "expected", game.ExpectedRootClaim)
+	}
+	b.metrics.RecordBackfillGames(len(games), incorrect, failed)
+	b.logger.Info("Completed backfill", "blockNumber", blockNumber, "duration", b.clock.Since(start),
+		"games", len(games), "incorrect", incorrect, "ignored", ignored, "failed", failed)
+	return nil
+}
+
+// resolvedIncorrectly returns true if the game resolved in favour of a root claim that disagrees with the rollup node,
+// or against a root claim that agrees with it.
+func resolvedIncorrectly(game *types.EnrichedGameData) bool {
+	switch game.Status {
+	case gameTypes.GameStatusDefenderWon:
+		return !game.AgreeWithClaim
+	case gameTypes.GameStatusChallengerWon:
+		return game.AgreeWithClaim
+	default:
+		return false
+	}
+}
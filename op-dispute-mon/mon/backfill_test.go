@@ -0,0 +1,124 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfill(t *testing.T) {
+	now := time.Unix(10*24*60*60, 0)
+	fetchBlockNum := func(ctx context.Context) (uint64, error) {
+		return 1, nil
+	}
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		return common.Hash{0xbb}, nil
+	}
+
+	t.Run("RateLimitedWhileMonitoring", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(now)
+		throttle := extract.NewThrottle(cl, time.Minute)
+		historical := []*types.EnrichedGameData{
+			{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x01}}, Status: gameTypes.GameStatusDefenderWon, AgreeWithClaim: true},
+			{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x02}}, Status: gameTypes.GameStatusDefenderWon, AgreeWithClaim: false},
+			{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x03}}, Status: gameTypes.GameStatusChallengerWon, AgreeWithClaim: false},
+		}
+		backfilled := make(chan common.Address, len(historical))
+		var minTimestamp, maxTimestamp uint64
+		// Simulates an extractor loading one game at a time through the throttle
+		extractRange := func(ctx context.Context, blockHash common.Hash, min uint64, max uint64) ([]*types.EnrichedGameData, int, int, error) {
+			minTimestamp, maxTimestamp = min, max
+			for _, game := range historical {
+				if err := throttle.Wait(ctx); err != nil {
+					return nil, 0, 0, err
+				}
+				backfilled <- game.Proxy
+			}
+			return historical, 0, 0, nil
+		}
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		m := &stubBackfillMetrics{}
+		b := newBackfill(context.Background(), logger, cl, m, 7*24*time.Hour, 24*time.Hour, extractRange, fetchBlockNum, fetchBlockHash)
+		b.Start()
+		defer b.Stop()
+
+		require.Equal(t, common.Address{0x01}, <-backfilled)
+
+		// Regular monitoring cycles proceed while the backfill is waiting
+		monitor, _, forecast, _ := setupMonitorTest(t)
+		require.NoError(t, monitor.monitorGames())
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, 2, forecast.calls)
+		require.Empty(t, backfilled, "should not load next game before interval elapses")
+
+		for _, expected := range historical[1:] {
+			require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second))
+			cl.AdvanceTime(59 * time.Second)
+			require.Empty(t, backfilled, "should not load next game before interval elapses")
+			cl.AdvanceTime(time.Second)
+			require.Equal(t, expected.Proxy, <-backfilled)
+		}
+		b.Stop()
+
+		require.Equal(t, uint64(now.Add(-7*24*time.Hour).Unix()), minTimestamp)
+		require.Equal(t, uint64(now.Add(-24*time.Hour).Unix()), maxTimestamp)
+		require.Equal(t, 3, m.processed)
+		require.Equal(t, 1, m.incorrect)
+		require.Zero(t, m.failed)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Backfilled game resolved incorrectly"))
+		require.NotNil(t, l)
+		require.Equal(t, common.Address{0x02}, l.AttrValue("game"))
+	})
+
+	t.Run("StopCancelsInProgressBackfill", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(now)
+		extractRange := func(ctx context.Context, _ common.Hash, _ uint64, _ uint64) ([]*types.EnrichedGameData, int, int, error) {
+			<-ctx.Done()
+			return nil, 0, 0, ctx.Err()
+		}
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		m := &stubBackfillMetrics{}
+		b := newBackfill(context.Background(), logger, cl, m, 7*24*time.Hour, 24*time.Hour, extractRange, fetchBlockNum, fetchBlockHash)
+		b.Start()
+		b.Stop()
+		require.Zero(t, m.processed)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Failed to backfill games"))
+		require.NotNil(t, l)
+		require.ErrorIs(t, l.AttrValue("err").(error), context.Canceled)
+	})
+
+	t.Run("BlockNumberError", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(now)
+		err := errors.New("boom")
+		extractRange := func(ctx context.Context, _ common.Hash, _ uint64, _ uint64) ([]*types.EnrichedGameData, int, int, error) {
+			t.Fatal("should not load games")
+			return nil, 0, 0, nil
+		}
+		b := newBackfill(context.Background(), testlog.Logger(t, log.LvlInfo), cl, &stubBackfillMetrics{}, 7*24*time.Hour, 24*time.Hour, extractRange,
+			func(ctx context.Context) (uint64, error) { return 0, err }, fetchBlockHash)
+		require.ErrorIs(t, b.run(), err)
+	})
+}
+
+type stubBackfillMetrics struct {
+	processed int
+	incorrect int
+	failed    int
+}
+
+func (s *stubBackfillMetrics) RecordBackfillGames(processed, incorrect, failed int) {
+	s.processed = processed
+	s.incorrect = incorrect
+	s.failed = failed
+}
@@ -0,0 +1,170 @@
+package mon
+
+import (
+	"context"
+	"flag"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	benchGames         = flag.Int("bench-games", 1000, "number of games loaded each cycle by BenchmarkDetect")
+	benchRollupLatency = flag.Duration("bench-rollup-latency", time.Millisecond, "simulated latency of each rollup node request in BenchmarkDetect")
+)
+
+// BenchmarkDetect measures a full monitoring cycle of extraction, root claim agreement detection and forecasting
+// over in-memory games with randomized statuses and root claims. Rollup node requests are delayed by a fixed latency
+// to model a real RPC. The number of games and the latency are set with -bench-games and -bench-rollup-latency.
+// Run with -benchmem for allocations per cycle and -cpuprofile or -memprofile to profile.
+func BenchmarkDetect(b *testing.B) {
+	logger := testlog.Logger(b, log.LevelCrit)
+	games := newBenchGames(*benchGames)
+	rollup := &benchRollupClient{latency: *benchRollupLatency}
+	extractor := extract.NewExtractor(
+		logger,
+		metrics.NoopMetrics,
+		clock.SystemClock,
+		func(_ context.Context, game gameTypes.GameMetadata) (extract.GameCaller, error) {
+			return games.callers[game.Proxy], nil
+		},
+		[]extract.GameSource{{
+			FetchGames: func(_ context.Context, _ common.Hash, _ uint64) ([]gameTypes.GameMetadata, error) {
+				return games.metadata, nil
+			},
+		}},
+		nil,
+		extract.GameFilter{},
+		5,
+		0,
+		0,
+		extract.NewAgreementEnricher(logger, metrics.NoopMetrics, clock.SystemClock, rollup, 0, monTypes.AgreementHeadSafe, nil, nil, nil),
+	)
+	forecast := NewForecast(logger, metrics.NoopMetrics, clock.SystemClock, false, nil, nil, nil, config.DefaultDisagreementSmoothing, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(b, err)
+		require.Len(b, enriched, len(games.metadata))
+		forecast.Forecast(enriched, ignored, failed)
+	}
+}
+
+type benchGameSet struct {
+	metadata []gameTypes.GameMetadata
+	callers  map[common.Address]extract.GameCaller
+}
+
+// newBenchGames generates count games with randomized statuses, each disputing its own L2 block.
+// A fixed seed is used so every run benchmarks the same games.
+func newBenchGames(count int) *benchGameSet {
+	rng := rand.New(rand.NewSource(1))
+	statuses := []gameTypes.GameStatus{gameTypes.GameStatusInProgress, gameTypes.GameStatusChallengerWon, gameTypes.GameStatusDefenderWon}
+	set := &benchGameSet{
+		metadata: make([]gameTypes.GameMetadata, count),
+		callers:  make(map[common.Address]extract.GameCaller, count),
+	}
+	for i := 0; i < count; i++ {
+		proxy := common.BigToAddress(big.NewInt(int64(i + 1)))
+		blockNum := uint64(i + 1)
+		rootClaim := benchOutputRoot(blockNum)
+		if rng.Intn(2) == 0 {
+			rootClaim = common.Hash{0xba, 0xd0}
+		}
+		set.metadata[i] = gameTypes.GameMetadata{Index: uint64(i), Proxy: proxy, Timestamp: uint64(i)}
+		set.callers[proxy] = &benchGameCaller{
+			metadata: contracts.GameMetadata{
+				L2BlockNum: blockNum,
+				RootClaim:  rootClaim,
+				Status:     statuses[rng.Intn(len(statuses))],
+			},
+		}
+	}
+	return set
+}
+
+// benchOutputRoot returns the output root the benchmark rollup client reports for the specified block.
+func benchOutputRoot(blockNum uint64) common.Hash {
+	return extract.ComputedOutputRootV0(benchOutput(blockNum))
+}
+
+func benchOutput(blockNum uint64) *eth.OutputResponse {
+	return &eth.OutputResponse{
+		BlockRef:              eth.L2BlockRef{Number: blockNum, Hash: common.BigToHash(new(big.Int).SetUint64(blockNum))},
+		StateRoot:             common.Hash{0x02},
+		WithdrawalStorageRoot: common.Hash{0x03},
+	}
+}
+
+// benchGameCaller serves a game's metadata and root claim.
+// Only the calls required by the enrichers used in the benchmark are supported.
+type benchGameCaller struct {
+	extract.GameCaller
+	metadata contracts.GameMetadata
+}
+
+func (c *benchGameCaller) GetGameMetadata(_ context.Context, _ rpcblock.Block) (contracts.GameMetadata, error) {
+	return c.metadata, nil
+}
+
+func (c *benchGameCaller) GetAllClaims(_ context.Context, _ rpcblock.Block) ([]faultTypes.Claim, error) {
+	return []faultTypes.Claim{{
+		ClaimData: faultTypes.ClaimData{Position: faultTypes.RootPosition, Value: c.metadata.RootClaim, Bond: big.NewInt(1)},
+	}}, nil
+}
+
+// benchRollupClient reports consistent outputs for every block with all blocks safe, after a fixed latency.
+type benchRollupClient struct {
+	latency time.Duration
+}
+
+func (r *benchRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	output := benchOutput(blockNum)
+	output.OutputRoot = eth.Bytes32(extract.ComputedOutputRootV0(output))
+	return output, nil
+}
+
+func (r *benchRollupClient) SafeHeadAtL1Block(ctx context.Context, _ uint64) (*eth.SafeHeadResponse, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return &eth.SafeHeadResponse{SafeHead: eth.BlockID{Number: uint64(*benchGames) + 1}}, nil
+}
+
+func (r *benchRollupClient) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	head := eth.L2BlockRef{Number: uint64(*benchGames) + 1}
+	return &eth.SyncStatus{UnsafeL2: head, SafeL2: head, FinalizedL2: head}, nil
+}
+
+func (r *benchRollupClient) wait(ctx context.Context) error {
+	select {
+	case <-time.After(r.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
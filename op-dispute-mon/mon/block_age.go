@@ -0,0 +1,70 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	BlockAgeUnderOneHour  = "<1h"
+	BlockAgeOneToSixHours = "1-6h"
+	BlockAgeOverSixHours  = ">6h"
+)
+
+var blockAgeBuckets = []string{BlockAgeUnderOneHour, BlockAgeOneToSixHours, BlockAgeOverSixHours}
+
+type BlockAgeMetrics interface {
+	RecordAgreementByBlockAge(bucket string, status string, count int)
+}
+
+// BlockAgeMonitor groups games by how far behind the rollup node's safe head the disputed block was
+// when the game's output root was checked. This highlights systematic proposer drift.
+type BlockAgeMonitor struct {
+	logger  log.Logger
+	metrics BlockAgeMetrics
+}
+
+func NewBlockAgeMonitor(logger log.Logger, metrics BlockAgeMetrics) *BlockAgeMonitor {
+	return &BlockAgeMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *BlockAgeMonitor) CheckBlockAge(games []*types.EnrichedGameData) {
+	agree := make(map[string]int)
+	disagree := make(map[string]int)
+	for _, game := range games {
+		if game.L2BlockTimestamp == 0 || game.RollupSafeHead.Time == 0 {
+			// The block wasn't available from the rollup node so there is no age to compare.
+			continue
+		}
+		bucket := blockAgeBucket(game.L2BlockTimestamp, game.RollupSafeHead.Time)
+		if game.AgreeWithClaim {
+			agree[bucket]++
+		} else {
+			disagree[bucket]++
+		}
+	}
+	for _, bucket := range blockAgeBuckets {
+		m.metrics.RecordAgreementByBlockAge(bucket, "agree", agree[bucket])
+		m.metrics.RecordAgreementByBlockAge(bucket, "disagree", disagree[bucket])
+	}
+}
+
+func blockAgeBucket(blockTime uint64, safeHeadTime uint64) string {
+	var age time.Duration
+	if safeHeadTime > blockTime {
+		age = time.Duration(safeHeadTime-blockTime) * time.Second
+	}
+	switch {
+	case age < time.Hour:
+		return BlockAgeUnderOneHour
+	case age <= 6*time.Hour:
+		return BlockAgeOneToSixHours
+	default:
+		return BlockAgeOverSixHours
+	}
+}
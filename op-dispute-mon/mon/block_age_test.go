@@ -0,0 +1,50 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorBlockAge(t *testing.T) {
+	safeHead := eth.L2BlockRef{Number: 100_000, Time: 1_000_000}
+	games := []*types.EnrichedGameData{
+		// Recent game, 10 minutes behind the safe head
+		{L2BlockTimestamp: safeHead.Time - 600, RollupSafeHead: safeHead, AgreeWithClaim: true},
+		// Old game, 12 hours behind the safe head
+		{L2BlockTimestamp: safeHead.Time - 12*60*60, RollupSafeHead: safeHead, AgreeWithClaim: false},
+		// Three hours behind the safe head
+		{L2BlockTimestamp: safeHead.Time - 3*60*60, RollupSafeHead: safeHead, AgreeWithClaim: false},
+		// Ahead of the safe head
+		{L2BlockTimestamp: safeHead.Time + 60, RollupSafeHead: safeHead, AgreeWithClaim: false},
+		// Block not available from the rollup node
+		{AgreeWithClaim: false},
+	}
+	metrics := &stubBlockAgeMetrics{}
+	monitor := NewBlockAgeMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+	monitor.CheckBlockAge(games)
+
+	require.Equal(t, map[string]int{
+		BlockAgeUnderOneHour + "/agree":     1,
+		BlockAgeUnderOneHour + "/disagree":  1,
+		BlockAgeOneToSixHours + "/agree":    0,
+		BlockAgeOneToSixHours + "/disagree": 1,
+		BlockAgeOverSixHours + "/agree":     0,
+		BlockAgeOverSixHours + "/disagree":  1,
+	}, metrics.counts)
+}
+
+type stubBlockAgeMetrics struct {
+	counts map[string]int
+}
+
+func (s *stubBlockAgeMetrics) RecordAgreementByBlockAge(bucket string, status string, count int) {
+	if s.counts == nil {
+		s.counts = make(map[string]int)
+	}
+	s.counts[bucket+"/"+status] = count
+}
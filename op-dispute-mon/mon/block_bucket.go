@@ -0,0 +1,46 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// blockBucketSize is the number of L2 blocks grouped into each disputed block bucket.
+const blockBucketSize = 1000
+
+type BlockBucketMetrics interface {
+	RecordMaxGamesPerBlockBucket(count int)
+}
+
+// BlockBucketMonitor groups games by disputed L2 block range and reports the most games found in any one range.
+// A high count highlights a block range with many competing proposals.
+type BlockBucketMonitor struct {
+	logger  log.Logger
+	metrics BlockBucketMetrics
+}
+
+func NewBlockBucketMonitor(logger log.Logger, metrics BlockBucketMetrics) *BlockBucketMonitor {
+	return &BlockBucketMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *BlockBucketMonitor) CheckBlockBuckets(games []*types.EnrichedGameData) {
+	buckets := make(map[uint64]int)
+	maxGames := 0
+	var hottest uint64
+	for _, game := range games {
+		bucket := game.L2BlockNumber / blockBucketSize
+		buckets[bucket]++
+		if buckets[bucket] > maxGames {
+			maxGames = buckets[bucket]
+			hottest = bucket
+		}
+	}
+	if maxGames > 1 {
+		m.logger.Debug("Found block range with multiple games",
+			"start", hottest*blockBucketSize, "end", (hottest+1)*blockBucketSize-1, "games", maxGames)
+	}
+	m.metrics.RecordMaxGamesPerBlockBucket(maxGames)
+}
@@ -0,0 +1,57 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorBlockBuckets(t *testing.T) {
+	t.Run("NoGames", func(t *testing.T) {
+		metrics := &stubBlockBucketMetrics{maxGames: -1}
+		monitor := NewBlockBucketMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckBlockBuckets(nil)
+		require.Zero(t, metrics.maxGames)
+	})
+
+	t.Run("ClusteredGames", func(t *testing.T) {
+		games := []*types.EnrichedGameData{
+			{L2BlockNumber: 150},
+			// Four games clustered in the 5000-5999 range
+			{L2BlockNumber: 5000},
+			{L2BlockNumber: 5001},
+			{L2BlockNumber: 5500},
+			{L2BlockNumber: 5999},
+			// Just beyond the clustered range
+			{L2BlockNumber: 6000},
+			{L2BlockNumber: 6001},
+		}
+		metrics := &stubBlockBucketMetrics{}
+		monitor := NewBlockBucketMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckBlockBuckets(games)
+		require.Equal(t, 4, metrics.maxGames)
+	})
+
+	t.Run("SpreadGames", func(t *testing.T) {
+		games := []*types.EnrichedGameData{
+			{L2BlockNumber: 999},
+			{L2BlockNumber: 1000},
+			{L2BlockNumber: 2000},
+		}
+		metrics := &stubBlockBucketMetrics{}
+		monitor := NewBlockBucketMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckBlockBuckets(games)
+		require.Equal(t, 1, metrics.maxGames)
+	})
+}
+
+type stubBlockBucketMetrics struct {
+	maxGames int
+}
+
+func (s *stubBlockBucketMetrics) RecordMaxGamesPerBlockBucket(count int) {
+	s.maxGames = count
+}
@@ -0,0 +1,40 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type BondTokenMetrics interface {
+	RecordUnexpectedBondToken(count int)
+}
+
+// BondTokenMonitor reports games holding bonds in a token other than the expected DelayedWETH contract.
+// Bonds held elsewhere are not covered by the bond collateral checks against the expected contract.
+type BondTokenMonitor struct {
+	logger   log.Logger
+	metrics  BondTokenMetrics
+	expected common.Address
+}
+
+func NewBondTokenMonitor(logger log.Logger, metrics BondTokenMetrics, expected common.Address) *BondTokenMonitor {
+	return &BondTokenMonitor{
+		logger:   logger,
+		metrics:  metrics,
+		expected: expected,
+	}
+}
+
+func (m *BondTokenMonitor) CheckBondTokens(games []*types.EnrichedGameData) {
+	unexpected := 0
+	for _, game := range games {
+		if game.WETHContract == m.expected {
+			continue
+		}
+		unexpected++
+		m.logger.Error("Game uses unexpected bond token",
+			"game", game.Proxy, "bondToken", game.WETHContract, "expectedBondToken", m.expected)
+	}
+	m.metrics.RecordUnexpectedBondToken(unexpected)
+}
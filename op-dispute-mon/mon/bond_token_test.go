@@ -0,0 +1,46 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBondTokens(t *testing.T) {
+	expected := common.Address{0xee}
+	foreign := common.Address{0xff}
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubBondTokenMetrics{}
+	monitor := NewBondTokenMonitor(logger, metrics, expected)
+
+	monitor.CheckBondTokens([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, WETHContract: expected},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}, WETHContract: foreign},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}, WETHContract: expected},
+	})
+	require.Equal(t, 1, metrics.unexpected)
+
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Game uses unexpected bond token"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xbb}, l.AttrValue("game"))
+	require.Equal(t, foreign, l.AttrValue("bondToken"))
+	require.Equal(t, expected, l.AttrValue("expectedBondToken"))
+
+	monitor.CheckBondTokens([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, WETHContract: expected},
+	})
+	require.Zero(t, metrics.unexpected)
+}
+
+type stubBondTokenMetrics struct {
+	unexpected int
+}
+
+func (s *stubBondTokenMetrics) RecordUnexpectedBondToken(count int) {
+	s.unexpected = count
+}
@@ -50,7 +50,7 @@ func (b *Bonds) checkCredits(games []*types.EnrichedGameData) {
 
 	for _, game := range games {
 		// Check if the max duration has been reached for this game
-		duration := uint64(b.clock.Now().Unix()) - game.Timestamp
+		duration := game.Age(b.clock.Now())
 		maxDurationReached := duration >= game.MaxClockDuration+uint64(game.WETHDelay.Seconds())
 
 		// Iterate over claims, filter out resolved ones and sum up expected credits per recipient
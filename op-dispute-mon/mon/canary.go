@@ -0,0 +1,48 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type CanaryMetrics interface {
+	RecordCanaryFailure(failed bool)
+}
+
+// CanaryMonitor checks a known game is classified as expected each cycle, validating the monitoring pipeline end-to-end.
+// The canary is considered failed if it is misclassified or not found at all.
+type CanaryMonitor struct {
+	logger        log.Logger
+	metrics       CanaryMetrics
+	game          common.Address
+	expectedAgree bool
+}
+
+func NewCanaryMonitor(logger log.Logger, metrics CanaryMetrics, game common.Address, expectedAgree bool) *CanaryMonitor {
+	return &CanaryMonitor{
+		logger:        logger,
+		metrics:       metrics,
+		game:          game,
+		expectedAgree: expectedAgree,
+	}
+}
+
+func (m *CanaryMonitor) CheckCanary(games []*types.EnrichedGameData) {
+	for _, game := range games {
+		if game.Proxy != m.game {
+			continue
+		}
+		if game.AgreeWithClaim != m.expectedAgree {
+			m.logger.Error("Canary game misclassified, monitoring may be unreliable",
+				"game", game.Proxy, "expectedAgree", m.expectedAgree, "actualAgree", game.AgreeWithClaim,
+				"rootClaim", game.RootClaim, "expectedRootClaim", game.ExpectedRootClaim)
+			m.metrics.RecordCanaryFailure(true)
+			return
+		}
+		m.metrics.RecordCanaryFailure(false)
+		return
+	}
+	m.logger.Error("Canary game not found, monitoring may be unreliable", "game", m.game)
+	m.metrics.RecordCanaryFailure(true)
+}
@@ -0,0 +1,64 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorCanary(t *testing.T) {
+	canary := common.Address{0xca}
+	setup := func(t *testing.T, expectedAgree bool) (*CanaryMonitor, *stubCanaryMetrics, *testlog.CapturingHandler) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubCanaryMetrics{}
+		return NewCanaryMonitor(logger, metrics, canary, expectedAgree), metrics, logs
+	}
+	games := func(canaryAgree bool) []*types.EnrichedGameData {
+		return []*types.EnrichedGameData{
+			{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, AgreeWithClaim: !canaryAgree},
+			{GameMetadata: gameTypes.GameMetadata{Proxy: canary}, AgreeWithClaim: canaryAgree},
+		}
+	}
+
+	t.Run("ClassifiedAsExpected", func(t *testing.T) {
+		monitor, metrics, logs := setup(t, false)
+		monitor.CheckCanary(games(false))
+		require.Equal(t, []bool{false}, metrics.failures)
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelError)))
+	})
+
+	t.Run("Misclassified", func(t *testing.T) {
+		monitor, metrics, logs := setup(t, false)
+		monitor.CheckCanary(games(true))
+		require.Equal(t, []bool{true}, metrics.failures)
+		l := logs.FindLog(
+			testlog.NewLevelFilter(log.LevelError),
+			testlog.NewMessageFilter("Canary game misclassified, monitoring may be unreliable"))
+		require.NotNil(t, l)
+		require.Equal(t, canary, l.AttrValue("game"))
+		require.Equal(t, false, l.AttrValue("expectedAgree"))
+		require.Equal(t, true, l.AttrValue("actualAgree"))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		monitor, metrics, logs := setup(t, true)
+		monitor.CheckCanary(games(true)[:1])
+		require.Equal(t, []bool{true}, metrics.failures)
+		require.NotNil(t, logs.FindLog(
+			testlog.NewLevelFilter(log.LevelError),
+			testlog.NewMessageFilter("Canary game not found, monitoring may be unreliable")))
+	})
+}
+
+type stubCanaryMetrics struct {
+	failures []bool
+}
+
+func (s *stubCanaryMetrics) RecordCanaryFailure(failed bool) {
+	s.failures = append(s.failures, failed)
+}
@@ -0,0 +1,102 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type CircuitBreakerMetrics interface {
+	RecordCircuitBreakerState(open bool)
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker pauses monitoring after a number of consecutive cycles in which no game could be loaded,
+// typically because the rollup node is down, rather than polling it and logging the same failures every cycle.
+// Once the cooldown has elapsed a single cycle is attempted. The breaker closes if that cycle succeeds
+// and opens for another cooldown if it fails.
+// Not safe for concurrent use. It is only used from the monitoring loop.
+type circuitBreaker struct {
+	logger  log.Logger
+	clock   RClock
+	metrics CircuitBreakerMetrics
+
+	threshold int
+	cooldown  time.Duration
+
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func newCircuitBreaker(logger log.Logger, clock RClock, metrics CircuitBreakerMetrics, threshold uint, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		logger:    logger,
+		clock:     clock,
+		metrics:   metrics,
+		threshold: int(threshold),
+		cooldown:  cooldown,
+	}
+}
+
+// Allow returns true if a monitoring cycle should be run.
+// Moves an open breaker to half-open once the cooldown has elapsed.
+func (b *circuitBreaker) Allow() bool {
+	if b.state != breakerOpen {
+		return true
+	}
+	if b.clock.Now().Before(b.openedAt.Add(b.cooldown)) {
+		return false
+	}
+	b.logger.Info("Circuit breaker cooldown elapsed, retrying monitoring", "failures", b.failures)
+	b.state = breakerHalfOpen
+	return true
+}
+
+// Record updates the breaker with the outcome of a monitoring cycle.
+// failed is true if no games could be loaded in the cycle.
+func (b *circuitBreaker) Record(failed bool) {
+	switch {
+	case !failed:
+		if b.state != breakerClosed {
+			b.logger.Info("Monitoring cycle succeeded, closing circuit breaker")
+		}
+		b.state = breakerClosed
+		b.failures = 0
+	case b.state == breakerHalfOpen:
+		b.failures++
+		b.open()
+	default:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.open()
+		}
+	}
+	b.metrics.RecordCircuitBreakerState(b.state != breakerClosed)
+}
+
+func (b *circuitBreaker) open() {
+	b.logger.Error("Opening circuit breaker, pausing monitoring", "failures", b.failures, "cooldown", b.cooldown)
+	b.state = breakerOpen
+	b.openedAt = b.clock.Now()
+}
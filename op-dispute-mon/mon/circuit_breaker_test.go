@@ -0,0 +1,132 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	setup := func(t *testing.T) (*circuitBreaker, *clock.DeterministicClock, *stubCircuitBreakerMetrics) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		m := &stubCircuitBreakerMetrics{}
+		return newCircuitBreaker(testlog.Logger(t, log.LvlInfo), cl, m, 3, time.Minute), cl, m
+	}
+
+	t.Run("OpensAfterConsecutiveFailures", func(t *testing.T) {
+		breaker, _, m := setup(t)
+		for i := 0; i < 2; i++ {
+			require.True(t, breaker.Allow())
+			breaker.Record(true)
+			require.False(t, m.open)
+		}
+		require.True(t, breaker.Allow())
+		breaker.Record(true)
+		require.True(t, m.open)
+		require.Equal(t, breakerOpen, breaker.state)
+		require.False(t, breaker.Allow())
+	})
+
+	t.Run("SuccessResetsFailures", func(t *testing.T) {
+		breaker, _, m := setup(t)
+		breaker.Record(true)
+		breaker.Record(true)
+		breaker.Record(false)
+		breaker.Record(true)
+		breaker.Record(true)
+		require.False(t, m.open)
+		require.True(t, breaker.Allow())
+	})
+
+	t.Run("PausedDuringCooldown", func(t *testing.T) {
+		breaker, cl, m := setup(t)
+		for i := 0; i < 3; i++ {
+			breaker.Record(true)
+		}
+		cl.AdvanceTime(59 * time.Second)
+		require.False(t, breaker.Allow())
+		require.True(t, m.open)
+	})
+
+	t.Run("HalfOpenAfterCooldownThenCloses", func(t *testing.T) {
+		breaker, cl, m := setup(t)
+		for i := 0; i < 3; i++ {
+			breaker.Record(true)
+		}
+		cl.AdvanceTime(time.Minute)
+		require.True(t, breaker.Allow())
+		require.Equal(t, breakerHalfOpen, breaker.state)
+		require.True(t, m.open, "should not report closed until a cycle succeeds")
+
+		breaker.Record(false)
+		require.Equal(t, breakerClosed, breaker.state)
+		require.False(t, m.open)
+		require.True(t, breaker.Allow())
+	})
+
+	t.Run("HalfOpenReopensOnFailure", func(t *testing.T) {
+		breaker, cl, m := setup(t)
+		for i := 0; i < 3; i++ {
+			breaker.Record(true)
+		}
+		cl.AdvanceTime(time.Minute)
+		require.True(t, breaker.Allow())
+
+		// A single failure reopens the breaker for another cooldown
+		breaker.Record(true)
+		require.Equal(t, breakerOpen, breaker.state)
+		require.True(t, m.open)
+		cl.AdvanceTime(59 * time.Second)
+		require.False(t, breaker.Allow())
+		cl.AdvanceTime(time.Second)
+		require.True(t, breaker.Allow())
+	})
+}
+
+func TestMonitor_CircuitBreaker(t *testing.T) {
+	monitor, extractor, forecast, _ := setupMonitorTest(t)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	m := &stubCircuitBreakerMetrics{}
+	monitor.breaker = newCircuitBreaker(testlog.Logger(t, log.LvlInfo), cl, m, 2, time.Minute)
+
+	// Every game fails to load
+	extractor.games = nil
+	extractor.failedCount = 3
+	require.NoError(t, monitor.monitorGames())
+	require.NoError(t, monitor.monitorGames())
+	require.True(t, m.open)
+	require.Equal(t, 2, forecast.calls)
+	require.Equal(t, 2, extractor.calls)
+
+	// Cycles are skipped while the breaker is open
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 2, extractor.calls)
+
+	// Extraction errors also count as failed cycles
+	cl.AdvanceTime(time.Minute)
+	extractor.fetchErr = mockErr
+	require.ErrorIs(t, monitor.monitorGames(), mockErr)
+	require.Equal(t, 3, extractor.calls)
+	require.True(t, m.open)
+
+	cl.AdvanceTime(time.Minute)
+	extractor.fetchErr = nil
+	extractor.games = []*monTypes.EnrichedGameData{{}}
+	extractor.failedCount = 1
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 4, extractor.calls)
+	require.False(t, m.open)
+}
+
+type stubCircuitBreakerMetrics struct {
+	open bool
+}
+
+func (s *stubCircuitBreakerMetrics) RecordCircuitBreakerState(open bool) {
+	s.open = open
+}
@@ -0,0 +1,38 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ClaimAgreementMetrics interface {
+	RecordClaimAgreement(agree, disagree int)
+}
+
+// ClaimAgreementMonitor reports how many output root claims posted in in-progress games agree with the rollup node.
+type ClaimAgreementMonitor struct {
+	logger  log.Logger
+	metrics ClaimAgreementMetrics
+}
+
+func NewClaimAgreementMonitor(logger log.Logger, metrics ClaimAgreementMetrics) *ClaimAgreementMonitor {
+	return &ClaimAgreementMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *ClaimAgreementMonitor) CheckClaimAgreement(games []*types.EnrichedGameData) {
+	agree := 0
+	disagree := 0
+	for _, game := range games {
+		if game.AgreeingClaims == 0 && game.DisagreeingClaims == 0 {
+			continue
+		}
+		m.logger.Debug("Game claim agreement", "game", game.Proxy,
+			"agreeingClaims", game.AgreeingClaims, "disagreeingClaims", game.DisagreeingClaims)
+		agree += game.AgreeingClaims
+		disagree += game.DisagreeingClaims
+	}
+	m.metrics.RecordClaimAgreement(agree, disagree)
+}
@@ -0,0 +1,37 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorClaimAgreement(t *testing.T) {
+	games := []*types.EnrichedGameData{
+		{AgreeingClaims: 3, DisagreeingClaims: 2},
+		{AgreeingClaims: 1},
+		{},
+	}
+	metrics := &stubClaimAgreementMetrics{}
+	monitor := NewClaimAgreementMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+	monitor.CheckClaimAgreement(games)
+	require.Equal(t, 4, metrics.agree)
+	require.Equal(t, 2, metrics.disagree)
+
+	monitor.CheckClaimAgreement(nil)
+	require.Zero(t, metrics.agree)
+	require.Zero(t, metrics.disagree)
+}
+
+type stubClaimAgreementMetrics struct {
+	agree    int
+	disagree int
+}
+
+func (s *stubClaimAgreementMetrics) RecordClaimAgreement(agree, disagree int) {
+	s.agree = agree
+	s.disagree = disagree
+}
@@ -83,7 +83,7 @@ func (c *ClaimMonitor) checkGameClaims(
 ) {
 	// Check if the game is in the first half
 	now := c.clock.Now()
-	duration := uint64(now.Unix()) - game.Timestamp
+	duration := game.Age(now)
 	firstHalf := duration <= game.MaxClockDuration
 
 	minDescendantAccumulatedTimeByIndex := make(map[int]time.Duration)
@@ -0,0 +1,75 @@
+package mon
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ClockSkewMetrics interface {
+	RecordClockSkew(seconds float64)
+}
+
+// L1TimeFetcher returns the timestamp of the latest L1 block.
+type L1TimeFetcher func(ctx context.Context) (uint64, error)
+
+// ClockSkewGuard compares local time against the timestamp of the latest L1 block.
+// While local time diverges from L1 by more than the threshold, Now reports the time according to L1 instead
+// so clock expiry and staleness are classified against the chain rather than a drifting local clock.
+type ClockSkewGuard struct {
+	logger      log.Logger
+	metrics     ClockSkewMetrics
+	clock       RClock
+	fetchL1Time L1TimeFetcher
+	threshold   time.Duration
+
+	// correction is subtracted from local time to give the time according to L1. Zero while within the threshold.
+	correction atomic.Int64
+}
+
+func NewClockSkewGuard(logger log.Logger, metrics ClockSkewMetrics, clock RClock, fetchL1Time L1TimeFetcher, threshold time.Duration) *ClockSkewGuard {
+	return &ClockSkewGuard{
+		logger:      logger,
+		metrics:     metrics,
+		clock:       clock,
+		fetchL1Time: fetchL1Time,
+		threshold:   threshold,
+	}
+}
+
+// Now returns the local time, corrected to L1 time if local time was skewed when last checked.
+func (g *ClockSkewGuard) Now() time.Time {
+	return g.clock.Now().Add(-time.Duration(g.correction.Load()))
+}
+
+// Check measures the skew between local time and the latest L1 block timestamp.
+// If the L1 timestamp can't be fetched the previous correction is retained.
+func (g *ClockSkewGuard) Check(ctx context.Context) {
+	l1Time, err := g.fetchL1Time(ctx)
+	if err != nil {
+		g.logger.Warn("Failed to fetch L1 time to check clock skew", "err", err)
+		return
+	}
+	local := g.clock.Now()
+	skew := local.Sub(time.Unix(int64(l1Time), 0))
+	g.metrics.RecordClockSkew(skew.Seconds())
+	if skew.Abs() > g.threshold {
+		g.logger.Warn("Local time diverges from L1, using L1 time", "skew", skew, "threshold", g.threshold, "local", local.Unix(), "l1Time", l1Time)
+		g.correction.Store(int64(skew))
+		return
+	}
+	g.correction.Store(0)
+}
+
+// checkClockSkew returns an Extract that checks the clock skew before loading any games,
+// so time based classifications in the cycle use the latest correction.
+func checkClockSkew(extract Extract, guard *ClockSkewGuard) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		guard.Check(ctx)
+		return extract(ctx, blockHash, minTimestamp)
+	}
+}
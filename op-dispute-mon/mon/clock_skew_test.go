@@ -0,0 +1,107 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSkewGuard(t *testing.T) {
+	setup := func(t *testing.T) (*ClockSkewGuard, *clock.DeterministicClock, *stubL1Time, *stubClockSkewMetrics, *testlog.CapturingHandler) {
+		cl := clock.NewDeterministicClock(time.Unix(10_000, 0))
+		l1 := &stubL1Time{timestamp: 10_000}
+		metrics := &stubClockSkewMetrics{}
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		return NewClockSkewGuard(logger, metrics, cl, l1.fetch, time.Minute), cl, l1, metrics, logs
+	}
+
+	t.Run("WithinThreshold", func(t *testing.T) {
+		guard, cl, l1, metrics, logs := setup(t)
+		l1.timestamp = 9_988
+		guard.Check(context.Background())
+		require.Equal(t, 12.0, metrics.skew)
+		require.Equal(t, cl.Now(), guard.Now())
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn)))
+	})
+
+	t.Run("LocalTimeAhead", func(t *testing.T) {
+		guard, _, l1, metrics, logs := setup(t)
+		l1.timestamp = 6_400
+		guard.Check(context.Background())
+		require.Equal(t, 3600.0, metrics.skew)
+		require.Equal(t, time.Unix(6_400, 0), guard.Now())
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Local time diverges from L1, using L1 time")))
+	})
+
+	t.Run("LocalTimeBehind", func(t *testing.T) {
+		guard, cl, l1, metrics, _ := setup(t)
+		l1.timestamp = 13_600
+		guard.Check(context.Background())
+		require.Equal(t, -3600.0, metrics.skew)
+		require.Equal(t, time.Unix(13_600, 0), guard.Now())
+
+		// The correction continues to apply as local time advances
+		cl.AdvanceTime(time.Minute)
+		require.Equal(t, time.Unix(13_660, 0), guard.Now())
+	})
+
+	t.Run("ReturnsToLocalTimeOnceWithinThreshold", func(t *testing.T) {
+		guard, cl, l1, _, _ := setup(t)
+		l1.timestamp = 6_400
+		guard.Check(context.Background())
+		require.Equal(t, time.Unix(6_400, 0), guard.Now())
+
+		l1.timestamp = 10_000
+		guard.Check(context.Background())
+		require.Equal(t, cl.Now(), guard.Now())
+	})
+
+	t.Run("RetainsCorrectionWhenL1Unavailable", func(t *testing.T) {
+		guard, _, l1, _, logs := setup(t)
+		l1.timestamp = 6_400
+		guard.Check(context.Background())
+
+		l1.err = errors.New("boom")
+		guard.Check(context.Background())
+		require.Equal(t, time.Unix(6_400, 0), guard.Now())
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Failed to fetch L1 time to check clock skew")))
+	})
+
+	t.Run("CheckedBeforeExtract", func(t *testing.T) {
+		guard, _, l1, _, _ := setup(t)
+		l1.timestamp = 6_400
+		var now time.Time
+		extract := checkClockSkew(func(_ context.Context, _ common.Hash, _ uint64) ([]*types.EnrichedGameData, int, int, error) {
+			now = guard.Now()
+			return nil, 0, 0, nil
+		}, guard)
+		_, _, _, err := extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(6_400, 0), now)
+	})
+}
+
+type stubL1Time struct {
+	timestamp uint64
+	err       error
+}
+
+func (s *stubL1Time) fetch(_ context.Context) (uint64, error) {
+	return s.timestamp, s.err
+}
+
+type stubClockSkewMetrics struct {
+	skew float64
+}
+
+func (s *stubClockSkewMetrics) RecordClockSkew(seconds float64) {
+	s.skew = seconds
+}
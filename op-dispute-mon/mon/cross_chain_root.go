@@ -0,0 +1,38 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type CrossChainRootMetrics interface {
+	RecordCrossChainRootGames(count int)
+}
+
+// CrossChainRootMonitor reports games whose root claim is a valid output of a different L2 chain than the one the
+// game disputes.
+type CrossChainRootMonitor struct {
+	logger  log.Logger
+	metrics CrossChainRootMetrics
+}
+
+func NewCrossChainRootMonitor(logger log.Logger, metrics CrossChainRootMetrics) *CrossChainRootMonitor {
+	return &CrossChainRootMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *CrossChainRootMonitor) CheckCrossChainRoots(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if !game.CrossChainRoot {
+			continue
+		}
+		count++
+		m.logger.Error("Game root claim matches the output of a different chain",
+			"game", game.Proxy, "l2ChainID", game.L2ChainID, "matchedChainID", game.CrossChainRootChainID,
+			"l2BlockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+	}
+	m.metrics.RecordCrossChainRootGames(count)
+}
@@ -0,0 +1,38 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCrossChainRoots(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubCrossChainRootMetrics{}
+	monitor := NewCrossChainRootMonitor(logger, metrics)
+	monitor.CheckCrossChainRoots([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, L2ChainID: 10, CrossChainRoot: true, CrossChainRootChainID: 20},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}, L2ChainID: 10},
+	})
+	require.Equal(t, 1, metrics.count)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Game root claim matches the output of a different chain"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xaa}, l.AttrValue("game"))
+	require.Equal(t, uint64(20), l.AttrValue("matchedChainID"))
+
+	monitor.CheckCrossChainRoots(nil)
+	require.Zero(t, metrics.count)
+}
+
+type stubCrossChainRootMetrics struct {
+	count int
+}
+
+func (s *stubCrossChainRootMetrics) RecordCrossChainRootGames(count int) {
+	s.count = count
+}
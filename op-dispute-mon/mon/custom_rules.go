@@ -0,0 +1,105 @@
+package mon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// customRuleTimeout is the maximum time a custom detection rule may take to evaluate all games in a cycle.
+const customRuleTimeout = 10 * time.Second
+
+type CustomRuleMetrics interface {
+	RecordCustomRule(name string, bucket string, count int)
+}
+
+// CustomRuleMonitor evaluates user supplied detection rules against each game and records the number of games
+// matching each rule by bucket. Rules run after the standard monitors and a failing, panicking or slow rule is logged
+// and skipped so it can't prevent other games or rules from being classified.
+type CustomRuleMonitor struct {
+	logger  log.Logger
+	metrics CustomRuleMetrics
+	timeout time.Duration
+	rules   []types.DetectionRule
+
+	// reported tracks the buckets previously reported for each rule so they can be reset once no games match.
+	reported map[string]map[string]bool
+}
+
+func NewCustomRuleMonitor(logger log.Logger, metrics CustomRuleMetrics, timeout time.Duration, rules []types.DetectionRule) *CustomRuleMonitor {
+	return &CustomRuleMonitor{
+		logger:   logger,
+		metrics:  metrics,
+		timeout:  timeout,
+		rules:    rules,
+		reported: make(map[string]map[string]bool),
+	}
+}
+
+func (m *CustomRuleMonitor) CheckCustomRules(ctx context.Context, games []*types.EnrichedGameData) {
+	for _, rule := range m.rules {
+		name := rule.Name()
+		counts, err := m.evaluate(ctx, rule, games)
+		if err != nil {
+			// Leave the previously reported counts in place rather than reporting a partial classification
+			m.logger.Warn("Custom detection rule did not complete", "rule", name, "err", err)
+			continue
+		}
+		previous := m.reported[name]
+		for bucket := range previous {
+			if _, ok := counts[bucket]; !ok {
+				m.metrics.RecordCustomRule(name, bucket, 0)
+			}
+		}
+		current := make(map[string]bool, len(counts))
+		for bucket, count := range counts {
+			m.metrics.RecordCustomRule(name, bucket, count)
+			current[bucket] = true
+		}
+		m.reported[name] = current
+	}
+}
+
+// evaluate returns the number of games matching rule by bucket.
+// The rule is run in a separate goroutine so it is abandoned if it doesn't complete within the timeout.
+func (m *CustomRuleMonitor) evaluate(ctx context.Context, rule types.DetectionRule, games []*types.EnrichedGameData) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	result := make(chan map[string]int, 1)
+	go func() {
+		counts := make(map[string]int)
+		for _, game := range games {
+			if ctx.Err() != nil {
+				return
+			}
+			bucket, matched, err := evaluateRule(ctx, rule, game)
+			if err != nil {
+				m.logger.Warn("Failed to evaluate custom detection rule", "rule", rule.Name(), "game", game.Proxy, "err", err)
+				continue
+			}
+			if matched {
+				counts[bucket]++
+			}
+		}
+		result <- counts
+	}()
+	select {
+	case counts := <-result:
+		return counts, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// evaluateRule evaluates rule against a single game, converting any panic in the rule into an error.
+func evaluateRule(ctx context.Context, rule types.DetectionRule, game *types.EnrichedGameData) (bucket string, matched bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("rule panicked: %v", r)
+		}
+	}()
+	return rule.Evaluate(ctx, game, game.GameMetadata)
+}
@@ -0,0 +1,139 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCustomRules(t *testing.T) {
+	watched := &addressRule{
+		name: "watched",
+		buckets: map[common.Address]string{
+			{0xaa}: "alpha",
+			{0xbb}: "alpha",
+			{0xcc}: "beta",
+		},
+	}
+	failing := &addressRule{name: "failing", err: errors.New("boom")}
+	games := []*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xdd}}},
+	}
+
+	t.Run("MatchingGames", func(t *testing.T) {
+		metrics := &stubCustomRuleMetrics{}
+		monitor := NewCustomRuleMonitor(testlog.Logger(t, log.LvlInfo), metrics, time.Minute, []types.DetectionRule{watched})
+		monitor.CheckCustomRules(context.Background(), games)
+		require.Equal(t, map[string]int{"watched/alpha": 2, "watched/beta": 1}, metrics.counts)
+	})
+
+	t.Run("ResetBucketsWithNoMatches", func(t *testing.T) {
+		metrics := &stubCustomRuleMetrics{}
+		monitor := NewCustomRuleMonitor(testlog.Logger(t, log.LvlInfo), metrics, time.Minute, []types.DetectionRule{watched})
+		monitor.CheckCustomRules(context.Background(), games)
+		monitor.CheckCustomRules(context.Background(), games[:1])
+		require.Equal(t, map[string]int{"watched/alpha": 1, "watched/beta": 0}, metrics.counts)
+	})
+
+	t.Run("ContinueAfterErrors", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubCustomRuleMetrics{}
+		monitor := NewCustomRuleMonitor(logger, metrics, time.Minute, []types.DetectionRule{failing, watched})
+		monitor.CheckCustomRules(context.Background(), games)
+		require.Equal(t, map[string]int{"watched/alpha": 2, "watched/beta": 1}, metrics.counts)
+		levelFilter := testlog.NewLevelFilter(log.LevelWarn)
+		messageFilter := testlog.NewMessageFilter("Failed to evaluate custom detection rule")
+		require.Len(t, logs.FindLogs(levelFilter, messageFilter), len(games))
+		l := logs.FindLog(levelFilter, messageFilter)
+		require.Equal(t, "failing", l.AttrValue("rule"))
+	})
+
+	t.Run("RecoverFromPanics", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubCustomRuleMetrics{}
+		panicking := &addressRule{name: "panicking", panics: true}
+		monitor := NewCustomRuleMonitor(logger, metrics, time.Minute, []types.DetectionRule{panicking, watched})
+		monitor.CheckCustomRules(context.Background(), games)
+		require.Equal(t, map[string]int{"watched/alpha": 2, "watched/beta": 1}, metrics.counts)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Failed to evaluate custom detection rule"))
+		require.NotNil(t, l)
+		require.Equal(t, "panicking", l.AttrValue("rule"))
+	})
+
+	t.Run("AbandonSlowRules", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubCustomRuleMetrics{}
+		release := make(chan struct{})
+		defer close(release)
+		slow := &addressRule{name: "slow", block: release}
+		monitor := NewCustomRuleMonitor(logger, metrics, time.Millisecond, []types.DetectionRule{slow, watched})
+		monitor.CheckCustomRules(context.Background(), games)
+		require.Equal(t, map[string]int{"watched/alpha": 2, "watched/beta": 1}, metrics.counts)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Custom detection rule did not complete"))
+		require.NotNil(t, l)
+		require.Equal(t, "slow", l.AttrValue("rule"))
+	})
+
+	t.Run("StopWhenCycleCancelled", func(t *testing.T) {
+		metrics := &stubCustomRuleMetrics{}
+		slow := &addressRule{name: "slow", block: make(chan struct{})}
+		monitor := NewCustomRuleMonitor(testlog.Logger(t, log.LvlInfo), metrics, time.Minute, []types.DetectionRule{slow})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		monitor.CheckCustomRules(ctx, games)
+		require.Empty(t, metrics.counts)
+	})
+}
+
+type addressRule struct {
+	name    string
+	buckets map[common.Address]string
+	err     error
+	panics  bool
+	// block, if set, blocks evaluation until it is closed or the context is done
+	block chan struct{}
+}
+
+func (r *addressRule) Name() string {
+	return r.name
+}
+
+func (r *addressRule) Evaluate(ctx context.Context, _ *types.EnrichedGameData, meta gameTypes.GameMetadata) (string, bool, error) {
+	if r.err != nil {
+		return "", false, r.err
+	}
+	if r.panics {
+		panic("boom")
+	}
+	if r.block != nil {
+		select {
+		case <-r.block:
+		case <-ctx.Done():
+			return "", false, ctx.Err()
+		}
+	}
+	bucket, ok := r.buckets[meta.Proxy]
+	return bucket, ok, nil
+}
+
+type stubCustomRuleMetrics struct {
+	counts map[string]int
+}
+
+func (s *stubCustomRuleMetrics) RecordCustomRule(name string, bucket string, count int) {
+	if s.counts == nil {
+		s.counts = make(map[string]int)
+	}
+	s.counts[name+"/"+bucket] = count
+}
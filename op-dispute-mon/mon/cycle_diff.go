@@ -0,0 +1,89 @@
+package mon
+
+import (
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type CycleDiffMetrics interface {
+	RecordCycleDiff(added, removed, changed int)
+}
+
+// gameClassification is the classification of a game in a single monitoring cycle.
+type gameClassification struct {
+	status         gameTypes.GameStatus
+	agreeWithClaim bool
+}
+
+type trackedClassification struct {
+	classification gameClassification
+	timestamp      uint64
+}
+
+// CycleDiffMonitor reports the games added, removed and reclassified since the previous monitoring cycle
+// to help operators follow how the set of monitored games changes during an incident.
+type CycleDiffMonitor struct {
+	logger     log.Logger
+	metrics    CycleDiffMetrics
+	clock      RClock
+	gameWindow time.Duration
+
+	// previous is the set of games in the previous cycle.
+	previous map[common.Address]bool
+	// classifications is the last known classification of each game in the game window, including games missing from
+	// the previous cycle, so a reclassification is still reported if the game failed to load in between.
+	classifications map[common.Address]trackedClassification
+}
+
+func NewCycleDiffMonitor(logger log.Logger, metrics CycleDiffMetrics, clock RClock, gameWindow time.Duration) *CycleDiffMonitor {
+	return &CycleDiffMonitor{
+		logger:          logger,
+		metrics:         metrics,
+		clock:           clock,
+		gameWindow:      gameWindow,
+		previous:        make(map[common.Address]bool),
+		classifications: make(map[common.Address]trackedClassification),
+	}
+}
+
+func (m *CycleDiffMonitor) CheckCycleDiff(games []*types.EnrichedGameData) {
+	current := make(map[common.Address]bool, len(games))
+	var added, changed []common.Address
+	for _, game := range games {
+		classification := gameClassification{status: game.Status, agreeWithClaim: game.AgreeWithClaim}
+		current[game.Proxy] = true
+		if !m.previous[game.Proxy] {
+			added = append(added, game.Proxy)
+		}
+		tracked, ok := m.classifications[game.Proxy]
+		m.classifications[game.Proxy] = trackedClassification{classification: classification, timestamp: game.Timestamp}
+		if ok && tracked.classification != classification {
+			changed = append(changed, game.Proxy)
+			m.logger.Debug("Game classification changed", "game", game.Proxy,
+				"previousStatus", tracked.classification.status, "status", classification.status,
+				"previousAgreement", tracked.classification.agreeWithClaim, "agreement", classification.agreeWithClaim)
+		}
+	}
+	var removed []common.Address
+	for proxy := range m.previous {
+		if !current[proxy] {
+			removed = append(removed, proxy)
+		}
+	}
+	m.previous = current
+	// Classifications are pruned once games leave the game window so memory use remains bounded by the game window.
+	for proxy, tracked := range m.classifications {
+		if outsideGameWindow(m.clock, m.gameWindow, tracked.timestamp) {
+			delete(m.classifications, proxy)
+		}
+	}
+	if len(added) > 0 || len(removed) > 0 || len(changed) > 0 {
+		m.logger.Info("Games changed since previous cycle",
+			"added", len(added), "removed", len(removed), "changed", len(changed), "changedGames", changed)
+	}
+	m.metrics.RecordCycleDiff(len(added), len(removed), len(changed))
+}
@@ -0,0 +1,80 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCycleDiff(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubCycleDiffMetrics{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	monitor := NewCycleDiffMonitor(logger, metrics, cl, time.Hour)
+	game := func(proxy common.Address, status gameTypes.GameStatus, agree bool) *types.EnrichedGameData {
+		return &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: proxy, Timestamp: 1000}, Status: status, AgreeWithClaim: agree}
+	}
+
+	monitor.CheckCycleDiff([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, gameTypes.GameStatusInProgress, true),
+		game(common.Address{0xbb}, gameTypes.GameStatusInProgress, true),
+		game(common.Address{0xcc}, gameTypes.GameStatusInProgress, false),
+	})
+	require.Equal(t, stubCycleDiffMetrics{added: 3}, *metrics)
+
+	monitor.CheckCycleDiff([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, gameTypes.GameStatusInProgress, true),  // Unchanged
+		game(common.Address{0xbb}, gameTypes.GameStatusDefenderWon, true), // Resolved
+		game(common.Address{0xcc}, gameTypes.GameStatusInProgress, true),  // Agreement changed
+		game(common.Address{0xdd}, gameTypes.GameStatusInProgress, true),  // Added
+		game(common.Address{0xee}, gameTypes.GameStatusInProgress, true),  // Added
+	})
+	require.Equal(t, stubCycleDiffMetrics{added: 2, changed: 2}, *metrics)
+	diffs := logs.FindLogs(testlog.NewLevelFilter(log.LevelInfo), testlog.NewMessageFilter("Games changed since previous cycle"))
+	require.Len(t, diffs, 2)
+	require.Equal(t, []common.Address{{0xbb}, {0xcc}}, diffs[1].AttrValue("changedGames"))
+
+	// Only compared against the immediately preceding cycle
+	monitor.CheckCycleDiff([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, gameTypes.GameStatusInProgress, true),
+	})
+	require.Equal(t, stubCycleDiffMetrics{removed: 4}, *metrics)
+	require.Len(t, monitor.previous, 1)
+
+	monitor.CheckCycleDiff([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, gameTypes.GameStatusInProgress, true),
+	})
+	require.Equal(t, stubCycleDiffMetrics{}, *metrics)
+
+	// A game returning after missing a cycle is added and compared against its last known classification
+	monitor.CheckCycleDiff([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, gameTypes.GameStatusInProgress, true),
+		game(common.Address{0xbb}, gameTypes.GameStatusChallengerWon, true),
+	})
+	require.Equal(t, stubCycleDiffMetrics{added: 1, changed: 1}, *metrics)
+
+	// Classifications are not retained for games outside the game window
+	cl.AdvanceTime(time.Hour + time.Second)
+	monitor.CheckCycleDiff(nil)
+	require.Equal(t, stubCycleDiffMetrics{removed: 2}, *metrics)
+	require.Empty(t, monitor.classifications)
+}
+
+type stubCycleDiffMetrics struct {
+	added   int
+	removed int
+	changed int
+}
+
+func (s *stubCycleDiffMetrics) RecordCycleDiff(added, removed, changed int) {
+	s.added = added
+	s.removed = removed
+	s.changed = changed
+}
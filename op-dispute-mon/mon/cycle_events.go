@@ -0,0 +1,93 @@
+package mon
+
+import (
+	"io"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// CycleEventName identifies detection cycle events so they can be selected by log aggregators.
+const CycleEventName = "detection_cycle"
+
+// CycleResult is the outcome of a single monitoring cycle.
+type CycleResult struct {
+	BlockNumber uint64
+	BlockHash   common.Hash
+	Duration    time.Duration
+	Games       []*monTypes.EnrichedGameData
+	Ignored     int
+	Failed      int
+}
+
+type CycleEventRecorder interface {
+	Record(result CycleResult)
+}
+
+// CycleDisagreement identifies a game whose root claim disagrees with the reference node.
+type CycleDisagreement struct {
+	Game              common.Address `json:"game"`
+	L2BlockNumber     uint64         `json:"l2BlockNumber"`
+	Status            string         `json:"status"`
+	RootClaim         common.Hash    `json:"rootClaim"`
+	ExpectedRootClaim common.Hash    `json:"expectedRootClaim"`
+}
+
+// CycleEvents writes a single JSON object summarising each monitoring cycle, for consumption by log aggregators.
+type CycleEvents struct {
+	logger log.Logger
+	clock  RClock
+}
+
+func NewCycleEvents(w io.Writer, clock RClock) *CycleEvents {
+	return &CycleEvents{
+		logger: log.NewLogger(log.JSONHandler(w)),
+		clock:  clock,
+	}
+}
+
+func (c *CycleEvents) Record(result CycleResult) {
+	inProgress := 0
+	agree := 0
+	disagreements := make([]CycleDisagreement, 0)
+	for _, game := range result.Games {
+		if game.Status == types.GameStatusInProgress {
+			inProgress++
+		}
+		// Games with an inconsistent output from the rollup node aren't classified.
+		if game.RollupInternalInconsistency {
+			continue
+		}
+		if game.AgreeWithClaim {
+			agree++
+			continue
+		}
+		// Pending and indeterminate games can't be classified yet so are not disagreements.
+		if game.Pending || game.Indeterminate {
+			continue
+		}
+		disagreements = append(disagreements, CycleDisagreement{
+			Game:              game.Proxy,
+			L2BlockNumber:     game.L2BlockNumber,
+			Status:            game.Status.String(),
+			RootClaim:         game.RootClaim,
+			ExpectedRootClaim: game.ExpectedRootClaim,
+		})
+	}
+	c.logger.Info("Completed detection cycle",
+		"event", CycleEventName,
+		"timestamp", c.clock.Now().Unix(),
+		"blockNumber", result.BlockNumber,
+		"blockHash", result.BlockHash,
+		"durationSeconds", result.Duration.Seconds(),
+		"games", len(result.Games),
+		"inProgress", inProgress,
+		"agree", agree,
+		"disagree", len(result.Games)-agree,
+		"ignored", result.Ignored,
+		"failed", result.Failed,
+		"disagreements", disagreements)
+}
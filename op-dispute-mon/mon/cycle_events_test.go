@@ -0,0 +1,74 @@
+package mon
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCycleEvents(t *testing.T) {
+	var out bytes.Buffer
+	events := NewCycleEvents(&out, clock.NewDeterministicClock(time.Unix(1234, 0)))
+	events.Record(CycleResult{
+		BlockNumber: 42,
+		BlockHash:   common.Hash{0x42},
+		Duration:    1500 * time.Millisecond,
+		Games: []*monTypes.EnrichedGameData{
+			{GameMetadata: types.GameMetadata{Proxy: common.Address{0xaa}}, Status: types.GameStatusInProgress, AgreeWithClaim: true},
+			{
+				GameMetadata:      types.GameMetadata{Proxy: common.Address{0xbb}},
+				Status:            types.GameStatusDefenderWon,
+				L2BlockNumber:     100,
+				RootClaim:         common.Hash{0x01},
+				ExpectedRootClaim: common.Hash{0x02},
+			},
+			// Pending games are not disagreements
+			{GameMetadata: types.GameMetadata{Proxy: common.Address{0xcc}}, Status: types.GameStatusInProgress, Pending: true},
+		},
+		Ignored: 2,
+		Failed:  1,
+	})
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 1, "should emit a single event per cycle")
+	var event struct {
+		Event           string              `json:"event"`
+		Timestamp       uint64              `json:"timestamp"`
+		BlockNumber     uint64              `json:"blockNumber"`
+		BlockHash       common.Hash         `json:"blockHash"`
+		DurationSeconds float64             `json:"durationSeconds"`
+		Games           int                 `json:"games"`
+		InProgress      int                 `json:"inProgress"`
+		Agree           int                 `json:"agree"`
+		Disagree        int                 `json:"disagree"`
+		Ignored         int                 `json:"ignored"`
+		Failed          int                 `json:"failed"`
+		Disagreements   []CycleDisagreement `json:"disagreements"`
+	}
+	require.NoError(t, json.Unmarshal(lines[0], &event))
+	require.Equal(t, CycleEventName, event.Event)
+	require.Equal(t, uint64(1234), event.Timestamp)
+	require.Equal(t, uint64(42), event.BlockNumber)
+	require.Equal(t, common.Hash{0x42}, event.BlockHash)
+	require.Equal(t, 1.5, event.DurationSeconds)
+	require.Equal(t, 3, event.Games)
+	require.Equal(t, 2, event.InProgress)
+	require.Equal(t, 1, event.Agree)
+	require.Equal(t, 2, event.Disagree)
+	require.Equal(t, 2, event.Ignored)
+	require.Equal(t, 1, event.Failed)
+	require.Equal(t, []CycleDisagreement{{
+		Game:              common.Address{0xbb},
+		L2BlockNumber:     100,
+		Status:            types.GameStatusDefenderWon.String(),
+		RootClaim:         common.Hash{0x01},
+		ExpectedRootClaim: common.Hash{0x02},
+	}}, event.Disagreements)
+}
@@ -0,0 +1,36 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type DepthAnomalyMetrics interface {
+	RecordDepthAnomalies(count int)
+}
+
+// DepthAnomalyMonitor reports games with claims deeper than the game's max depth.
+// The contract rejects moves beyond the max depth so such a claim indicates a contract or loader bug.
+type DepthAnomalyMonitor struct {
+	logger  log.Logger
+	metrics DepthAnomalyMetrics
+}
+
+func NewDepthAnomalyMonitor(logger log.Logger, metrics DepthAnomalyMetrics) *DepthAnomalyMonitor {
+	return &DepthAnomalyMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *DepthAnomalyMonitor) CheckDepthAnomalies(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if game.DeepestClaim > game.MaxGameDepth {
+			m.logger.Error("Game has claims deeper than its max game depth", "game", game.Proxy, "status", game.Status,
+				"deepestClaim", game.DeepestClaim, "maxGameDepth", game.MaxGameDepth)
+			count++
+		}
+	}
+	m.metrics.RecordDepthAnomalies(count)
+}
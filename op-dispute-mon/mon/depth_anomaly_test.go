@@ -0,0 +1,47 @@
+package mon
+
+import (
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDepthAnomalies(t *testing.T) {
+	newGame := func(addr byte, deepest faultTypes.Depth) *types.EnrichedGameData {
+		return &types.EnrichedGameData{
+			GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{addr}},
+			MaxGameDepth: 10,
+			DeepestClaim: deepest,
+		}
+	}
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubDepthAnomalyMetrics{}
+	monitor := NewDepthAnomalyMonitor(logger, metrics)
+	monitor.CheckDepthAnomalies([]*types.EnrichedGameData{
+		newGame(0xaa, 0),
+		newGame(0xbb, 9),
+		newGame(0xcc, 10), // At the max depth
+		newGame(0xdd, 11), // Beyond the max depth
+	})
+	require.Equal(t, 1, metrics.count)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Game has claims deeper than its max game depth"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xdd}, l.AttrValue("game"))
+
+	monitor.CheckDepthAnomalies(nil)
+	require.Zero(t, metrics.count)
+}
+
+type stubDepthAnomalyMetrics struct {
+	count int
+}
+
+func (s *stubDepthAnomalyMetrics) RecordDepthAnomalies(count int) {
+	s.count = count
+}
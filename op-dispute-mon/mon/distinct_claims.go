@@ -0,0 +1,47 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type DistinctClaimsMetrics interface {
+	RecordMaxDistinctClaimsPerBlock(count int)
+}
+
+// DistinctClaimsMonitor reports the most distinct root claims made across games for any one disputed L2 block.
+// Only one root claim can be correct for a block so more than one distinct claim indicates genuine contention.
+type DistinctClaimsMonitor struct {
+	logger  log.Logger
+	metrics DistinctClaimsMetrics
+}
+
+func NewDistinctClaimsMonitor(logger log.Logger, metrics DistinctClaimsMetrics) *DistinctClaimsMonitor {
+	return &DistinctClaimsMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *DistinctClaimsMonitor) CheckDistinctClaims(games []*types.EnrichedGameData) {
+	claims := make(map[uint64]map[common.Hash]bool)
+	maxClaims := 0
+	var contested uint64
+	for _, game := range games {
+		blockClaims, ok := claims[game.L2BlockNumber]
+		if !ok {
+			blockClaims = make(map[common.Hash]bool)
+			claims[game.L2BlockNumber] = blockClaims
+		}
+		blockClaims[game.RootClaim] = true
+		if len(blockClaims) > maxClaims {
+			maxClaims = len(blockClaims)
+			contested = game.L2BlockNumber
+		}
+	}
+	if maxClaims > 1 {
+		m.logger.Debug("Found block with multiple distinct root claims", "l2BlockNum", contested, "claims", maxClaims)
+	}
+	m.metrics.RecordMaxDistinctClaimsPerBlock(maxClaims)
+}
@@ -0,0 +1,51 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorDistinctClaims(t *testing.T) {
+	t.Run("NoGames", func(t *testing.T) {
+		metrics := &stubDistinctClaimsMetrics{maxClaims: -1}
+		monitor := NewDistinctClaimsMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckDistinctClaims(nil)
+		require.Zero(t, metrics.maxClaims)
+	})
+
+	t.Run("DifferentRootsForSameBlock", func(t *testing.T) {
+		games := []*types.EnrichedGameData{
+			{L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+			{L2BlockNumber: 100, RootClaim: common.Hash{0x02}},
+		}
+		metrics := &stubDistinctClaimsMetrics{}
+		monitor := NewDistinctClaimsMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckDistinctClaims(games)
+		require.Equal(t, 2, metrics.maxClaims)
+	})
+
+	t.Run("DuplicateRootsNotDistinct", func(t *testing.T) {
+		games := []*types.EnrichedGameData{
+			{L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+			{L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+			{L2BlockNumber: 101, RootClaim: common.Hash{0x02}},
+		}
+		metrics := &stubDistinctClaimsMetrics{}
+		monitor := NewDistinctClaimsMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckDistinctClaims(games)
+		require.Equal(t, 1, metrics.maxClaims)
+	})
+}
+
+type stubDistinctClaimsMetrics struct {
+	maxClaims int
+}
+
+func (s *stubDistinctClaimsMetrics) RecordMaxDistinctClaimsPerBlock(count int) {
+	s.maxClaims = count
+}
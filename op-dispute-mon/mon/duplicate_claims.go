@@ -0,0 +1,54 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type DuplicateClaimsMetrics interface {
+	RecordDuplicateGameClaims(groups int)
+}
+
+// claimKey identifies the output root a game claims for an L2 block.
+type claimKey struct {
+	l2BlockNumber uint64
+	rootClaim     common.Hash
+}
+
+// DuplicateClaimsMonitor reports groups of games that claim the same output root for the same L2 block.
+// Only one such game is needed so duplicates are either redundant proposals or a griefing pattern.
+type DuplicateClaimsMonitor struct {
+	logger  log.Logger
+	metrics DuplicateClaimsMetrics
+}
+
+func NewDuplicateClaimsMonitor(logger log.Logger, metrics DuplicateClaimsMetrics) *DuplicateClaimsMonitor {
+	return &DuplicateClaimsMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *DuplicateClaimsMonitor) CheckDuplicateClaims(games []*types.EnrichedGameData) {
+	var keys []claimKey
+	groups := make(map[claimKey][]common.Address)
+	for _, game := range games {
+		key := claimKey{l2BlockNumber: game.L2BlockNumber, rootClaim: game.RootClaim}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], game.Proxy)
+	}
+	duplicates := 0
+	for _, key := range keys {
+		proxies := groups[key]
+		if len(proxies) < 2 {
+			continue
+		}
+		duplicates++
+		m.logger.Warn("Multiple games claim the same root",
+			"l2BlockNum", key.l2BlockNumber, "rootClaim", key.rootClaim, "games", proxies)
+	}
+	m.metrics.RecordDuplicateGameClaims(duplicates)
+}
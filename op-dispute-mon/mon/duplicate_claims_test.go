@@ -0,0 +1,45 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDuplicateClaims(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubDuplicateClaimsMetrics{}
+	monitor := NewDuplicateClaimsMonitor(logger, metrics)
+
+	monitor.CheckDuplicateClaims([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}, L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}, L2BlockNumber: 100, RootClaim: common.Hash{0x02}},
+	})
+	require.Equal(t, 1, metrics.groups)
+
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Multiple games claim the same root"))
+	require.NotNil(t, l)
+	require.Equal(t, uint64(100), l.AttrValue("l2BlockNum"))
+	require.Equal(t, common.Hash{0x01}, l.AttrValue("rootClaim"))
+	require.Equal(t, []common.Address{{0xaa}, {0xbb}}, l.AttrValue("games"))
+
+	monitor.CheckDuplicateClaims([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, L2BlockNumber: 100, RootClaim: common.Hash{0x01}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}, L2BlockNumber: 101, RootClaim: common.Hash{0x01}},
+	})
+	require.Zero(t, metrics.groups)
+}
+
+type stubDuplicateClaimsMetrics struct {
+	groups int
+}
+
+func (s *stubDuplicateClaimsMetrics) RecordDuplicateGameClaims(groups int) {
+	s.groups = groups
+}
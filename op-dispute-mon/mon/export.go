@@ -0,0 +1,134 @@
+package mon
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var exportHeader = []string{"address", "l2Block", "rootClaim", "status", "agreement"}
+
+// ExportGames loads the games in the game window once and writes each game and its classification to w as CSV.
+// Only the clients and extractor are initialized so no metrics, status or history are recorded.
+func ExportGames(ctx context.Context, logger log.Logger, cfg *config.Config, w io.Writer) error {
+	s := &Service{
+		cl:           clock.SystemClock,
+		logger:       logger,
+		metrics:      metrics.NoopMetrics,
+		honestActors: types.NewHonestActors(cfg.HonestActors),
+	}
+	if err := s.initL1Client(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init l1 client: %w", err)
+	}
+	defer s.l1Client.Close()
+	if err := s.initFactoryContract(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to create factory contract bindings: %w", err)
+	}
+	if err := s.initOutputRollupClient(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init rollup client: %w", err)
+	}
+	defer s.rollupClient.Close()
+	s.initGameCallerCreator()
+	s.initExtractor(cfg)
+
+	fetchBlockNumber := s.l1Client.BlockNumber
+	if cfg.PinnedL1Block != nil {
+		fetchBlockNumber = pinnedBlockNumber(*cfg.PinnedL1Block, s.fetchFinalizedBlockNumber)
+	}
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		header, err := s.l1Client.HeaderByNumber(ctx, number)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return header.Hash(), nil
+	}
+	return exportGames(ctx, logger, fetchBlockNumber, fetchBlockHash, s.extractor.ExtractEach, clock.MinCheckedTimestamp(s.cl, cfg.GameWindow), w)
+}
+
+// ExtractEach loads the games created at or after minTimestamp, calling handle with each game once it is loaded.
+type ExtractEach func(ctx context.Context, blockHash common.Hash, minTimestamp uint64, handle func(game *types.EnrichedGameData)) (int, int, error)
+
+// exportGames loads the games at the current L1 block and writes each one to w as soon as it is loaded
+// so that exporting a large game window doesn't require holding every game in memory.
+func exportGames(ctx context.Context, logger log.Logger, fetchBlockNumber BlockNumberFetcher, fetchBlockHash BlockHashFetcher, extract ExtractEach, minTimestamp uint64, w io.Writer) error {
+	blockNumber, err := fetchBlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	blockHash, err := fetchBlockHash(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return fmt.Errorf("failed to fetch block header: %w", err)
+	}
+	out, err := newGameCSV(w)
+	if err != nil {
+		return err
+	}
+	logger.Info("Exporting games", "blockNumber", blockNumber)
+	var exported int
+	var writeErr error
+	ignored, failed, err := extract(ctx, blockHash, minTimestamp, func(game *types.EnrichedGameData) {
+		// Once a write fails the export is abandoned but the remaining games still need to be drained
+		if writeErr != nil {
+			return
+		}
+		writeErr = out.Write(game)
+		exported++
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load games: %w", err)
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	if failed > 0 {
+		logger.Warn("Some games failed to load and are not exported", "failed", failed)
+	}
+	logger.Info("Exported games", "blockNumber", blockNumber, "games", exported, "ignored", ignored)
+	return out.Flush()
+}
+
+// gameCSV writes games and their classification to an underlying writer as CSV.
+// Rows are flushed to the writer as the csv writer's buffer fills rather than being held until all games are written.
+type gameCSV struct {
+	out *csv.Writer
+}
+
+// newGameCSV creates a gameCSV writing to w and writes the header row.
+func newGameCSV(w io.Writer) (*gameCSV, error) {
+	out := csv.NewWriter(w)
+	if err := out.Write(exportHeader); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	return &gameCSV{out: out}, nil
+}
+
+// Write writes a single row for game.
+func (c *gameCSV) Write(game *types.EnrichedGameData) error {
+	row := []string{
+		game.Proxy.Hex(),
+		strconv.FormatUint(game.L2BlockNumber, 10),
+		game.RootClaim.Hex(),
+		game.Status.String(),
+		classify(game),
+	}
+	if err := c.out.Write(row); err != nil {
+		return fmt.Errorf("failed to write game %v: %w", game.Proxy, err)
+	}
+	return nil
+}
+
+// Flush writes any buffered rows to the underlying writer.
+func (c *gameCSV) Flush() error {
+	c.out.Flush()
+	return c.out.Error()
+}
@@ -0,0 +1,197 @@
+package mon
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameCSV(t *testing.T) {
+	t.Run("Rows", func(t *testing.T) {
+		games := []*types.EnrichedGameData{
+			{
+				GameMetadata:   gameTypes.GameMetadata{Proxy: common.Address{0xaa}},
+				L2BlockNumber:  100,
+				RootClaim:      common.Hash{0x01},
+				Status:         gameTypes.GameStatusInProgress,
+				AgreeWithClaim: true,
+			},
+			{
+				GameMetadata:  gameTypes.GameMetadata{Proxy: common.Address{0xbb}},
+				L2BlockNumber: 200,
+				RootClaim:     common.Hash{0x02},
+				Status:        gameTypes.GameStatusChallengerWon,
+			},
+			{
+				GameMetadata:  gameTypes.GameMetadata{Proxy: common.Address{0xcc}},
+				L2BlockNumber: 300,
+				RootClaim:     common.Hash{0x03},
+				Status:        gameTypes.GameStatusInProgress,
+				Pending:       true,
+			},
+		}
+		var buf bytes.Buffer
+		out, err := newGameCSV(&buf)
+		require.NoError(t, err)
+		for _, game := range games {
+			require.NoError(t, out.Write(game))
+		}
+		require.NoError(t, out.Flush())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"address", "l2Block", "rootClaim", "status", "agreement"},
+			{common.Address{0xaa}.Hex(), "100", common.Hash{0x01}.Hex(), "In Progress", "agree"},
+			{common.Address{0xbb}.Hex(), "200", common.Hash{0x02}.Hex(), "Challenger Won", "disagree"},
+			{common.Address{0xcc}.Hex(), "300", common.Hash{0x03}.Hex(), "In Progress", "pending"},
+		}, records)
+	})
+
+	t.Run("NoGames", func(t *testing.T) {
+		var buf bytes.Buffer
+		out, err := newGameCSV(&buf)
+		require.NoError(t, err)
+		require.NoError(t, out.Flush())
+		require.Equal(t, "address,l2Block,rootClaim,status,agreement\n", buf.String())
+	})
+
+	t.Run("WriteError", func(t *testing.T) {
+		out, err := newGameCSV(&failingWriter{})
+		require.NoError(t, err)
+		require.ErrorIs(t, out.Flush(), errWriteFailed)
+	})
+}
+
+func TestExportGames(t *testing.T) {
+	blockHash := common.Hash{0xab}
+	fetchBlockNumber := func(_ context.Context) (uint64, error) {
+		return 42, nil
+	}
+	fetchBlockHash := func(_ context.Context, number *big.Int) (common.Hash, error) {
+		require.Equal(t, uint64(42), number.Uint64())
+		return blockHash, nil
+	}
+
+	t.Run("WritesEachLoadedGame", func(t *testing.T) {
+		fixture := loadReplayFixture(t)
+		extractor := newExportTestExtractor(t, fixture, blockHash)
+		var buf bytes.Buffer
+		err := exportGames(context.Background(), testlog.Logger(t, log.LvlInfo), fetchBlockNumber, fetchBlockHash, extractor.ExtractEach, 0, &buf)
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, exportHeader, records[0])
+		require.Len(t, records, len(fixture.Games)+1)
+		for i, game := range fixture.Games {
+			require.Equal(t, game.Proxy.Hex(), records[i+1][0])
+			require.Equal(t, strconv.FormatUint(game.L2BlockNumber, 10), records[i+1][1])
+			require.Equal(t, game.RootClaim.Hex(), records[i+1][2])
+		}
+	})
+
+	t.Run("StreamsRowsBeforeAllGamesLoaded", func(t *testing.T) {
+		var buf bytes.Buffer
+		extract := func(_ context.Context, _ common.Hash, _ uint64, handle func(game *types.EnrichedGameData)) (int, int, error) {
+			for i := 0; i < 100; i++ {
+				handle(&types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{byte(i)}}})
+			}
+			// Rows are written as the csv buffer fills rather than once every game is loaded
+			require.NotZero(t, buf.Len())
+			return 0, 0, nil
+		}
+		err := exportGames(context.Background(), testlog.Logger(t, log.LvlInfo), fetchBlockNumber, fetchBlockHash, extract, 0, &buf)
+		require.NoError(t, err)
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 101)
+	})
+
+	t.Run("LogsFailedGames", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		extract := func(_ context.Context, _ common.Hash, _ uint64, _ func(game *types.EnrichedGameData)) (int, int, error) {
+			return 1, 2, nil
+		}
+		require.NoError(t, exportGames(context.Background(), logger, fetchBlockNumber, fetchBlockHash, extract, 0, &bytes.Buffer{}))
+		require.NotNil(t, logs.FindLog(testlog.NewMessageFilter("Some games failed to load and are not exported")))
+		exported := logs.FindLog(testlog.NewMessageFilter("Exported games"))
+		require.NotNil(t, exported)
+		require.Equal(t, int64(1), exported.AttrValue("ignored"))
+	})
+
+	t.Run("BlockNumberError", func(t *testing.T) {
+		fetchErr := errors.New("boom")
+		fetch := func(_ context.Context) (uint64, error) {
+			return 0, fetchErr
+		}
+		err := exportGames(context.Background(), testlog.Logger(t, log.LvlInfo), fetch, fetchBlockHash, nil, 0, &bytes.Buffer{})
+		require.ErrorIs(t, err, fetchErr)
+	})
+
+	t.Run("ExtractError", func(t *testing.T) {
+		extractErr := errors.New("boom")
+		extract := func(_ context.Context, _ common.Hash, _ uint64, _ func(game *types.EnrichedGameData)) (int, int, error) {
+			return 0, 0, extractErr
+		}
+		err := exportGames(context.Background(), testlog.Logger(t, log.LvlInfo), fetchBlockNumber, fetchBlockHash, extract, 0, &bytes.Buffer{})
+		require.ErrorIs(t, err, extractErr)
+	})
+
+	t.Run("WriteError", func(t *testing.T) {
+		fixture := loadReplayFixture(t)
+		extractor := newExportTestExtractor(t, fixture, blockHash)
+		err := exportGames(context.Background(), testlog.Logger(t, log.LvlInfo), fetchBlockNumber, fetchBlockHash, extractor.ExtractEach, 0, &failingWriter{})
+		require.ErrorIs(t, err, errWriteFailed)
+	})
+}
+
+// newExportTestExtractor creates an extractor serving the replay fixture's games and outputs,
+// requiring that games are loaded at blockHash.
+func newExportTestExtractor(t *testing.T, fixture *replayFixtureData, blockHash common.Hash) *extract.Extractor {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cl := clock.NewDeterministicClock(time.Unix(0, 0))
+	return extract.NewExtractor(
+		logger,
+		metrics.NoopMetrics,
+		cl,
+		func(_ context.Context, game gameTypes.GameMetadata) (extract.GameCaller, error) {
+			return fixture.caller(game.Proxy)
+		},
+		[]extract.GameSource{{
+			FetchGames: func(_ context.Context, hash common.Hash, _ uint64) ([]gameTypes.GameMetadata, error) {
+				require.Equal(t, blockHash, hash)
+				return fixture.gameMetadata(), nil
+			},
+		}},
+		nil,
+		extract.GameFilter{},
+		1,
+		0,
+		0,
+		extract.NewAgreementEnricher(logger, metrics.NoopMetrics, cl, &replayRollupClient{fixture: fixture}, 0, types.AgreementHeadSafe, nil, nil, nil),
+	)
+}
+
+var errWriteFailed = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (f *failingWriter) Write(_ []byte) (int, error) {
+	return 0, errWriteFailed
+}
@@ -0,0 +1,46 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ Enricher = (*ActivityEnricher)(nil)
+
+type MaxGameDepthCaller interface {
+	GetMaxGameDepth(ctx context.Context) (faultTypes.Depth, error)
+}
+
+// ActivityEnricher counts the moves and steps made in an in-progress game.
+// Every claim other than the root claim is a move. A step counters a claim at the maximum game depth
+// without adding a claim so is counted from the countered claims at that depth.
+type ActivityEnricher struct{}
+
+func NewActivityEnricher() *ActivityEnricher {
+	return &ActivityEnricher{}
+}
+
+func (e *ActivityEnricher) Enrich(ctx context.Context, _ rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	game.Moves = 0
+	game.Steps = 0
+	if game.Status != gameTypes.GameStatusInProgress || len(game.Claims) == 0 {
+		return nil
+	}
+	maxDepth, err := caller.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load max game depth: %w", err)
+	}
+	game.Moves = len(game.Claims) - 1
+	for _, claim := range game.Claims {
+		if claim.Depth() == maxDepth && claim.CounteredBy != (common.Address{}) {
+			game.Steps++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityEnricher(t *testing.T) {
+	claim := func(depth faultTypes.Depth, counteredBy common.Address) monTypes.EnrichedClaim {
+		return monTypes.EnrichedClaim{Claim: faultTypes.Claim{
+			ClaimData:   faultTypes.ClaimData{Position: faultTypes.NewPosition(depth, big.NewInt(0))},
+			CounteredBy: counteredBy,
+		}}
+	}
+
+	t.Run("CountsMovesAndSteps", func(t *testing.T) {
+		enricher := NewActivityEnricher()
+		caller := &mockGameCaller{maxGameDepth: 2}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{
+				claim(0, common.Address{}),
+				claim(1, common.Address{0xaa}), // Countered by a move, not a step
+				claim(2, common.Address{0xbb}), // Stepped on
+				claim(2, common.Address{}),     // Not yet stepped on
+			},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Equal(t, 3, game.Moves)
+		require.Equal(t, 1, game.Steps)
+	})
+
+	t.Run("SkipsResolvedGames", func(t *testing.T) {
+		enricher := NewActivityEnricher()
+		caller := &mockGameCaller{maxGameDepthErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusChallengerWon,
+			Claims: []monTypes.EnrichedClaim{claim(0, common.Address{}), claim(1, common.Address{})},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.Moves)
+		require.Zero(t, game.Steps)
+	})
+
+	t.Run("MaxGameDepthError", func(t *testing.T) {
+		enricher := NewActivityEnricher()
+		caller := &mockGameCaller{maxGameDepthErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0, common.Address{})},
+		}
+		require.ErrorIs(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game), caller.maxGameDepthErr)
+	})
+}
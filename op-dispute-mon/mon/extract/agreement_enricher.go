@@ -3,12 +3,17 @@ package extract
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -17,55 +22,308 @@ import (
 type OutputRollupClient interface {
 	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
 	SafeHeadAtL1Block(ctx context.Context, blockNum uint64) (*eth.SafeHeadResponse, error)
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
 }
 
 type OutputMetrics interface {
 	RecordOutputFetchTime(float64)
+	RecordSingleflightCoalesced()
+	RecordRootMismatchDetails()
+	RecordRollupInternalInconsistency()
+}
+
+// prunedStateErrors are substrings of errors returned by the rollup node when the state for a block has been pruned.
+var prunedStateErrors = []string{"missing trie node", "historical state"}
+
+// OutputComparator returns the output root a game's root claim is expected to match for the specified output.
+type OutputComparator func(output *eth.OutputResponse) common.Hash
+
+// ComparatorSelector returns the OutputComparator to use for games disputing the specified L2 block.
+type ComparatorSelector func(blockNum uint64) OutputComparator
+
+// ReportedOutputRoot expects the root claim to match the output root reported by the rollup node.
+func ReportedOutputRoot(output *eth.OutputResponse) common.Hash {
+	return common.Hash(output.OutputRoot)
+}
+
+// ComputedOutputRootV0 expects the root claim to match the V0 output root computed from the output's components,
+// regardless of the format of the output root reported by the rollup node.
+func ComputedOutputRootV0(output *eth.OutputResponse) common.Hash {
+	return common.Hash(eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(output.StateRoot),
+		MessagePasserStorageRoot: eth.Bytes32(output.WithdrawalStorageRoot),
+		BlockHash:                output.BlockRef.Hash,
+	}))
+}
+
+// RootHasher derives a root from its components. Allows the hash function used to derive the root claims games are
+// expected to commit to be replaced if the hashing scheme changes.
+type RootHasher func(data ...[]byte) common.Hash
+
+// Keccak256RootHasher derives roots using keccak256.
+var Keccak256RootHasher RootHasher = crypto.Keccak256Hash
+
+// ForkComparatorSelector selects preFork for blocks before forkBlock and postFork for forkBlock onwards.
+// Used during an upgrade that changes how output roots are computed.
+func ForkComparatorSelector(forkBlock uint64, preFork, postFork OutputComparator) ComparatorSelector {
+	return func(blockNum uint64) OutputComparator {
+		if blockNum < forkBlock {
+			return preFork
+		}
+		return postFork
+	}
+}
+
+// DomainComparatorSelector expects root claims to commit to the chain-specific domain, hashing the domain with the
+// output root the selector would otherwise compare against. A nil selector uses the reported output root and a nil
+// hasher uses keccak256.
+// Used for superchain setups where games of multiple chains otherwise share the same output root format.
+func DomainComparatorSelector(domain common.Hash, selector ComparatorSelector, hasher RootHasher) ComparatorSelector {
+	if hasher == nil {
+		hasher = Keccak256RootHasher
+	}
+	return func(blockNum uint64) OutputComparator {
+		comparator := OutputComparator(ReportedOutputRoot)
+		if selector != nil {
+			comparator = selector(blockNum)
+		}
+		return func(output *eth.OutputResponse) common.Hash {
+			return hasher(domain[:], comparator(output).Bytes())
+		}
+	}
+}
+
+// FinalityPredicate returns true if the specified L2 block is final, so games disputing it are classified
+// against the rollup node rather than reported as pending.
+type FinalityPredicate func(ctx context.Context, blockNum uint64) (bool, error)
+
+type RClock interface {
+	Now() time.Time
 }
 
 type AgreementEnricher struct {
 	log     log.Logger
 	metrics OutputMetrics
+	clock   RClock
 	client  OutputRollupClient
+	timeout time.Duration
+	head    monTypes.AgreementHead
+
+	// isFinalized, if not nil, determines which games are pending in place of the agreement head.
+	isFinalized FinalityPredicate
+
+	comparatorFor ComparatorSelector
+
+	// archive, if not nil, is used to fetch outputs for blocks the primary rollup node has pruned.
+	archive OutputRollupClient
+
+	// outputs deduplicates concurrent requests for the output at the same block.
+	outputs singleflight.Group
 }
 
-func NewAgreementEnricher(logger log.Logger, metrics OutputMetrics, client OutputRollupClient) *AgreementEnricher {
+// NewAgreementEnricher creates a new AgreementEnricher.
+// If comparatorFor is nil, root claims are compared against the output root reported by the rollup node.
+// If archive is not nil, outputs for blocks pruned by client are fetched from archive instead.
+// If isFinalized is not nil, it decides whether a disputed block is final in place of the agreement head,
+// for chains that define finality differently.
+func NewAgreementEnricher(logger log.Logger, metrics OutputMetrics, clock RClock, client OutputRollupClient, timeout time.Duration, head monTypes.AgreementHead, isFinalized FinalityPredicate, comparatorFor ComparatorSelector, archive OutputRollupClient) *AgreementEnricher {
+	if comparatorFor == nil {
+		comparatorFor = func(uint64) OutputComparator {
+			return ReportedOutputRoot
+		}
+	}
 	return &AgreementEnricher{
-		log:     logger,
-		metrics: metrics,
-		client:  client,
+		log:           logger,
+		metrics:       metrics,
+		clock:         clock,
+		client:        client,
+		timeout:       timeout,
+		head:          head,
+		isFinalized:   isFinalized,
+		comparatorFor: comparatorFor,
+		archive:       archive,
 	}
 }
 
 // Enrich validates the specified root claim against the output at the given block number.
+// The comparison timeout, if configured, applies to all rollup node requests made for the game.
 func (o *AgreementEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
-	output, err := o.client.OutputAtBlock(ctx, game.L2BlockNumber)
+	if game.ImplausibleBlock {
+		// The disputed block can't be trusted so there is no output to meaningfully compare against
+		game.AgreeWithClaim = false
+		game.Indeterminate = true
+		return nil
+	}
+	ctx, cancel := withTimeout(ctx, o.timeout)
+	defer cancel()
+	output, fromArchive, err := o.outputAtBlock(ctx, game.L2BlockNumber)
+	game.ArchiveFallback = fromArchive
 	if err != nil {
 		// string match as the error comes from the remote server so we can't use Errors.Is sadly.
 		if strings.Contains(err.Error(), "not found") {
 			// Output root doesn't exist, so we must disagree with it.
 			game.AgreeWithClaim = false
+			o.checkOutputRange(ctx, game)
 			return nil
 		}
 		return fmt.Errorf("failed to get output at block: %w", err)
 	}
-	o.metrics.RecordOutputFetchTime(float64(time.Now().Unix()))
-	game.ExpectedRootClaim = common.Hash(output.OutputRoot)
-	rootMatches := game.RootClaim == game.ExpectedRootClaim
-	if !rootMatches {
+	o.metrics.RecordOutputFetchTime(float64(o.clock.Now().Unix()))
+	if output.OutputRoot == (eth.Bytes32{}) {
+		// An empty output usually means the rollup node hasn't synced the block rather than a genuine disagreement.
+		o.log.Warn("Rollup node returned an empty output", "game", game.Proxy, "l2BlockNum", game.L2BlockNumber)
 		game.AgreeWithClaim = false
+		game.Indeterminate = true
 		return nil
 	}
+	game.RollupInternalInconsistency = !o.isConsistent(game, output)
+	game.ExpectedRootClaim = o.comparatorFor(game.L2BlockNumber)(output)
+	game.L2BlockTimestamp = output.BlockRef.Time
+	if output.Status != nil {
+		game.RollupSafeHead = output.Status.SafeL2
+	}
+	rootMatches := game.RootClaim == game.ExpectedRootClaim
+	if rootMatches {
+		// If the root matches, also check that l2 block is safe at the L1 head
+		game.AgreeWithClaim = o.isSafeAtL1Head(ctx, game)
+	} else {
+		game.AgreeWithClaim = false
+		o.logMismatch(game, output)
+	}
+	if !game.AgreeWithClaim {
+		o.checkPending(ctx, output.Status, game)
+	}
+	return nil
+}
+
+// isConsistent returns false if the output root reported by the rollup node doesn't match the root computed from the
+// block hash, state root and withdrawal storage root it reported alongside it. A mismatch indicates a rollup node bug.
+// Only V0 outputs can be verified so other versions are assumed to be consistent.
+func (o *AgreementEnricher) isConsistent(game *monTypes.EnrichedGameData, output *eth.OutputResponse) bool {
+	if output.Version != eth.OutputVersionV0 {
+		return true
+	}
+	computed := ComputedOutputRootV0(output)
+	if computed == common.Hash(output.OutputRoot) {
+		return true
+	}
+	o.log.Error("Rollup node returned an output root inconsistent with its block",
+		"game", game.Proxy, "l2BlockNum", game.L2BlockNumber,
+		"outputRoot", common.Hash(output.OutputRoot), "computed", computed,
+		"blockHash", output.BlockRef.Hash, "stateRoot", output.StateRoot,
+		"withdrawalStorageRoot", output.WithdrawalStorageRoot)
+	o.metrics.RecordRollupInternalInconsistency()
+	return false
+}
+
+// logMismatch logs the details of the output that conflicts with the game's root claim to aid investigation.
+// The details are taken from the output already fetched so no additional requests are made.
+func (o *AgreementEnricher) logMismatch(game *monTypes.EnrichedGameData, output *eth.OutputResponse) {
+	o.log.Warn("Root claim does not match output",
+		"game", game.Proxy, "l2BlockNum", game.L2BlockNumber,
+		"rootClaim", game.RootClaim, "expected", game.ExpectedRootClaim, "reported", common.Hash(output.OutputRoot),
+		"blockHash", output.BlockRef.Hash, "stateRoot", output.StateRoot,
+		"withdrawalStorageRoot", output.WithdrawalStorageRoot)
+	o.metrics.RecordRootMismatchDetails()
+}
+
+// fetchedOutput is an output along with whether it was fetched from the archive rollup node.
+type fetchedOutput struct {
+	output      *eth.OutputResponse
+	fromArchive bool
+}
+
+// outputAtBlock fetches the output at the specified block, sharing the result with any concurrent requests for the same block.
+// The shared request is detached from the context of the caller that started it so cancelling one caller doesn't fail
+// the others. It is limited by the comparison timeout instead, while each caller stops waiting once its own ctx is done.
+// Returns true if the output was fetched from the archive rollup node.
+func (o *AgreementEnricher) outputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, bool, error) {
+	var executed atomic.Bool
+	resultCh := o.outputs.DoChan(strconv.FormatUint(blockNum, 10), func() (any, error) {
+		executed.Store(true)
+		ctx, cancel := withTimeout(context.WithoutCancel(ctx), o.timeout)
+		defer cancel()
+		output, err := o.client.OutputAtBlock(ctx, blockNum)
+		if err == nil || o.archive == nil || !isPrunedState(err) {
+			return fetchedOutput{output: output}, err
+		}
+		o.log.Debug("Output pruned by rollup node, using archive node", "l2BlockNum", blockNum, "err", err)
+		output, err = o.archive.OutputAtBlock(ctx, blockNum)
+		return fetchedOutput{output: output, fromArchive: true}, err
+	})
+	var result singleflight.Result
+	select {
+	case result = <-resultCh:
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+	if !executed.Load() {
+		o.metrics.RecordSingleflightCoalesced()
+	}
+	fetched, _ := result.Val.(fetchedOutput)
+	if result.Err != nil {
+		return nil, fetched.fromArchive, result.Err
+	}
+	return fetched.output, fetched.fromArchive, nil
+}
+
+func isPrunedState(err error) bool {
+	for _, msg := range prunedStateErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
 
-	// If the root matches, also check that l2 block is safe at the L1 head
+func (o *AgreementEnricher) isSafeAtL1Head(ctx context.Context, game *monTypes.EnrichedGameData) bool {
 	safeHead, err := o.client.SafeHeadAtL1Block(ctx, game.L1HeadNum)
 	if err != nil {
 		o.log.Warn("Unable to verify proposed block was safe", "l1HeadNum", game.L1HeadNum, "l2BlockNum", game.L2BlockNumber, "err", err)
 		// If safe head data isn't available, assume the output root was safe
 		// Avoids making the dispute mon dependent on safe head db being available
-		game.AgreeWithClaim = true
-		return nil
+		return true
+	}
+	return safeHead.SafeHead.Number >= game.L2BlockNumber
+}
+
+// checkPending flags games that disagree with the output for a block newer than the agreement head,
+// or that isn't final according to the finality predicate if one is configured.
+// The sync status returned with the output is used if available so the head is consistent with the output.
+func (o *AgreementEnricher) checkPending(ctx context.Context, status *eth.SyncStatus, game *monTypes.EnrichedGameData) {
+	if o.isFinalized != nil {
+		finalized, err := o.isFinalized(ctx, game.L2BlockNumber)
+		if err != nil {
+			o.log.Warn("Unable to determine if block is final", "game", game.Proxy, "l2BlockNum", game.L2BlockNumber, "err", err)
+			return
+		}
+		game.Pending = !finalized
+		return
+	}
+	if status == nil {
+		var err error
+		status, err = o.client.SyncStatus(ctx)
+		if err != nil {
+			o.log.Warn("Unable to determine agreement head", "game", game.Proxy, "l2BlockNum", game.L2BlockNumber, "err", err)
+			return
+		}
+	}
+	head := status.SafeL2
+	if o.head == monTypes.AgreementHeadFinalized {
+		head = status.FinalizedL2
+	}
+	game.Pending = game.L2BlockNumber > head.Number
+}
+
+// checkOutputRange flags games that dispute a block beyond the latest block the rollup node has produced an output for.
+// Such games are invalid by construction as no output root can exist for the disputed block yet.
+func (o *AgreementEnricher) checkOutputRange(ctx context.Context, game *monTypes.EnrichedGameData) {
+	status, err := o.client.SyncStatus(ctx)
+	if err != nil {
+		o.log.Warn("Unable to determine latest output block", "game", game.Proxy, "l2BlockNum", game.L2BlockNumber, "err", err)
+		return
+	}
+	if game.L2BlockNumber > status.UnsafeL2.Number {
+		game.BeyondOutputRange = true
 	}
-	game.AgreeWithClaim = safeHead.SafeHead.Number >= game.L2BlockNumber
-	return nil
 }
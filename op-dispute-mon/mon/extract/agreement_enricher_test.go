@@ -3,9 +3,14 @@ package extract
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
@@ -119,6 +124,21 @@ func TestDetector_CheckRootAgreement(t *testing.T) {
 		require.NotZero(t, metrics.fetchTime)
 	})
 
+	t.Run("OutputMatches_RecordsSafeHead", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		client.blockTime = 5000
+		client.status = &eth.SyncStatus{SafeL2: eth.L2BlockRef{Number: 300, Time: 9000}}
+		game := &types.EnrichedGameData{
+			L1HeadNum:     200,
+			L2BlockNumber: 100,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5000), game.L2BlockTimestamp)
+		require.Equal(t, client.status.SafeL2, game.RollupSafeHead)
+	})
+
 	t.Run("OutputNotFound", func(t *testing.T) {
 		validator, rollup, metrics := setupOutputValidatorTest(t)
 		// This crazy error is what we actually get back from the API
@@ -132,36 +152,644 @@ func TestDetector_CheckRootAgreement(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, common.Hash{}, game.ExpectedRootClaim)
 		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.BeyondOutputRange)
+		require.False(t, game.Indeterminate)
 		require.Zero(t, metrics.fetchTime)
 	})
+
+	t.Run("OutputNotFound_BeyondOutputRange", func(t *testing.T) {
+		validator, rollup, metrics := setupOutputValidatorTest(t)
+		rollup.outputErr = errors.New("not found")
+		rollup.unsafeHeadNum = 1000
+		game := &types.EnrichedGameData{
+			L1HeadNum:     100,
+			L2BlockNumber: 1_000_000_000,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.NoError(t, err)
+		require.False(t, game.AgreeWithClaim)
+		require.True(t, game.BeyondOutputRange)
+		require.False(t, game.Indeterminate)
+		require.Zero(t, metrics.fetchTime)
+	})
+
+	t.Run("OutputNotFound_SyncStatusError", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		rollup.outputErr = errors.New("not found")
+		rollup.syncStatusErr = errors.New("boom")
+		game := &types.EnrichedGameData{
+			L1HeadNum:     100,
+			L2BlockNumber: 1_000_000_000,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.NoError(t, err)
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.BeyondOutputRange)
+	})
+}
+
+func TestDetector_CheckRootAgreementIndeterminate(t *testing.T) {
+	t.Run("ZeroOutputRoot", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		validator.client = &emptyOutputRollupClient{rollup}
+		game := &types.EnrichedGameData{L1HeadNum: 100, L2BlockNumber: 50, RootClaim: mockRootClaim}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.True(t, game.Indeterminate)
+	})
+
+	t.Run("DifferentRoot", func(t *testing.T) {
+		validator, _, _ := setupOutputValidatorTest(t)
+		game := &types.EnrichedGameData{L1HeadNum: 100, L2BlockNumber: 50, RootClaim: common.Hash{0xbb}}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.Indeterminate)
+	})
+
+	t.Run("MatchingRoot", func(t *testing.T) {
+		validator, _, _ := setupOutputValidatorTest(t)
+		game := &types.EnrichedGameData{L1HeadNum: 100, L2BlockNumber: 50, RootClaim: mockRootClaim}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.AgreeWithClaim)
+		require.False(t, game.Indeterminate)
+	})
+}
+
+// emptyOutputRollupClient returns outputs with an empty output root.
+type emptyOutputRollupClient struct {
+	*stubRollupClient
+}
+
+func (c *emptyOutputRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	output, err := c.stubRollupClient.OutputAtBlock(ctx, blockNum)
+	if output != nil {
+		output.OutputRoot = eth.Bytes32{}
+	}
+	return output, err
+}
+
+func TestDetector_CheckRootAgreementMismatchDetails(t *testing.T) {
+	setup := func(t *testing.T) (*AgreementEnricher, *stubRollupClient, *stubOutputMetrics, *testlog.CapturingHandler) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		client := &stubRollupClient{
+			safeHeadNum:    99999999999,
+			unsafeHeadNum:  99999999999,
+			safeL2Num:      99999999999,
+			finalizedL2Num: 99999999999,
+			blockHash:      common.Hash{0x01},
+			stateRoot:      common.Hash{0x02},
+			withdrawalRoot: common.Hash{0x03},
+		}
+		metrics := &stubOutputMetrics{}
+		return NewAgreementEnricher(logger, metrics, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, types.AgreementHeadSafe, nil, nil, nil), client, metrics, logs
+	}
+
+	t.Run("Mismatch", func(t *testing.T) {
+		validator, rollup, metrics, logs := setup(t)
+		game := &types.EnrichedGameData{
+			GameMetadata:  gameTypes.GameMetadata{Proxy: common.Address{0xaa}},
+			L1HeadNum:     200,
+			L2BlockNumber: 50,
+			RootClaim:     common.Hash{0xbb},
+		}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.Equal(t, 1, rollup.outputCalls)
+		require.Equal(t, 1, metrics.mismatchDetails)
+		l := logs.FindLog(
+			testlog.NewLevelFilter(log.LevelWarn),
+			testlog.NewMessageFilter("Root claim does not match output"))
+		require.NotNil(t, l)
+		require.Equal(t, common.Address{0xaa}, l.AttrValue("game"))
+		require.EqualValues(t, 50, l.AttrValue("l2BlockNum"))
+		require.Equal(t, common.Hash{0xbb}, l.AttrValue("rootClaim"))
+		require.Equal(t, mockRootClaim, l.AttrValue("expected"))
+		require.Equal(t, common.Hash{0x01}, l.AttrValue("blockHash"))
+		require.Equal(t, common.Hash{0x02}, l.AttrValue("stateRoot"))
+		require.Equal(t, common.Hash{0x03}, l.AttrValue("withdrawalStorageRoot"))
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		validator, rollup, metrics, logs := setup(t)
+		game := &types.EnrichedGameData{
+			L1HeadNum:     200,
+			L2BlockNumber: 50,
+			RootClaim:     mockRootClaim,
+		}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.AgreeWithClaim)
+		require.Equal(t, 1, rollup.outputCalls)
+		require.Zero(t, metrics.mismatchDetails)
+		require.Nil(t, logs.FindLog(testlog.NewMessageFilter("Root claim does not match output")))
+	})
+}
+
+func TestDetector_CheckRootAgreementOutputFork(t *testing.T) {
+	const forkBlock = 100
+	client := &stubRollupClient{
+		safeHeadNum:    99999999999,
+		unsafeHeadNum:  99999999999,
+		safeL2Num:      99999999999,
+		finalizedL2Num: 99999999999,
+		blockHash:      common.Hash{0x01},
+		stateRoot:      common.Hash{0x02},
+		withdrawalRoot: common.Hash{0x03},
+	}
+	legacyRoot := common.Hash(eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32{0x02},
+		MessagePasserStorageRoot: eth.Bytes32{0x03},
+		BlockHash:                common.Hash{0x01},
+	}))
+	require.NotEqual(t, mockRootClaim, legacyRoot)
+	validator := NewAgreementEnricher(testlog.Logger(t, log.LvlInfo), &stubOutputMetrics{}, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, types.AgreementHeadSafe, nil,
+		ForkComparatorSelector(forkBlock, ComputedOutputRootV0, ReportedOutputRoot), nil)
+
+	tests := []struct {
+		name     string
+		blockNum uint64
+		root     common.Hash
+		expected common.Hash
+		agree    bool
+	}{
+		{name: "PreForkLegacyRoot", blockNum: forkBlock - 1, root: legacyRoot, expected: legacyRoot, agree: true},
+		{name: "PreForkReportedRoot", blockNum: forkBlock - 1, root: mockRootClaim, expected: legacyRoot, agree: false},
+		{name: "ForkBlockReportedRoot", blockNum: forkBlock, root: mockRootClaim, expected: mockRootClaim, agree: true},
+		{name: "PostForkReportedRoot", blockNum: forkBlock + 1, root: mockRootClaim, expected: mockRootClaim, agree: true},
+		{name: "PostForkLegacyRoot", blockNum: forkBlock + 1, root: legacyRoot, expected: mockRootClaim, agree: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			game := &types.EnrichedGameData{
+				L1HeadNum:     200,
+				L2BlockNumber: test.blockNum,
+				RootClaim:     test.root,
+			}
+			require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+			require.Equal(t, test.expected, game.ExpectedRootClaim)
+			require.Equal(t, test.agree, game.AgreeWithClaim)
+		})
+	}
+}
+
+func TestDetector_CheckRootAgreementInternalConsistency(t *testing.T) {
+	setup := func(t *testing.T) (*AgreementEnricher, *stubRollupClient, *stubOutputMetrics, *testlog.CapturingHandler) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		client := &stubRollupClient{
+			safeHeadNum:    99999999999,
+			unsafeHeadNum:  99999999999,
+			safeL2Num:      99999999999,
+			finalizedL2Num: 99999999999,
+			blockHash:      common.Hash{0x01},
+			stateRoot:      common.Hash{0x02},
+			withdrawalRoot: common.Hash{0x03},
+		}
+		metrics := &stubOutputMetrics{}
+		return NewAgreementEnricher(logger, metrics, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, types.AgreementHeadSafe, nil, nil, nil), client, metrics, logs
+	}
+
+	t.Run("Inconsistent", func(t *testing.T) {
+		validator, _, metrics, logs := setup(t)
+		game := &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 50, RootClaim: mockRootClaim}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.Equal(t, 1, metrics.inconsistencies)
+		require.True(t, game.RollupInternalInconsistency)
+		l := logs.FindLog(
+			testlog.NewLevelFilter(log.LevelError),
+			testlog.NewMessageFilter("Rollup node returned an output root inconsistent with its block"))
+		require.NotNil(t, l)
+		require.Equal(t, mockRootClaim, l.AttrValue("outputRoot"))
+		require.Equal(t, common.Hash{0x01}, l.AttrValue("blockHash"))
+	})
+
+	t.Run("Consistent", func(t *testing.T) {
+		validator, rollup, metrics, logs := setup(t)
+		rollup.consistentRoot = true
+		game := &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 50, RootClaim: mockRootClaim}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.Zero(t, metrics.inconsistencies)
+		require.False(t, game.RollupInternalInconsistency)
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelError)))
+	})
 }
 
 func setupOutputValidatorTest(t *testing.T) (*AgreementEnricher, *stubRollupClient, *stubOutputMetrics) {
 	logger := testlog.Logger(t, log.LvlInfo)
-	client := &stubRollupClient{safeHeadNum: 99999999999}
+	client := &stubRollupClient{
+		safeHeadNum:    99999999999,
+		unsafeHeadNum:  99999999999,
+		safeL2Num:      99999999999,
+		finalizedL2Num: 99999999999,
+	}
 	metrics := &stubOutputMetrics{}
-	validator := NewAgreementEnricher(logger, metrics, client)
+	validator := NewAgreementEnricher(logger, metrics, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, types.AgreementHeadSafe, nil, nil, nil)
 	return validator, client, metrics
 }
 
+func TestDetector_CheckRootAgreementOutputFetchTime(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	client := &stubRollupClient{safeHeadNum: 99999999999, safeL2Num: 99999999999}
+	metrics := &stubOutputMetrics{}
+	validator := NewAgreementEnricher(testlog.Logger(t, log.LvlInfo), metrics, cl, client, 0, types.AgreementHeadSafe, nil, nil, nil)
+	enrich := func() {
+		game := &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 0, RootClaim: mockRootClaim}
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+	}
+
+	enrich()
+	require.Equal(t, float64(1000), metrics.fetchTime)
+
+	cl.AdvanceTime(90 * time.Second)
+	enrich()
+	require.Equal(t, float64(1090), metrics.fetchTime)
+}
+
+func TestDetector_CheckRootAgreementArchiveFallback(t *testing.T) {
+	setup := func(t *testing.T) (*AgreementEnricher, *stubRollupClient, *stubRollupClient) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		client.prunedBelow = 100
+		archive := &stubRollupClient{safeHeadNum: 99999999999, safeL2Num: 99999999999}
+		validator.archive = archive
+		return validator, client, archive
+	}
+
+	t.Run("PrunedUsesArchive", func(t *testing.T) {
+		validator, client, archive := setup(t)
+		pruned := &types.EnrichedGameData{L2BlockNumber: 50, RootClaim: mockRootClaim}
+		recent := &types.EnrichedGameData{L2BlockNumber: 150, RootClaim: mockRootClaim}
+		for _, game := range []*types.EnrichedGameData{pruned, recent} {
+			require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+			require.True(t, game.AgreeWithClaim)
+		}
+		require.True(t, pruned.ArchiveFallback)
+		require.False(t, recent.ArchiveFallback)
+		require.Equal(t, 2, client.outputCalls)
+		require.Equal(t, 1, archive.outputCalls)
+		require.Equal(t, uint64(50), archive.blockNum)
+	})
+
+	t.Run("ArchiveFails", func(t *testing.T) {
+		validator, _, archive := setup(t)
+		archive.outputErr = errors.New("boom")
+		game := &types.EnrichedGameData{L2BlockNumber: 50, RootClaim: mockRootClaim}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.ErrorIs(t, err, archive.outputErr)
+		require.True(t, game.ArchiveFallback)
+	})
+
+	t.Run("NoArchive", func(t *testing.T) {
+		validator, _, _ := setup(t)
+		validator.archive = nil
+		game := &types.EnrichedGameData{L2BlockNumber: 50, RootClaim: mockRootClaim}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.ErrorContains(t, err, "missing trie node")
+		require.False(t, game.ArchiveFallback)
+	})
+
+	t.Run("OtherErrorsNotRetried", func(t *testing.T) {
+		validator, client, archive := setup(t)
+		client.outputErr = errors.New("boom")
+		game := &types.EnrichedGameData{L2BlockNumber: 150, RootClaim: mockRootClaim}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.ErrorIs(t, err, client.outputErr)
+		require.Zero(t, archive.outputCalls)
+		require.False(t, game.ArchiveFallback)
+	})
+}
+
+func TestDetector_CheckRootAgreementHead(t *testing.T) {
+	t.Parallel()
+
+	newGame := func(rootClaim common.Hash) *types.EnrichedGameData {
+		return &types.EnrichedGameData{
+			L1HeadNum:     200,
+			L2BlockNumber: 150,
+			RootClaim:     rootClaim,
+		}
+	}
+
+	for _, head := range types.AgreementHeads {
+		head := head
+		t.Run(head.String(), func(t *testing.T) {
+			t.Run("DisagreeBeforeHead", func(t *testing.T) {
+				validator, client, _ := setupOutputValidatorTest(t)
+				validator.head = head
+				client.safeL2Num = 150
+				client.finalizedL2Num = 150
+				game := newGame(common.Hash{0xbb})
+				require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+				require.False(t, game.AgreeWithClaim)
+				require.False(t, game.Pending)
+			})
+
+			t.Run("PendingAfterHead", func(t *testing.T) {
+				validator, client, _ := setupOutputValidatorTest(t)
+				validator.head = head
+				client.safeL2Num = 149
+				client.finalizedL2Num = 149
+				game := newGame(common.Hash{0xbb})
+				require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+				require.False(t, game.AgreeWithClaim)
+				require.True(t, game.Pending)
+			})
+
+			t.Run("AgreeAfterHead", func(t *testing.T) {
+				validator, client, _ := setupOutputValidatorTest(t)
+				validator.head = head
+				client.safeL2Num = 149
+				client.finalizedL2Num = 149
+				game := newGame(mockRootClaim)
+				require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+				require.True(t, game.AgreeWithClaim)
+				require.False(t, game.Pending)
+			})
+		})
+	}
+
+	t.Run("SafeModeIgnoresFinalizedHead", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		validator.head = types.AgreementHeadSafe
+		client.safeL2Num = 150
+		client.finalizedL2Num = 100
+		game := newGame(common.Hash{0xbb})
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.Pending)
+	})
+
+	t.Run("FinalizedModeIgnoresSafeHead", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		validator.head = types.AgreementHeadFinalized
+		client.safeL2Num = 150
+		client.finalizedL2Num = 100
+		game := newGame(common.Hash{0xbb})
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.True(t, game.Pending)
+	})
+
+	t.Run("UsesStatusFromOutput", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		client.status = &eth.SyncStatus{SafeL2: eth.L2BlockRef{Number: 100}}
+		game := newGame(common.Hash{0xbb})
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.Pending)
+		require.Zero(t, client.syncStatusCalls)
+	})
+
+	t.Run("FinalityPredicate", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		// Blocks are final once they are 50 blocks behind the safe head
+		client.safeL2Num = 200
+		validator.isFinalized = func(_ context.Context, blockNum uint64) (bool, error) {
+			return blockNum+50 <= client.safeL2Num, nil
+		}
+
+		game := newGame(common.Hash{0xbb})
+		game.L2BlockNumber = 150
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.Pending)
+
+		game = newGame(common.Hash{0xbb})
+		game.L2BlockNumber = 151
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.True(t, game.Pending, "should be pending when the predicate reports the block is not final")
+	})
+
+	t.Run("FinalityPredicateError", func(t *testing.T) {
+		validator, _, _ := setupOutputValidatorTest(t)
+		validator.isFinalized = func(_ context.Context, _ uint64) (bool, error) {
+			return false, errors.New("boom")
+		}
+		game := newGame(common.Hash{0xbb})
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.Pending)
+	})
+
+	t.Run("SyncStatusError", func(t *testing.T) {
+		validator, client, _ := setupOutputValidatorTest(t)
+		client.syncStatusErr = errors.New("boom")
+		game := newGame(common.Hash{0xbb})
+		require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.AgreeWithClaim)
+		require.False(t, game.Pending)
+	})
+}
+
+func TestDetector_CheckRootAgreementTimeout(t *testing.T) {
+	t.Run("TimesOut", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		validator.timeout = 10 * time.Millisecond
+		rollup.outputBlocks = true
+		game := &types.EnrichedGameData{
+			L1HeadNum:     100,
+			L2BlockNumber: 0,
+			RootClaim:     mockRootClaim,
+		}
+		err := validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.False(t, game.AgreeWithClaim)
+	})
+
+	t.Run("AppliesOwnDeadline", func(t *testing.T) {
+		validator, rollup, _ := setupOutputValidatorTest(t)
+		validator.timeout = time.Minute
+		game := &types.EnrichedGameData{
+			L1HeadNum:     200,
+			L2BlockNumber: 0,
+			RootClaim:     mockRootClaim,
+		}
+		// The comparison timeout applies independently of any longer deadline inherited from the caller
+		parentCtx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+		err := validator.Enrich(parentCtx, rpcblock.Latest, nil, game)
+		require.NoError(t, err)
+		require.True(t, game.AgreeWithClaim)
+		require.True(t, rollup.hadDeadline)
+		require.WithinDuration(t, time.Now().Add(time.Minute), rollup.deadline, 10*time.Second)
+	})
+}
+
+func TestDetector_CheckRootAgreementCoalescesRequests(t *testing.T) {
+	const workers = 10
+	logger := testlog.Logger(t, log.LvlInfo)
+	client := &blockingRollupClient{release: make(chan struct{})}
+	metrics := &concurrentOutputMetrics{}
+	validator := NewAgreementEnricher(logger, metrics, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, types.AgreementHeadSafe, nil, nil, nil)
+
+	var ready, done sync.WaitGroup
+	ready.Add(workers)
+	done.Add(workers)
+	games := make([]*types.EnrichedGameData, workers)
+	for i := range games {
+		games[i] = &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 100, RootClaim: mockRootClaim}
+		go func(game *types.EnrichedGameData) {
+			defer done.Done()
+			ready.Done()
+			require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		}(games[i])
+	}
+	ready.Wait()
+	// Allow all workers to join the in-flight request before it completes
+	time.Sleep(100 * time.Millisecond)
+	close(client.release)
+	done.Wait()
+
+	require.EqualValues(t, 1, client.outputCalls.Load())
+	require.EqualValues(t, workers-1, metrics.coalesced.Load())
+	for _, game := range games {
+		require.True(t, game.AgreeWithClaim)
+		require.Equal(t, mockRootClaim, game.ExpectedRootClaim)
+	}
+
+	// Subsequent requests for the same block are not served from a completed request
+	game := &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 100, RootClaim: mockRootClaim}
+	require.NoError(t, validator.Enrich(context.Background(), rpcblock.Latest, nil, game))
+	require.EqualValues(t, 2, client.outputCalls.Load())
+	require.EqualValues(t, workers-1, metrics.coalesced.Load())
+}
+
+func TestDetector_CheckRootAgreementCoalescedCancellation(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	client := &blockingRollupClient{release: make(chan struct{})}
+	metrics := &concurrentOutputMetrics{}
+	validator := NewAgreementEnricher(logger, metrics, clock.NewDeterministicClock(time.Unix(1000, 0)), client, time.Minute, types.AgreementHeadSafe, nil, nil, nil)
+
+	// The first caller starts the shared request then is cancelled
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- validator.Enrich(firstCtx, rpcblock.Latest, nil, &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 100, RootClaim: mockRootClaim})
+	}()
+	require.Eventually(t, func() bool { return client.outputCalls.Load() == 1 }, 10*time.Second, time.Millisecond)
+
+	game := &types.EnrichedGameData{L1HeadNum: 200, L2BlockNumber: 100, RootClaim: mockRootClaim}
+	secondErr := make(chan error, 1)
+	go func() {
+		secondErr <- validator.Enrich(context.Background(), rpcblock.Latest, nil, game)
+	}()
+	// Allow the second caller to join the in-flight request
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled, "should return once its own context is done")
+
+	close(client.release)
+	require.NoError(t, <-secondErr, "should not be failed by the first caller's cancellation")
+	require.True(t, game.AgreeWithClaim)
+	require.EqualValues(t, 1, client.outputCalls.Load())
+	require.EqualValues(t, 1, metrics.coalesced.Load())
+}
+
+type concurrentOutputMetrics struct {
+	coalesced atomic.Int64
+}
+
+func (c *concurrentOutputMetrics) RecordOutputFetchTime(_ float64) {}
+
+func (c *concurrentOutputMetrics) RecordRootMismatchDetails() {}
+
+func (c *concurrentOutputMetrics) RecordRollupInternalInconsistency() {}
+
+func (c *concurrentOutputMetrics) RecordSingleflightCoalesced() {
+	c.coalesced.Add(1)
+}
+
+// blockingRollupClient blocks output requests until released and is safe for concurrent use.
+type blockingRollupClient struct {
+	outputCalls atomic.Int64
+	release     chan struct{}
+}
+
+func (b *blockingRollupClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	b.outputCalls.Add(1)
+	<-b.release
+	return &eth.OutputResponse{
+		OutputRoot: eth.Bytes32(mockRootClaim),
+		BlockRef:   eth.L2BlockRef{Number: blockNum},
+	}, nil
+}
+
+func (b *blockingRollupClient) SafeHeadAtL1Block(_ context.Context, _ uint64) (*eth.SafeHeadResponse, error) {
+	return &eth.SafeHeadResponse{SafeHead: eth.BlockID{Number: 99999999999}}, nil
+}
+
+func (b *blockingRollupClient) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	return &eth.SyncStatus{}, nil
+}
+
 type stubOutputMetrics struct {
-	fetchTime float64
+	fetchTime       float64
+	coalesced       int
+	mismatchDetails int
+	inconsistencies int
 }
 
 func (s *stubOutputMetrics) RecordOutputFetchTime(fetchTime float64) {
 	s.fetchTime = fetchTime
 }
 
+func (s *stubOutputMetrics) RecordSingleflightCoalesced() {
+	s.coalesced++
+}
+
+func (s *stubOutputMetrics) RecordRootMismatchDetails() {
+	s.mismatchDetails++
+}
+
+func (s *stubOutputMetrics) RecordRollupInternalInconsistency() {
+	s.inconsistencies++
+}
+
 type stubRollupClient struct {
-	blockNum    uint64
-	outputErr   error
-	safeHeadErr error
-	safeHeadNum uint64
+	blockNum        uint64
+	outputErr       error
+	safeHeadErr     error
+	safeHeadNum     uint64
+	syncStatusErr   error
+	unsafeHeadNum   uint64
+	blockTime       uint64
+	status          *eth.SyncStatus
+	outputBlocks    bool
+	safeL2Num       uint64
+	finalizedL2Num  uint64
+	syncStatusCalls int
+	outputCalls     int
+	blockHash       common.Hash
+	stateRoot       common.Hash
+	withdrawalRoot  common.Hash
+	consistentRoot  bool
+	prunedBelow     uint64
+	hadDeadline     bool
+	deadline        time.Time
 }
 
-func (s *stubRollupClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+func (s *stubRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
 	s.blockNum = blockNum
-	return &eth.OutputResponse{OutputRoot: eth.Bytes32(mockRootClaim)}, s.outputErr
+	s.outputCalls++
+	s.deadline, s.hadDeadline = ctx.Deadline()
+	if s.outputBlocks {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if blockNum < s.prunedBelow {
+		return nil, errors.New("missing trie node 0x1234 (path ) state 0x1234 is not available")
+	}
+	output := &eth.OutputResponse{
+		OutputRoot:            eth.Bytes32(mockRootClaim),
+		BlockRef:              eth.L2BlockRef{Number: blockNum, Time: s.blockTime, Hash: s.blockHash},
+		StateRoot:             s.stateRoot,
+		WithdrawalStorageRoot: s.withdrawalRoot,
+		Status:                s.status,
+	}
+	if s.consistentRoot {
+		output.OutputRoot = eth.Bytes32(ComputedOutputRootV0(output))
+	}
+	return output, s.outputErr
 }
 
 func (s *stubRollupClient) SafeHeadAtL1Block(_ context.Context, _ uint64) (*eth.SafeHeadResponse, error) {
@@ -174,3 +802,21 @@ func (s *stubRollupClient) SafeHeadAtL1Block(_ context.Context, _ uint64) (*eth.
 		},
 	}, nil
 }
+
+func (s *stubRollupClient) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	s.syncStatusCalls++
+	if s.syncStatusErr != nil {
+		return nil, s.syncStatusErr
+	}
+	return &eth.SyncStatus{
+		UnsafeL2: eth.L2BlockRef{
+			Number: s.unsafeHeadNum,
+		},
+		SafeL2: eth.L2BlockRef{
+			Number: s.safeL2Num,
+		},
+		FinalizedL2: eth.L2BlockRef{
+			Number: s.finalizedL2Num,
+		},
+	}, nil
+}
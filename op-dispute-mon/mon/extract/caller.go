@@ -3,6 +3,7 @@ package extract
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	contractMetrics "github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
@@ -21,6 +22,7 @@ const metricsLabel = "game_caller_creator"
 type GameCallerMetrics interface {
 	caching.Metrics
 	contractMetrics.ContractMetricer
+	RecordContractCacheReuse(reused, created int)
 }
 
 type GameCaller interface {
@@ -30,24 +32,62 @@ type GameCaller interface {
 	BondCaller
 	BalanceCaller
 	ClaimCaller
+	OutputClaimCaller
+	MaxGameDepthCaller
+	RequiredBondCaller
+	ResolvedAtCaller
 }
 
+// GameCallerCreator creates the contract bindings for games, reusing the binding for each game address
+// across monitoring cycles since a game's contract never changes.
 type GameCallerCreator struct {
 	m      GameCallerMetrics
-	cache  *caching.LRUCache[common.Address, contracts.FaultDisputeGameContract]
 	caller *batching.MultiCaller
+
+	mu    sync.Mutex
+	cache map[common.Address]contracts.FaultDisputeGameContract
+
+	// reused and created count the bindings reused from the cache and newly created since the last RetainGames call.
+	reused  int
+	created int
 }
 
 func NewGameCallerCreator(m GameCallerMetrics, caller *batching.MultiCaller) *GameCallerCreator {
 	return &GameCallerCreator{
 		m:      m,
 		caller: caller,
-		cache:  caching.NewLRUCache[common.Address, contracts.FaultDisputeGameContract](m, metricsLabel, 100),
+		cache:  make(map[common.Address]contracts.FaultDisputeGameContract),
+	}
+}
+
+// RetainGames evicts the bindings of all games not in games, so the cache only holds games still listed by the
+// factories. It is called once per monitoring cycle so also records the number of bindings reused and created in the cycle.
+func (g *GameCallerCreator) RetainGames(games []gameTypes.GameMetadata) {
+	present := make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		present[game.Proxy] = true
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.m.RecordContractCacheReuse(g.reused, g.created)
+	g.reused = 0
+	g.created = 0
+	for addr := range g.cache {
+		if !present[addr] {
+			delete(g.cache, addr)
+		}
 	}
 }
 
 func (g *GameCallerCreator) CreateContract(ctx context.Context, game gameTypes.GameMetadata) (GameCaller, error) {
-	if fdg, ok := g.cache.Get(game.Proxy); ok {
+	g.mu.Lock()
+	fdg, ok := g.cache[game.Proxy]
+	if ok {
+		g.reused++
+	}
+	g.mu.Unlock()
+	g.m.CacheGet(metricsLabel, ok)
+	if ok {
 		return fdg, nil
 	}
 	switch faultTypes.GameType(game.GameType) {
@@ -60,7 +100,12 @@ func (g *GameCallerCreator) CreateContract(ctx context.Context, game gameTypes.G
 		if err != nil {
 			return nil, fmt.Errorf("failed to create fault dispute game contract: %w", err)
 		}
-		g.cache.Add(game.Proxy, fdg)
+		g.mu.Lock()
+		g.cache[game.Proxy] = fdg
+		g.created++
+		size := len(g.cache)
+		g.mu.Unlock()
+		g.m.CacheAdd(metricsLabel, size, false)
 		return fdg, nil
 	default:
 		return nil, fmt.Errorf("unsupported game type: %d", game.GameType)
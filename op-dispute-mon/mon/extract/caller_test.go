@@ -75,17 +75,53 @@ func TestMetadataCreator_CreateContract(t *testing.T) {
 	}
 }
 
-func setupMetadataLoaderTest(t *testing.T) (*batching.MultiCaller, *mockCacheMetrics) {
+func TestMetadataCreator_ReusesContractsAcrossCycles(t *testing.T) {
+	otherAddr := common.HexToAddress("0x1234")
+	caller, metrics := setupMetadataLoaderTest(t, otherAddr)
+	creator := NewGameCallerCreator(metrics, caller)
+	games := []types.GameMetadata{
+		{GameType: uint32(faultTypes.CannonGameType), Proxy: fdgAddr},
+		{GameType: uint32(faultTypes.CannonGameType), Proxy: otherAddr},
+	}
+	cycle := func(games ...types.GameMetadata) {
+		for _, game := range games {
+			_, err := creator.CreateContract(context.Background(), game)
+			require.NoError(t, err)
+		}
+		creator.RetainGames(games)
+	}
+
+	cycle(games...)
+	cycle(games...)
+	require.Equal(t, 2, metrics.cacheAddCalls, "should create each contract once")
+	require.Equal(t, 4, metrics.cacheGetCalls)
+
+	// Games no longer present are evicted
+	cycle(games[0])
+	cycle(games...)
+	require.Equal(t, 3, metrics.cacheAddCalls, "should recreate the evicted contract only")
+
+	require.Equal(t, []int{0, 2, 1, 1}, metrics.reused, "should count reuse from the second cycle")
+	require.Equal(t, []int{2, 0, 0, 1}, metrics.created)
+}
+
+func setupMetadataLoaderTest(t *testing.T, additionalGames ...common.Address) (*batching.MultiCaller, *mockCacheMetrics) {
 	fdgAbi := snapshots.LoadFaultDisputeGameABI()
 	stubRpc := batchingTest.NewAbiBasedRpc(t, fdgAddr, fdgAbi)
 	caller := batching.NewMultiCaller(stubRpc, batching.DefaultBatchSize)
 	stubRpc.SetResponse(fdgAddr, "version", rpcblock.Latest, nil, []interface{}{"0.18.0"})
+	for _, addr := range additionalGames {
+		stubRpc.AddContract(addr, fdgAbi)
+		stubRpc.SetResponse(addr, "version", rpcblock.Latest, nil, []interface{}{"0.18.0"})
+	}
 	return caller, &mockCacheMetrics{}
 }
 
 type mockCacheMetrics struct {
 	cacheAddCalls int
 	cacheGetCalls int
+	reused        []int
+	created       []int
 	*contractMetrics.NoopMetrics
 }
 
@@ -95,3 +131,7 @@ func (m *mockCacheMetrics) CacheAdd(_ string, _ int, _ bool) {
 func (m *mockCacheMetrics) CacheGet(_ string, _ bool) {
 	m.cacheGetCalls++
 }
+func (m *mockCacheMetrics) RecordContractCacheReuse(reused, created int) {
+	m.reused = append(m.reused, reused)
+	m.created = append(m.created, created)
+}
@@ -0,0 +1,41 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*ChainEnricher)(nil)
+
+type ChainMetrics interface {
+	RecordUnknownChainGames(chainID uint64)
+}
+
+// ChainEnricher applies the enricher for the L2 chain each game disputes, so games from multiple chains
+// are compared against the outputs of their own chain when monitored by a single process.
+// Games disputing a chain without an enricher are filtered.
+type ChainEnricher struct {
+	metrics   ChainMetrics
+	enrichers map[uint64]Enricher
+}
+
+// NewChainEnricher creates a new ChainEnricher, applying the enricher keyed by each game's L2 chain ID.
+// Games disputing the chain of the primary rollup node have a chain ID of zero.
+func NewChainEnricher(metrics ChainMetrics, enrichers map[uint64]Enricher) *ChainEnricher {
+	return &ChainEnricher{
+		metrics:   metrics,
+		enrichers: enrichers,
+	}
+}
+
+func (e *ChainEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	enricher, ok := e.enrichers[game.L2ChainID]
+	if !ok {
+		e.metrics.RecordUnknownChainGames(game.L2ChainID)
+		return fmt.Errorf("%w: no rollup node for l2 chain %v", ErrFiltered, game.L2ChainID)
+	}
+	return enricher.Enrich(ctx, block, caller, game)
+}
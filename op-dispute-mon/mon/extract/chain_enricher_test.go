@@ -0,0 +1,151 @@
+package extract
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainEnricher(t *testing.T) {
+	setup := func(t *testing.T) (*ChainEnricher, *chainOutputClient, *chainOutputClient, *stubChainMetrics) {
+		chainA := &chainOutputClient{root: common.Hash{0x0a}}
+		chainB := &chainOutputClient{root: common.Hash{0x0b}}
+		newEnricher := func(client ClaimOutputClient) Enricher {
+			return NewClaimAgreementEnricher(testlog.Logger(t, log.LvlInfo), client, nil)
+		}
+		metrics := &stubChainMetrics{unknown: make(map[uint64]int)}
+		enricher := NewChainEnricher(metrics, map[uint64]Enricher{
+			10: newEnricher(chainA),
+			20: newEnricher(chainB),
+		})
+		return enricher, chainA, chainB, metrics
+	}
+	caller := &mockGameCaller{splitDepth: 2, prestateBlock: 100, poststateBlock: 104}
+	newGame := func(chainID uint64, root common.Hash) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			L2ChainID: chainID,
+			Claims: []monTypes.EnrichedClaim{
+				{Claim: faultTypes.Claim{ClaimData: faultTypes.ClaimData{Position: faultTypes.RootPosition, Value: root}}},
+			},
+		}
+	}
+
+	t.Run("RoutesToChainProvider", func(t *testing.T) {
+		enricher, chainA, chainB, _ := setup(t)
+		gameA := newGame(10, common.Hash{0x0a})
+		gameB := newGame(20, common.Hash{0x0a})
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, gameA))
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, gameB))
+		require.Equal(t, 1, chainA.calls)
+		require.Equal(t, 1, chainB.calls)
+		require.Equal(t, 1, gameA.AgreeingClaims)
+		require.Equal(t, 1, gameB.DisagreeingClaims, "should compare against the output of chain B")
+	})
+
+	t.Run("DomainSeparatedChains", func(t *testing.T) {
+		// Both rollup nodes report the same output root but each chain's claims commit to its own domain
+		outputRoot := common.Hash{0xaa}
+		domainA := common.Hash{0xd0, 0x0a}
+		domainB := common.Hash{0xd0, 0x0b}
+		chainA := &chainOutputClient{root: outputRoot}
+		chainB := &chainOutputClient{root: outputRoot}
+		newEnricher := func(client ClaimOutputClient, domain common.Hash) Enricher {
+			return NewClaimAgreementEnricher(testlog.Logger(t, log.LvlInfo), client, DomainComparatorSelector(domain, nil, nil))
+		}
+		enricher := NewChainEnricher(&stubChainMetrics{unknown: make(map[uint64]int)}, map[uint64]Enricher{
+			10: newEnricher(chainA, domainA),
+			20: newEnricher(chainB, domainB),
+		})
+		rootA := crypto.Keccak256Hash(domainA[:], outputRoot[:])
+		rootB := crypto.Keccak256Hash(domainB[:], outputRoot[:])
+
+		games := []*monTypes.EnrichedGameData{
+			newGame(10, rootA),
+			newGame(20, rootB),
+			newGame(10, rootB),
+			newGame(20, rootA),
+			newGame(10, outputRoot),
+		}
+		for _, game := range games {
+			require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		}
+		require.Equal(t, 1, games[0].AgreeingClaims)
+		require.Equal(t, 1, games[1].AgreeingClaims)
+		require.Equal(t, 1, games[2].DisagreeingClaims, "should not match under the domain of another chain")
+		require.Equal(t, 1, games[3].DisagreeingClaims, "should not match under the domain of another chain")
+		require.Equal(t, 1, games[4].DisagreeingClaims, "should not match without the domain")
+	})
+
+	t.Run("AlternateHasher", func(t *testing.T) {
+		outputRoot := common.Hash{0xaa}
+		domain := common.Hash{0xd0, 0x0a}
+		sha256Hasher := func(data ...[]byte) common.Hash {
+			h := sha256.New()
+			for _, d := range data {
+				h.Write(d)
+			}
+			return common.Hash(h.Sum(nil))
+		}
+		client := &chainOutputClient{root: outputRoot}
+		keccakEnricher := NewClaimAgreementEnricher(testlog.Logger(t, log.LvlInfo), client, DomainComparatorSelector(domain, nil, nil))
+		sha256Enricher := NewClaimAgreementEnricher(testlog.Logger(t, log.LvlInfo), client, DomainComparatorSelector(domain, nil, sha256Hasher))
+		keccakRoot := crypto.Keccak256Hash(domain[:], outputRoot[:])
+		sha256Root := common.Hash(sha256.Sum256(append(domain[:], outputRoot[:]...)))
+
+		for _, test := range []struct {
+			enricher Enricher
+			root     common.Hash
+			agree    bool
+		}{
+			{keccakEnricher, keccakRoot, true},
+			{keccakEnricher, sha256Root, false},
+			{sha256Enricher, sha256Root, true},
+			{sha256Enricher, keccakRoot, false},
+		} {
+			game := newGame(10, test.root)
+			require.NoError(t, test.enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+			if test.agree {
+				require.Equal(t, 1, game.AgreeingClaims)
+			} else {
+				require.Equal(t, 1, game.DisagreeingClaims)
+			}
+		}
+	})
+
+	t.Run("UnknownChain", func(t *testing.T) {
+		enricher, chainA, chainB, metrics := setup(t)
+		err := enricher.Enrich(context.Background(), rpcblock.Latest, caller, newGame(30, common.Hash{0x0a}))
+		require.ErrorIs(t, err, ErrFiltered)
+		require.Equal(t, 1, metrics.unknown[30])
+		require.Zero(t, chainA.calls)
+		require.Zero(t, chainB.calls)
+	})
+}
+
+type stubChainMetrics struct {
+	unknown map[uint64]int
+}
+
+func (s *stubChainMetrics) RecordUnknownChainGames(chainID uint64) {
+	s.unknown[chainID]++
+}
+
+type chainOutputClient struct {
+	root  common.Hash
+	calls int
+}
+
+func (c *chainOutputClient) OutputAtBlock(_ context.Context, _ uint64) (*eth.OutputResponse, error) {
+	c.calls++
+	return &eth.OutputResponse{OutputRoot: eth.Bytes32(c.root)}, nil
+}
@@ -0,0 +1,98 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var _ Enricher = (*ClaimAgreementEnricher)(nil)
+
+// OutputClaimCaller loads the parameters required to determine the L2 block each output root claim commits to.
+type OutputClaimCaller interface {
+	GetSplitDepth(ctx context.Context) (faultTypes.Depth, error)
+	GetBlockRange(ctx context.Context) (prestateBlock uint64, poststateBlock uint64, retErr error)
+}
+
+type ClaimOutputClient interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// ClaimAgreementEnricher counts the output root claims in an in-progress game that agree with the rollup node.
+// Claims below the split depth commit to execution trace states rather than output roots so are not counted.
+type ClaimAgreementEnricher struct {
+	log           log.Logger
+	client        ClaimOutputClient
+	comparatorFor ComparatorSelector
+}
+
+// NewClaimAgreementEnricher creates a new ClaimAgreementEnricher.
+// If comparatorFor is nil, claims are compared against the output root reported by the rollup node.
+func NewClaimAgreementEnricher(logger log.Logger, client ClaimOutputClient, comparatorFor ComparatorSelector) *ClaimAgreementEnricher {
+	if comparatorFor == nil {
+		comparatorFor = func(uint64) OutputComparator {
+			return ReportedOutputRoot
+		}
+	}
+	return &ClaimAgreementEnricher{
+		log:           logger,
+		client:        client,
+		comparatorFor: comparatorFor,
+	}
+}
+
+func (e *ClaimAgreementEnricher) Enrich(ctx context.Context, _ rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	if game.Status != gameTypes.GameStatusInProgress {
+		return nil
+	}
+	splitDepth, err := caller.GetSplitDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load split depth: %w", err)
+	}
+	prestateBlock, poststateBlock, err := caller.GetBlockRange(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load block range: %w", err)
+	}
+	// Many claims commit to the same block so only fetch each output once.
+	expected := make(map[uint64]common.Hash)
+	game.AgreeingClaims = 0
+	game.DisagreeingClaims = 0
+	for _, claim := range game.Claims {
+		if claim.Position.Depth() > splitDepth {
+			continue
+		}
+		blockNum := claimedBlockNumber(claim.Position, splitDepth, prestateBlock, poststateBlock)
+		root, ok := expected[blockNum]
+		if !ok {
+			output, err := e.client.OutputAtBlock(ctx, blockNum)
+			if err != nil {
+				e.log.Warn("Unable to check claim agreement", "game", game.Proxy, "claim", claim.ContractIndex, "l2BlockNum", blockNum, "err", err)
+				continue
+			}
+			root = e.comparatorFor(blockNum)(output)
+			expected[blockNum] = root
+		}
+		if claim.Value == root {
+			game.AgreeingClaims++
+		} else {
+			game.DisagreeingClaims++
+		}
+	}
+	return nil
+}
+
+// claimedBlockNumber returns the L2 block number the output root claim at pos commits to.
+func claimedBlockNumber(pos faultTypes.Position, splitDepth faultTypes.Depth, prestateBlock, poststateBlock uint64) uint64 {
+	traceIndex := pos.TraceIndex(splitDepth)
+	if !traceIndex.IsUint64() {
+		return poststateBlock
+	}
+	return min(traceIndex.Uint64()+prestateBlock+1, poststateBlock)
+}
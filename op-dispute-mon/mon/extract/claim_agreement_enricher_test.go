@@ -0,0 +1,109 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimAgreementEnricher(t *testing.T) {
+	setup := func(t *testing.T) (*ClaimAgreementEnricher, *mockGameCaller, *claimOutputClient) {
+		client := &claimOutputClient{outputs: map[uint64]common.Hash{
+			101: {0x01},
+			102: {0x02},
+			103: {0x03},
+			104: {0x04},
+		}}
+		caller := &mockGameCaller{splitDepth: 2, prestateBlock: 100, poststateBlock: 104}
+		return NewClaimAgreementEnricher(testlog.Logger(t, log.LvlInfo), client, nil), caller, client
+	}
+	claim := func(depth faultTypes.Depth, index int64, value common.Hash) monTypes.EnrichedClaim {
+		return monTypes.EnrichedClaim{Claim: faultTypes.Claim{ClaimData: faultTypes.ClaimData{
+			Position: faultTypes.NewPosition(depth, big.NewInt(index)),
+			Value:    value,
+		}}}
+	}
+
+	t.Run("MixedAgreement", func(t *testing.T) {
+		enricher, caller, client := setup(t)
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{
+				claim(0, 0, common.Hash{0x04}), // Root claim commits to the poststate block
+				claim(1, 0, common.Hash{0xbb}), // Disagrees with block 102
+				claim(2, 0, common.Hash{0x01}), // Agrees with block 101
+				claim(2, 2, common.Hash{0xcc}), // Disagrees with block 103
+				claim(2, 1, common.Hash{0x02}), // Agrees with block 102
+				claim(3, 0, common.Hash{0xdd}), // Below the split depth so not an output root
+			},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Equal(t, 3, game.AgreeingClaims)
+		require.Equal(t, 2, game.DisagreeingClaims)
+		require.Equal(t, 4, client.calls, "should fetch each output once")
+	})
+
+	t.Run("SkipsResolvedGames", func(t *testing.T) {
+		enricher, caller, client := setup(t)
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusDefenderWon,
+			Claims: []monTypes.EnrichedClaim{claim(0, 0, common.Hash{0x04})},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.AgreeingClaims)
+		require.Zero(t, client.calls)
+	})
+
+	t.Run("SkipsClaimsWithUnavailableOutput", func(t *testing.T) {
+		enricher, caller, client := setup(t)
+		client.err = errors.New("not found")
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0, 0, common.Hash{0x04})},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.AgreeingClaims)
+		require.Zero(t, game.DisagreeingClaims)
+	})
+
+	t.Run("SplitDepthError", func(t *testing.T) {
+		enricher, caller, _ := setup(t)
+		caller.splitDepthErr = errors.New("boom")
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusInProgress}
+		err := enricher.Enrich(context.Background(), rpcblock.Latest, caller, game)
+		require.ErrorIs(t, err, caller.splitDepthErr)
+	})
+
+	t.Run("BlockRangeError", func(t *testing.T) {
+		enricher, caller, _ := setup(t)
+		caller.blockRangeErr = errors.New("boom")
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusInProgress}
+		err := enricher.Enrich(context.Background(), rpcblock.Latest, caller, game)
+		require.ErrorIs(t, err, caller.blockRangeErr)
+	})
+}
+
+type claimOutputClient struct {
+	outputs map[uint64]common.Hash
+	err     error
+	calls   int
+}
+
+func (c *claimOutputClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &eth.OutputResponse{OutputRoot: eth.Bytes32(c.outputs[blockNum])}, nil
+}
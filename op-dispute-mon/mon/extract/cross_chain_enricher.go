@@ -0,0 +1,61 @@
+package extract
+
+import (
+	"context"
+
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var _ Enricher = (*CrossChainEnricher)(nil)
+
+// ChainOutputs is the source of outputs for an L2 chain and the comparator used to derive root claims from them.
+type ChainOutputs struct {
+	Client        ClaimOutputClient
+	ComparatorFor ComparatorSelector // nil to compare against the output root reported by the rollup node
+}
+
+// CrossChainEnricher checks whether a root claim that disagrees with its own chain matches the output of a different
+// L2 chain at the same block. A match indicates a cross-chain confusion attack, proposing a valid output from one
+// chain on another.
+type CrossChainEnricher struct {
+	log    log.Logger
+	chains map[uint64]ChainOutputs
+}
+
+// NewCrossChainEnricher creates a new CrossChainEnricher comparing against the outputs of chains, keyed by L2 chain ID.
+// The chain of the primary rollup node has a chain ID of zero.
+func NewCrossChainEnricher(logger log.Logger, chains map[uint64]ChainOutputs) *CrossChainEnricher {
+	return &CrossChainEnricher{
+		log:    logger,
+		chains: chains,
+	}
+}
+
+func (e *CrossChainEnricher) Enrich(ctx context.Context, _ rpcblock.Block, _ GameCaller, game *monTypes.EnrichedGameData) error {
+	if game.AgreeWithClaim || game.Pending || game.Indeterminate || game.ImplausibleBlock || game.RollupInternalInconsistency {
+		return nil
+	}
+	for chainID, chain := range e.chains {
+		if chainID == game.L2ChainID {
+			continue
+		}
+		output, err := chain.Client.OutputAtBlock(ctx, game.L2BlockNumber)
+		if err != nil {
+			// The other chain may not have reached the block, which doesn't prevent the game being classified
+			e.log.Debug("Failed to fetch output from other chain", "game", game.Proxy, "chain", chainID, "l2BlockNum", game.L2BlockNumber, "err", err)
+			continue
+		}
+		comparator := ReportedOutputRoot
+		if chain.ComparatorFor != nil {
+			comparator = chain.ComparatorFor(game.L2BlockNumber)
+		}
+		if comparator(output) == game.RootClaim {
+			game.CrossChainRoot = true
+			game.CrossChainRootChainID = chainID
+			return nil
+		}
+	}
+	return nil
+}
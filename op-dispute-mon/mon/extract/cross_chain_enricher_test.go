@@ -0,0 +1,118 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossChainEnricher(t *testing.T) {
+	setup := func(t *testing.T) (*CrossChainEnricher, *chainOutputClient, *chainOutputClient) {
+		chainA := &chainOutputClient{root: common.Hash{0x0a}}
+		chainB := &chainOutputClient{root: common.Hash{0x0b}}
+		enricher := NewCrossChainEnricher(testlog.Logger(t, log.LvlInfo), map[uint64]ChainOutputs{
+			10: {Client: chainA},
+			20: {Client: chainB},
+		})
+		return enricher, chainA, chainB
+	}
+	newGame := func(chainID uint64, root common.Hash) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			GameMetadata:  gameTypes.GameMetadata{Proxy: common.Address{0xaa}},
+			L2ChainID:     chainID,
+			L2BlockNumber: 100,
+			RootClaim:     root,
+		}
+	}
+
+	t.Run("MatchesOtherChain", func(t *testing.T) {
+		enricher, chainA, chainB := setup(t)
+		game := newGame(10, common.Hash{0x0b})
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.CrossChainRoot)
+		require.Equal(t, uint64(20), game.CrossChainRootChainID)
+		require.Zero(t, chainA.calls, "should not query the game's own chain")
+		require.Equal(t, 1, chainB.calls)
+	})
+
+	t.Run("MatchesNoChain", func(t *testing.T) {
+		enricher, _, _ := setup(t)
+		game := newGame(10, common.Hash{0xcc})
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.CrossChainRoot)
+	})
+
+	t.Run("MatchesOwnChainOnly", func(t *testing.T) {
+		// Only chain A reports this root and the game disputes chain A so it isn't a cross-chain root
+		enricher, _, _ := setup(t)
+		game := newGame(10, common.Hash{0x0a})
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.CrossChainRoot)
+	})
+
+	t.Run("SkipAgreeingGames", func(t *testing.T) {
+		enricher, _, chainB := setup(t)
+		game := newGame(10, common.Hash{0x0b})
+		game.AgreeWithClaim = true
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.False(t, game.CrossChainRoot)
+		require.Zero(t, chainB.calls)
+	})
+
+	t.Run("SkipUnclassifiedGames", func(t *testing.T) {
+		for name, modify := range map[string]func(game *monTypes.EnrichedGameData){
+			"Pending":          func(game *monTypes.EnrichedGameData) { game.Pending = true },
+			"Indeterminate":    func(game *monTypes.EnrichedGameData) { game.Indeterminate = true },
+			"ImplausibleBlock": func(game *monTypes.EnrichedGameData) { game.ImplausibleBlock = true },
+		} {
+			t.Run(name, func(t *testing.T) {
+				enricher, _, chainB := setup(t)
+				game := newGame(10, common.Hash{0x0b})
+				modify(game)
+				require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+				require.False(t, game.CrossChainRoot)
+				require.Zero(t, chainB.calls)
+			})
+		}
+	})
+
+	t.Run("UsesChainComparator", func(t *testing.T) {
+		chainB := &chainOutputClient{root: common.Hash{0x0b}}
+		claimed := common.Hash{0xd0, 0x0b}
+		enricher := NewCrossChainEnricher(testlog.Logger(t, log.LvlInfo), map[uint64]ChainOutputs{
+			20: {Client: chainB, ComparatorFor: func(_ uint64) OutputComparator {
+				return func(_ *eth.OutputResponse) common.Hash { return claimed }
+			}},
+		})
+		game := newGame(10, claimed)
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.CrossChainRoot)
+	})
+
+	t.Run("IgnoreOutputErrors", func(t *testing.T) {
+		chainC := &chainOutputClient{root: common.Hash{0x0c}}
+		enricher := NewCrossChainEnricher(testlog.Logger(t, log.LvlInfo), map[uint64]ChainOutputs{
+			20: {Client: &erroringOutputClient{}},
+			30: {Client: chainC},
+		})
+		game := newGame(10, common.Hash{0x0c})
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.True(t, game.CrossChainRoot)
+		require.Equal(t, uint64(30), game.CrossChainRootChainID)
+	})
+}
+
+type erroringOutputClient struct{}
+
+func (c *erroringOutputClient) OutputAtBlock(_ context.Context, _ uint64) (*eth.OutputResponse, error) {
+	return nil, errors.New("boom")
+}
@@ -0,0 +1,37 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*DepthEnricher)(nil)
+
+// DepthEnricher loads the max game depth of a game and the depth of its deepest claim.
+type DepthEnricher struct{}
+
+func NewDepthEnricher() *DepthEnricher {
+	return &DepthEnricher{}
+}
+
+func (e *DepthEnricher) Enrich(ctx context.Context, _ rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	game.MaxGameDepth = 0
+	game.DeepestClaim = 0
+	if len(game.Claims) == 0 {
+		return nil
+	}
+	maxDepth, err := caller.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load max game depth: %w", err)
+	}
+	game.MaxGameDepth = maxDepth
+	for _, claim := range game.Claims {
+		if depth := claim.Depth(); depth > game.DeepestClaim {
+			game.DeepestClaim = depth
+		}
+	}
+	return nil
+}
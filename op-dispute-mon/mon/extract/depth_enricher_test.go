@@ -0,0 +1,53 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepthEnricher(t *testing.T) {
+	claim := func(depth faultTypes.Depth) monTypes.EnrichedClaim {
+		return monTypes.EnrichedClaim{Claim: faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{Position: faultTypes.NewPosition(depth, big.NewInt(0))},
+		}}
+	}
+
+	t.Run("LoadsDepths", func(t *testing.T) {
+		enricher := NewDepthEnricher()
+		caller := &mockGameCaller{maxGameDepth: 73}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusDefenderWon,
+			Claims: []monTypes.EnrichedClaim{claim(0), claim(1), claim(3), claim(2)},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Equal(t, faultTypes.Depth(73), game.MaxGameDepth)
+		require.Equal(t, faultTypes.Depth(3), game.DeepestClaim)
+	})
+
+	t.Run("SkipsGamesWithoutClaims", func(t *testing.T) {
+		enricher := NewDepthEnricher()
+		caller := &mockGameCaller{maxGameDepthErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusInProgress}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Zero(t, game.MaxGameDepth)
+		require.Zero(t, game.DeepestClaim)
+	})
+
+	t.Run("MaxGameDepthError", func(t *testing.T) {
+		enricher := NewDepthEnricher()
+		caller := &mockGameCaller{maxGameDepthErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0)},
+		}
+		require.ErrorIs(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game), caller.maxGameDepthErr)
+	})
+}
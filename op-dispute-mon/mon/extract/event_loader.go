@@ -0,0 +1,197 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var resolvedTopic = crypto.Keccak256Hash([]byte("Resolved(uint8)"))
+
+type LogClient interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+}
+
+// EventMetadataLoader loads game metadata from events rather than contract calls, for L1 providers where log queries
+// are cheap but calls are expensive. The status is read from the game's Resolved event. The remaining metadata,
+// including the root claim, can't change after the game is created, so is loaded from the contract once and cached.
+// L2BlockNumberChallenged is only loaded with the cached metadata so a later challenge is reflected once the game
+// resolves.
+type EventMetadataLoader struct {
+	client LogClient
+
+	mu    sync.Mutex
+	cache map[common.Address]eventGame
+}
+
+// eventGame is the cached state of a game loaded by an EventMetadataLoader.
+type eventGame struct {
+	meta contracts.GameMetadata
+	// l1HeadNum is the number of the game's L1 head. The game is created after it so events are only queried from it.
+	l1HeadNum *big.Int
+	// status is the game's status once resolved, loaded from the Resolved event at resolvedAt.
+	status     gameTypes.GameStatus
+	resolvedAt uint64
+}
+
+// NewEventMetadataLoader creates a new EventMetadataLoader.
+func NewEventMetadataLoader(client LogClient) *EventMetadataLoader {
+	return &EventMetadataLoader{
+		client: client,
+		cache:  make(map[common.Address]eventGame),
+	}
+}
+
+// WrapCreator returns a CreateGameCaller that creates callers with create, loading their metadata from events.
+func (l *EventMetadataLoader) WrapCreator(create CreateGameCaller) CreateGameCaller {
+	return func(ctx context.Context, game gameTypes.GameMetadata) (GameCaller, error) {
+		caller, err := create(ctx, game)
+		if err != nil {
+			return nil, err
+		}
+		return &eventGameCaller{GameCaller: caller, loader: l, game: game.Proxy}, nil
+	}
+}
+
+// RetainGames evicts the cached metadata of all games not in games, so the cache only holds games still listed by the
+// factories.
+func (l *EventMetadataLoader) RetainGames(games []gameTypes.GameMetadata) {
+	present := make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		present[game.Proxy] = true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for addr := range l.cache {
+		if !present[addr] {
+			delete(l.cache, addr)
+		}
+	}
+}
+
+// GetGameMetadata returns the metadata of game as at block, loading the fields not available from events with caller.
+// Once the game has resolved its status is cached and no further events are queried.
+func (l *EventMetadataLoader) GetGameMetadata(ctx context.Context, block rpcblock.Block, game common.Address, caller GameCaller) (contracts.GameMetadata, error) {
+	toBlock, err := l.blockNumber(ctx, block)
+	if err != nil {
+		return contracts.GameMetadata{}, err
+	}
+	cached, err := l.cachedGame(ctx, block, game, caller)
+	if err != nil {
+		return contracts.GameMetadata{}, err
+	}
+	meta := cached.meta
+	// The cached status only applies if block is after the resolution. Otherwise, load it again.
+	if cached.status != gameTypes.GameStatusInProgress && (toBlock == nil || toBlock.Uint64() >= cached.resolvedAt) {
+		meta.Status = cached.status
+		return meta, nil
+	}
+	status, resolvedAt, err := l.status(ctx, cached.l1HeadNum, toBlock, game)
+	if err != nil {
+		return contracts.GameMetadata{}, err
+	}
+	if status != gameTypes.GameStatusInProgress {
+		l.mu.Lock()
+		if entry, ok := l.cache[game]; ok {
+			entry.status = status
+			entry.resolvedAt = resolvedAt
+			l.cache[game] = entry
+		}
+		l.mu.Unlock()
+	}
+	meta.Status = status
+	return meta, nil
+}
+
+// status returns the status of game from its Resolved event between fromBlock and toBlock and the block it was
+// resolved in.
+func (l *EventMetadataLoader) status(ctx context.Context, fromBlock *big.Int, toBlock *big.Int, game common.Address) (gameTypes.GameStatus, uint64, error) {
+	logs, err := l.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: []common.Address{game},
+		Topics:    [][]common.Hash{{resolvedTopic}},
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to load game resolution event: %w", err)
+	}
+	for _, log := range logs {
+		if !log.Removed && len(log.Topics) == 2 {
+			// A game can only be resolved once
+			status, err := gameTypes.GameStatusFromUint8(uint8(log.Topics[1].Big().Uint64()))
+			return status, log.BlockNumber, err
+		}
+	}
+	return gameTypes.GameStatusInProgress, 0, nil
+}
+
+func (l *EventMetadataLoader) cachedGame(ctx context.Context, block rpcblock.Block, game common.Address, caller GameCaller) (eventGame, error) {
+	l.mu.Lock()
+	cached, ok := l.cache[game]
+	l.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+	meta, err := caller.GetGameMetadata(ctx, block)
+	if err != nil {
+		return eventGame{}, err
+	}
+	header, err := l.client.HeaderByHash(ctx, meta.L1Head)
+	if err != nil {
+		return eventGame{}, fmt.Errorf("failed to fetch game L1 head: %w", err)
+	}
+	cached = eventGame{meta: meta, l1HeadNum: header.Number}
+	l.mu.Lock()
+	l.cache[game] = cached
+	l.mu.Unlock()
+	return cached, nil
+}
+
+// blockNumber returns the number of the block to load events up to. Log queries can't be made by block hash
+// for a range of blocks so the hash is resolved to its number.
+func (l *EventMetadataLoader) blockNumber(ctx context.Context, block rpcblock.Block) (*big.Int, error) {
+	switch arg := block.ArgValue().(type) {
+	case rpc.BlockNumberOrHash:
+		if hash, ok := arg.Hash(); ok {
+			header, err := l.client.HeaderByHash(ctx, hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch block header: %w", err)
+			}
+			return header.Number, nil
+		}
+		if num, ok := arg.Number(); ok && num >= 0 {
+			return big.NewInt(num.Int64()), nil
+		}
+	case rpc.BlockNumber:
+		if arg >= 0 {
+			return big.NewInt(arg.Int64()), nil
+		}
+	case string:
+		if arg == "latest" {
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported block for event query: %v", block.ArgValue())
+}
+
+// eventGameCaller is a GameCaller that loads game metadata from events.
+type eventGameCaller struct {
+	GameCaller
+	loader *EventMetadataLoader
+	game   common.Address
+}
+
+func (c *eventGameCaller) GetGameMetadata(ctx context.Context, block rpcblock.Block) (contracts.GameMetadata, error) {
+	return c.loader.GetGameMetadata(ctx, block, c.game, c.GameCaller)
+}
@@ -0,0 +1,191 @@
+package extract
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventMetadataLoader(t *testing.T) {
+	game := common.Address{0xaa}
+	otherGame := common.Address{0xbb}
+	moved := func(block uint64, proxy common.Address) types.Log {
+		return types.Log{
+			Address:     proxy,
+			BlockNumber: block,
+			Topics: []common.Hash{
+				crypto.Keccak256Hash([]byte("Move(uint256,bytes32,address)")),
+				common.BigToHash(big.NewInt(0)),
+				{0xcc},
+				common.BytesToHash(common.Address{0xdd}.Bytes()),
+			},
+		}
+	}
+	resolved := func(block uint64, proxy common.Address, status gameTypes.GameStatus) types.Log {
+		return types.Log{
+			Address:     proxy,
+			BlockNumber: block,
+			Topics:      []common.Hash{resolvedTopic, common.BigToHash(big.NewInt(int64(status)))},
+		}
+	}
+	setup := func(logs ...types.Log) (*EventMetadataLoader, *stubLogClient) {
+		// The mock game caller's L1 head is block 5
+		client := &stubLogClient{logs: logs, headers: map[common.Hash]uint64{{0xaa}: 5}}
+		return NewEventMetadataLoader(client), client
+	}
+	atBlock := func(num uint64) rpcblock.Block {
+		return rpcblock.ByHash(common.BigToHash(new(big.Int).SetUint64(num)))
+	}
+
+	t.Run("MatchesCallBasedMetadata", func(t *testing.T) {
+		loader, _ := setup(
+			moved(8, game),
+			moved(12, game),
+			resolved(15, otherGame, gameTypes.GameStatusDefenderWon),
+			resolved(20, game, gameTypes.GameStatusChallengerWon),
+		)
+		for _, test := range []struct {
+			block  uint64
+			status gameTypes.GameStatus
+		}{
+			{block: 10, status: gameTypes.GameStatusInProgress},
+			{block: 20, status: gameTypes.GameStatusChallengerWon},
+		} {
+			caller := &mockGameCaller{status: test.status, l2BlockNum: 100}
+			expected, err := caller.GetGameMetadata(context.Background(), atBlock(test.block))
+			require.NoError(t, err)
+			actual, err := loader.GetGameMetadata(context.Background(), atBlock(test.block), game, &mockGameCaller{l2BlockNum: 100})
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("CachesImmutableMetadata", func(t *testing.T) {
+		loader, client := setup(resolved(20, game, gameTypes.GameStatusDefenderWon))
+		caller := &mockGameCaller{l2BlockNum: 100}
+		meta, err := loader.GetGameMetadata(context.Background(), atBlock(10), game, caller)
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusInProgress, meta.Status)
+		meta, err = loader.GetGameMetadata(context.Background(), atBlock(30), game, caller)
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusDefenderWon, meta.Status, "should load the status from events each time")
+		require.Equal(t, uint64(100), meta.L2BlockNum)
+		require.Equal(t, 1, caller.metadataCalls, "should only load immutable metadata from the contract once")
+		require.Equal(t, 2, client.filterCalls)
+
+		// Resolved status is cached
+		meta, err = loader.GetGameMetadata(context.Background(), atBlock(40), game, caller)
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusDefenderWon, meta.Status)
+		require.Equal(t, 2, client.filterCalls, "should not query events once resolved")
+
+		// But not used for blocks before the resolution
+		meta, err = loader.GetGameMetadata(context.Background(), atBlock(15), game, caller)
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusInProgress, meta.Status)
+		require.Equal(t, 3, client.filterCalls)
+
+		// Evicted games are loaded again
+		loader.RetainGames([]gameTypes.GameMetadata{{Proxy: otherGame}})
+		_, err = loader.GetGameMetadata(context.Background(), atBlock(30), game, caller)
+		require.NoError(t, err)
+		require.Equal(t, 2, caller.metadataCalls)
+	})
+
+	t.Run("IgnoresRemovedLogs", func(t *testing.T) {
+		reorged := resolved(20, game, gameTypes.GameStatusDefenderWon)
+		reorged.Removed = true
+		loader, _ := setup(reorged)
+		meta, err := loader.GetGameMetadata(context.Background(), atBlock(30), game, &mockGameCaller{})
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusInProgress, meta.Status)
+	})
+
+	t.Run("QueriesFromL1Head", func(t *testing.T) {
+		loader, client := setup(resolved(3, game, gameTypes.GameStatusDefenderWon))
+		meta, err := loader.GetGameMetadata(context.Background(), atBlock(30), game, &mockGameCaller{})
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusInProgress, meta.Status)
+		require.Len(t, client.queries, 1)
+		require.Equal(t, big.NewInt(5), client.queries[0].FromBlock)
+		require.Equal(t, big.NewInt(30), client.queries[0].ToBlock)
+	})
+
+	t.Run("UnsupportedBlock", func(t *testing.T) {
+		loader, _ := setup()
+		_, err := loader.GetGameMetadata(context.Background(), rpcblock.Safe, game, &mockGameCaller{})
+		require.ErrorContains(t, err, "unsupported block")
+	})
+
+	t.Run("WrapCreator", func(t *testing.T) {
+		loader, _ := setup(resolved(8, game, gameTypes.GameStatusChallengerWon))
+		caller := &mockGameCaller{}
+		creator := &mockGameCallerCreator{caller: caller}
+		wrapped, err := loader.WrapCreator(creator.CreateGameCaller)(context.Background(), gameTypes.GameMetadata{Proxy: game})
+		require.NoError(t, err)
+		meta, err := wrapped.GetGameMetadata(context.Background(), atBlock(10))
+		require.NoError(t, err)
+		require.Equal(t, gameTypes.GameStatusChallengerWon, meta.Status)
+		require.Equal(t, mockRootClaim, meta.RootClaim)
+		require.Equal(t, common.Hash{0xaa}, meta.L1Head)
+		require.Equal(t, 1, caller.metadataCalls)
+	})
+}
+
+// stubLogClient serves logs matching a query's block range, addresses and topics. Block hashes are the block number
+// unless set in headers.
+type stubLogClient struct {
+	logs        []types.Log
+	headers     map[common.Hash]uint64
+	filterCalls int
+	queries     []ethereum.FilterQuery
+}
+
+func (s *stubLogClient) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	s.filterCalls++
+	s.queries = append(s.queries, q)
+	var result []types.Log
+	for _, log := range s.logs {
+		if q.FromBlock != nil && log.BlockNumber < q.FromBlock.Uint64() {
+			continue
+		}
+		if q.ToBlock != nil && log.BlockNumber > q.ToBlock.Uint64() {
+			continue
+		}
+		if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, log.Address) {
+			continue
+		}
+		if matchesTopics(log, q.Topics) {
+			result = append(result, log)
+		}
+	}
+	return result, nil
+}
+
+func matchesTopics(log types.Log, topics [][]common.Hash) bool {
+	for i, options := range topics {
+		if len(options) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) || !slices.Contains(options, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *stubLogClient) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	if num, ok := s.headers[hash]; ok {
+		return &types.Header{Number: new(big.Int).SetUint64(num)}, nil
+	}
+	return &types.Header{Number: hash.Big()}, nil
+}
@@ -2,74 +2,257 @@ package extract
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
 	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
 var (
-	ErrIgnored = errors.New("ignored")
+	ErrIgnored  = errors.New("ignored")
+	ErrFiltered = errors.New("filtered")
+	ErrVanished = errors.New("vanished")
 )
 
+// vanishedGameErrors are substrings of errors returned when loading a game that no longer exists,
+// such as a game removed by a reorg or cleanup between listing and loading it.
+// Other reverts, such as from an ABI mismatch after an upgrade, are failures to load the game.
+var vanishedGameErrors = []string{"no such game", "no contract code"}
+
 type (
 	CreateGameCaller   func(ctx context.Context, game gameTypes.GameMetadata) (GameCaller, error)
 	FactoryGameFetcher func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]gameTypes.GameMetadata, error)
+	// GameRetainer is called with the games listed by the factories each cycle so per-game caches can evict the rest.
+	GameRetainer func(games []gameTypes.GameMetadata)
 )
 
+// GameSource loads the games created by a single dispute game factory.
+type GameSource struct {
+	Factory    common.Address
+	FetchGames FactoryGameFetcher
+
+	// L2ChainID is the chain ID of the L2 chain the factory's games dispute.
+	// Zero if the games dispute the chain of the primary rollup node.
+	L2ChainID uint64
+
+	// L1ChainID is the chain ID of the L1 chain the factory is deployed on.
+	L1ChainID uint64
+}
+
+type ExtractorMetrics interface {
+	RecordFilteredGames(count int)
+	RecordVanishedGames(count int)
+	RecordSampledGames(processed, total int)
+	RecordGameLatencyPercentiles(p50, p90, p99 time.Duration)
+	RecordImplausibleBlockGames(count int)
+}
+
+// GameFilter restricts monitoring to games matching the specified criteria.
+type GameFilter struct {
+	GameTypes []uint32 // Game types to monitor. Empty to monitor all game types.
+	MinBond   *big.Int // Minimum bond posted on the root claim. nil to monitor games regardless of bond.
+
+	// Statuses are the game statuses to monitor. Empty to monitor games regardless of status.
+	Statuses []gameTypes.GameStatus
+
+	// SampleRate is the fraction of games to monitor. Games are selected by the hash of their address
+	// so the same games are sampled every cycle. 0 or 1 to monitor all games.
+	SampleRate float64
+}
+
 type Enricher interface {
 	Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error
 }
 
 type Extractor struct {
-	logger         log.Logger
-	createContract CreateGameCaller
-	fetchGames     FactoryGameFetcher
-	maxConcurrency int
-	enrichers      []Enricher
-	ignoredGames   map[common.Address]bool
+	logger          log.Logger
+	metrics         ExtractorMetrics
+	clock           RClock
+	createContract  CreateGameCaller
+	sources         []GameSource
+	maxConcurrency  int
+	metadataTimeout time.Duration
+	maxL2Block      uint64
+	enrichers       []Enricher
+	ignoredGames    map[common.Address]bool
+	gameTypes       map[uint32]bool
+	statuses        map[gameTypes.GameStatus]bool
+	minBond         *big.Int
+	sampleRate      float64
+	retainers       []GameRetainer
 }
 
-func NewExtractor(logger log.Logger, creator CreateGameCaller, fetchGames FactoryGameFetcher, ignoredGames []common.Address, maxConcurrency uint, enrichers ...Enricher) *Extractor {
+func NewExtractor(logger log.Logger, m ExtractorMetrics, clock RClock, creator CreateGameCaller, sources []GameSource, ignoredGames []common.Address, filter GameFilter, maxConcurrency uint, metadataTimeout time.Duration, maxL2Block uint64, enrichers ...Enricher) *Extractor {
 	ignored := make(map[common.Address]bool)
 	for _, game := range ignoredGames {
 		ignored[game] = true
 	}
+	statuses := make(map[gameTypes.GameStatus]bool)
+	for _, status := range filter.Statuses {
+		statuses[status] = true
+	}
+	gameTypes := make(map[uint32]bool)
+	for _, gameType := range filter.GameTypes {
+		gameTypes[gameType] = true
+	}
 	return &Extractor{
-		logger:         logger,
-		createContract: creator,
-		fetchGames:     fetchGames,
-		maxConcurrency: int(maxConcurrency),
-		enrichers:      enrichers,
-		ignoredGames:   ignored,
+		logger:          logger,
+		metrics:         m,
+		clock:           clock,
+		createContract:  creator,
+		sources:         sources,
+		maxConcurrency:  int(maxConcurrency),
+		metadataTimeout: metadataTimeout,
+		maxL2Block:      maxL2Block,
+		enrichers:       enrichers,
+		ignoredGames:    ignored,
+		gameTypes:       gameTypes,
+		statuses:        statuses,
+		minBond:         filter.MinBond,
+		sampleRate:      filter.SampleRate,
 	}
 }
 
+// AddRetainer registers retain to be called with the games listed by the factories once each cycle's games are loaded.
+func (e *Extractor) AddRetainer(retain GameRetainer) {
+	e.retainers = append(e.retainers, retain)
+}
+
 func (e *Extractor) Extract(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*monTypes.EnrichedGameData, int, int, error) {
-	games, err := e.fetchGames(ctx, blockHash, minTimestamp)
+	return e.ExtractRange(ctx, blockHash, minTimestamp, math.MaxUint64)
+}
+
+// ExtractRange loads the games created at or after minTimestamp and before maxTimestamp.
+func (e *Extractor) ExtractRange(ctx context.Context, blockHash common.Hash, minTimestamp uint64, maxTimestamp uint64) ([]*monTypes.EnrichedGameData, int, int, error) {
+	games, err := e.listGames(ctx, blockHash, minTimestamp, maxTimestamp)
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("failed to load games: %w", err)
+		return nil, 0, 0, err
 	}
-	enriched, ignored, failed := e.enrichGames(ctx, blockHash, games)
+	enriched, ignored, failed := e.enrichGames(ctx, blockHash, e.sample(games))
+	e.retainGames(games)
 	return enriched, ignored, failed, nil
 }
 
-func (e *Extractor) enrichGames(ctx context.Context, blockHash common.Hash, games []gameTypes.GameMetadata) ([]*monTypes.EnrichedGameData, int, int) {
+// retainGames calls each retainer with the listed games, including those not loaded because they were filtered,
+// sampled out or failed, so their cached data is kept for the next cycle.
+func (e *Extractor) retainGames(games []factoryGame) {
+	if len(e.retainers) == 0 {
+		return
+	}
+	listed := make([]gameTypes.GameMetadata, 0, len(games))
+	for _, game := range games {
+		listed = append(listed, game.GameMetadata)
+	}
+	for _, retain := range e.retainers {
+		retain(listed)
+	}
+}
+
+// listGames lists the games from all factories created at or after minTimestamp and before maxTimestamp.
+func (e *Extractor) listGames(ctx context.Context, blockHash common.Hash, minTimestamp uint64, maxTimestamp uint64) ([]factoryGame, error) {
+	var games []factoryGame
+	for _, source := range e.sources {
+		sourceGames, err := source.FetchGames(ctx, blockHash, minTimestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load games from factory %v: %w", source.Factory, err)
+		}
+		for _, game := range sourceGames {
+			if game.Timestamp >= maxTimestamp {
+				continue
+			}
+			games = append(games, factoryGame{factory: source.Factory, l2ChainID: source.L2ChainID, l1ChainID: source.L1ChainID, GameMetadata: game})
+		}
+	}
+	return games, nil
+}
+
+// sample returns the subset of games selected by the sample rate.
+func (e *Extractor) sample(games []factoryGame) []factoryGame {
+	if e.sampleRate <= 0 || e.sampleRate >= 1 {
+		e.metrics.RecordSampledGames(len(games), len(games))
+		return games
+	}
+	sampled := make([]factoryGame, 0, len(games))
+	for _, game := range games {
+		if isSampled(game.Proxy, e.sampleRate) {
+			sampled = append(sampled, game)
+		}
+	}
+	e.metrics.RecordSampledGames(len(sampled), len(games))
+	return sampled
+}
+
+// isSampled deterministically selects approximately rate of all game addresses.
+func isSampled(game common.Address, rate float64) bool {
+	hash := crypto.Keccak256Hash(game[:])
+	return float64(binary.BigEndian.Uint64(hash[:8]))/math.MaxUint64 < rate
+}
+
+// factoryGame is a game tagged with the factory it was loaded from.
+type factoryGame struct {
+	gameTypes.GameMetadata
+	factory   common.Address
+	l2ChainID uint64
+	l1ChainID uint64
+}
+
+// ExtractEach loads the games created at or after minTimestamp, calling handle with each game as soon as it is loaded
+// rather than collecting them so callers need not hold every game in memory. handle is called from a single goroutine.
+// Returns the number of ignored and failed games.
+func (e *Extractor) ExtractEach(ctx context.Context, blockHash common.Hash, minTimestamp uint64, handle func(game *monTypes.EnrichedGameData)) (int, int, error) {
+	games, err := e.listGames(ctx, blockHash, minTimestamp, math.MaxUint64)
+	if err != nil {
+		return 0, 0, err
+	}
+	ignored, failed := e.streamGames(ctx, blockHash, e.sample(games), handle)
+	e.retainGames(games)
+	return ignored, failed, nil
+}
+
+func (e *Extractor) enrichGames(ctx context.Context, blockHash common.Hash, games []factoryGame) ([]*monTypes.EnrichedGameData, int, int) {
 	var enrichedGames []*monTypes.EnrichedGameData
+	ignored, failed := e.streamGames(ctx, blockHash, games, func(game *monTypes.EnrichedGameData) {
+		enrichedGames = append(enrichedGames, game)
+	})
+	return enrichedGames, ignored, failed
+}
+
+// streamGames enriches games concurrently, calling handle from a single goroutine with each game once it is enriched.
+func (e *Extractor) streamGames(ctx context.Context, blockHash common.Hash, games []factoryGame, handle func(game *monTypes.EnrichedGameData)) (int, int) {
+	var enriched atomic.Int32
 	var ignored atomic.Int32
+	var filtered atomic.Int32
+	var vanished atomic.Int32
+	var implausible atomic.Int32
 	var failed atomic.Int32
 
 	var wg sync.WaitGroup
 	wg.Add(e.maxConcurrency)
-	gameCh := make(chan gameTypes.GameMetadata, e.maxConcurrency)
-	// Create a channel for enriched games. Must have enough capacity to hold all games.
-	enrichedCh := make(chan *monTypes.EnrichedGameData, len(games))
+	gameCh := make(chan factoryGame, e.maxConcurrency)
+	// Enriched games are handled as they arrive so the channel only needs to buffer the in-flight games
+	enrichedCh := make(chan *monTypes.EnrichedGameData, e.maxConcurrency)
+	latencyCh := make(chan time.Duration, len(games))
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+		for enrichedGame := range enrichedCh {
+			enriched.Add(1)
+			handle(enrichedGame)
+		}
+	}()
 	// Spin up multiple goroutines to enrich game data
 	for i := 0; i < e.maxConcurrency; i++ {
 		go func() {
@@ -85,48 +268,118 @@ func (e *Extractor) enrichGames(ctx context.Context, blockHash common.Hash, game
 						return
 					}
 					e.logger.Trace("Enriching game", "game", game.Proxy)
+					start := e.clock.Now()
 					enrichedGame, err := e.enrichGame(ctx, blockHash, game)
 					if errors.Is(err, ErrIgnored) {
 						ignored.Add(1)
 						e.logger.Warn("Ignoring game", "game", game.Proxy)
 						continue
+					} else if errors.Is(err, ErrFiltered) {
+						filtered.Add(1)
+						e.logger.Debug("Filtered game", "game", game.Proxy, "gameType", game.GameType)
+						continue
+					} else if errors.Is(err, ErrVanished) {
+						vanished.Add(1)
+						e.logger.Debug("Game no longer available", "game", game.Proxy, "err", err)
+						continue
 					} else if err != nil {
 						failed.Add(1)
 						e.logger.Error("Failed to fetch game data", "game", game.Proxy, "err", err)
 						continue
 					}
+					if enrichedGame.ImplausibleBlock {
+						implausible.Add(1)
+					}
 					enrichedCh <- enrichedGame
+					latencyCh <- e.clock.Now().Sub(start)
 				}
 			}
 		}()
 	}
 
 	// Push each game into the channel
+pushGames:
 	for _, game := range games {
-		gameCh <- game
+		select {
+		case gameCh <- game:
+		case <-ctx.Done():
+			break pushGames
+		}
 	}
 	close(gameCh)
 	// Wait for games to finish being enriched then close enrichedCh since no future results will be published
 	wg.Wait()
 	close(enrichedCh)
+	close(latencyCh)
+	<-handled
+
+	var latencies []time.Duration
+	for latency := range latencyCh {
+		latencies = append(latencies, latency)
+	}
+	if ctx.Err() != nil {
+		processed := int(enriched.Load() + ignored.Load() + filtered.Load() + vanished.Load() + failed.Load())
+		e.logger.Warn("Enriching cancelled, only partial game data is available", "err", ctx.Err(), "processed", processed, "unprocessed", len(games)-processed)
+	}
+	e.metrics.RecordGameLatencyPercentiles(percentile(latencies, 0.5), percentile(latencies, 0.9), percentile(latencies, 0.99))
+	e.metrics.RecordFilteredGames(int(filtered.Load()))
+	e.metrics.RecordVanishedGames(int(vanished.Load()))
+	e.metrics.RecordImplausibleBlockGames(int(implausible.Load()))
+	return int(ignored.Load()), int(failed.Load())
+}
 
-	// Read the results
-	for enrichedGame := range enrichedCh {
-		enrichedGames = append(enrichedGames, enrichedGame)
+// percentile returns the p-th percentile of latencies using the nearest-rank method.
+// Returns 0 if there are no latencies.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
 	}
-	return enrichedGames, int(ignored.Load()), int(failed.Load())
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
 }
 
-func (e *Extractor) enrichGame(ctx context.Context, blockHash common.Hash, game gameTypes.GameMetadata) (*monTypes.EnrichedGameData, error) {
+func (e *Extractor) enrichGame(ctx context.Context, blockHash common.Hash, game factoryGame) (*monTypes.EnrichedGameData, error) {
 	if e.ignoredGames[game.Proxy] {
 		return nil, ErrIgnored
 	}
-	caller, err := e.createContract(ctx, game)
+	// Filter by game type before creating contracts so filtered games incur no RPC calls
+	if len(e.gameTypes) > 0 && !e.gameTypes[game.GameType] {
+		return nil, ErrFiltered
+	}
+	caller, err := e.createContract(ctx, game.GameMetadata)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create contracts: %w", err)
 	}
-	meta, err := caller.GetGameMetadata(ctx, rpcblock.ByHash(blockHash))
+	enrichedGame, err := e.fetchGameMetadata(ctx, blockHash, caller, game.GameMetadata)
 	if err != nil {
+		return nil, err
+	}
+	enrichedGame.Factory = game.factory
+	enrichedGame.L2ChainID = game.l2ChainID
+	enrichedGame.L1ChainID = game.l1ChainID
+	// The status and bond are only known once metadata is loaded but games are still filtered before the expensive enrichers
+	if len(e.statuses) > 0 && !e.statuses[enrichedGame.Status] {
+		return nil, ErrFiltered
+	}
+	if !e.hasMinBond(enrichedGame) {
+		return nil, ErrFiltered
+	}
+	if err := e.applyEnrichers(ctx, blockHash, caller, enrichedGame); err != nil {
+		return nil, fmt.Errorf("failed to enrich game: %w", err)
+	}
+	return enrichedGame, nil
+}
+
+// fetchGameMetadata loads the game's metadata and claims, applying the metadata timeout if configured.
+func (e *Extractor) fetchGameMetadata(ctx context.Context, blockHash common.Hash, caller GameCaller, game gameTypes.GameMetadata) (*monTypes.EnrichedGameData, error) {
+	ctx, cancel := withTimeout(ctx, e.metadataTimeout)
+	defer cancel()
+	meta, err := caller.GetGameMetadata(ctx, rpcblock.ByHash(blockHash))
+	if err != nil && isVanishedGame(err) {
+		return nil, fmt.Errorf("%w: failed to fetch game metadata: %w", ErrVanished, err)
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to fetch game metadata: %w", err)
 	}
 	claims, err := caller.GetAllClaims(ctx, rpcblock.ByHash(blockHash))
@@ -148,12 +401,38 @@ func (e *Extractor) enrichGame(ctx context.Context, blockHash common.Hash, game
 		BlockNumberChallenger: meta.L2BlockNumberChallenger,
 		Claims:                enrichedClaims,
 	}
-	if err := e.applyEnrichers(ctx, blockHash, caller, enrichedGame); err != nil {
-		return nil, fmt.Errorf("failed to enrich game: %w", err)
+	if !e.isPlausibleBlock(meta.L2BlockNum) {
+		e.logger.Warn("Game disputes an implausible L2 block, not classifying agreement", "game", game.Proxy, "l2BlockNum", meta.L2BlockNum, "max", e.maxL2Block)
+		enrichedGame.ImplausibleBlock = true
 	}
 	return enrichedGame, nil
 }
 
+// isPlausibleBlock returns false if a game can't genuinely dispute the L2 block, indicating a decoding bug or a
+// malicious game contract. Genesis can't be disputed so block 0 is never plausible.
+func (e *Extractor) isPlausibleBlock(l2BlockNum uint64) bool {
+	return l2BlockNum != 0 && (e.maxL2Block == 0 || l2BlockNum <= e.maxL2Block)
+}
+
+func isVanishedGame(err error) bool {
+	for _, msg := range vanishedGameErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Extractor) hasMinBond(game *monTypes.EnrichedGameData) bool {
+	if e.minBond == nil {
+		return true
+	}
+	if len(game.Claims) == 0 || game.Claims[0].Bond == nil {
+		return e.minBond.Sign() <= 0
+	}
+	return game.Claims[0].Bond.Cmp(e.minBond) >= 0
+}
+
 func (e *Extractor) applyEnrichers(ctx context.Context, blockHash common.Hash, caller GameCaller, game *monTypes.EnrichedGameData) error {
 	for _, enricher := range e.enrichers {
 		if err := enricher.Enrich(ctx, rpcblock.ByHash(blockHash), caller, game); err != nil {
@@ -162,3 +441,12 @@ func (e *Extractor) applyEnrichers(ctx context.Context, blockHash common.Hash, c
 	}
 	return nil
 }
+
+// withTimeout returns a child context with the specified timeout applied.
+// A timeout of 0 disables the timeout, returning a child context that is only cancelled with its parent.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -3,6 +3,7 @@ package extract
 import (
 	"context"
 	"errors"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -21,6 +23,9 @@ import (
 
 var (
 	mockRootClaim = common.HexToHash("0x1234")
+	mockFactory   = common.HexToAddress("0xfac0")
+	mockL2Block   = uint64(100)
+	maxL2Block    = uint64(1_000_000)
 	ignoredGames  = []common.Address{common.HexToAddress("0xdeadbeef")}
 )
 
@@ -65,6 +70,45 @@ func TestExtractor_Extract(t *testing.T) {
 		verifyLogs(t, logs, 0, 1, 0, 0)
 	})
 
+	t.Run("MetadataFetchVanishedGame", func(t *testing.T) {
+		extractor, creator, games, logs, metrics := setupFilterTest(t)
+		games.games = []gameTypes.GameMetadata{{}}
+		creator.caller.metadataErr = errors.New("execution reverted: no such game")
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 0)
+		require.Equal(t, 1, metrics.vanished)
+		require.Equal(t, 0, creator.caller.claimsCalls)
+		verifyLogs(t, logs, 0, 0, 0, 0)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelDebug), testlog.NewMessageFilter("Game no longer available"))
+		require.NotNil(t, l)
+	})
+
+	t.Run("MetadataFetchNoContractCode", func(t *testing.T) {
+		extractor, creator, games, _, metrics := setupFilterTest(t)
+		games.games = []gameTypes.GameMetadata{{}}
+		creator.caller.metadataErr = errors.New("no contract code at given address")
+		_, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Equal(t, 1, metrics.vanished)
+	})
+
+	t.Run("MetadataFetchRevertIsFailure", func(t *testing.T) {
+		extractor, creator, games, logs, metrics := setupFilterTest(t)
+		games.games = []gameTypes.GameMetadata{{}}
+		creator.caller.metadataErr = errors.New("execution reverted")
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Equal(t, 1, failed)
+		require.Len(t, enriched, 0)
+		require.Zero(t, metrics.vanished)
+		verifyLogs(t, logs, 0, 1, 0, 0)
+	})
+
 	t.Run("ClaimsFetchErrorLog", func(t *testing.T) {
 		extractor, creator, games, logs := setupExtractorTest(t)
 		games.games = []gameTypes.GameMetadata{{}}
@@ -152,6 +196,302 @@ func TestExtractor_Extract(t *testing.T) {
 			testlog.NewMessageFilter("Ignoring game"),
 			testlog.NewAttributesFilter("game", ignoredGames[0].Hex())))
 	})
+	t.Run("MultipleFactories", func(t *testing.T) {
+		extractor, _, games, _ := setupExtractorTest(t)
+		games.games = []gameTypes.GameMetadata{{Proxy: common.Address{0xaa}}}
+		otherFactory := common.Address{0xfa}
+		otherGames := &mockGameFetcher{games: []gameTypes.GameMetadata{{Proxy: common.Address{0xbb}}}}
+		extractor.sources = append(extractor.sources, GameSource{Factory: otherFactory, FetchGames: otherGames.FetchGames, L2ChainID: 42, L1ChainID: 11155111})
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 2)
+		require.Equal(t, 1, games.calls)
+		require.Equal(t, 1, otherGames.calls)
+		factories := make(map[common.Address]common.Address)
+		chains := make(map[common.Address]uint64)
+		l1Chains := make(map[common.Address]uint64)
+		for _, game := range enriched {
+			factories[game.Proxy] = game.Factory
+			chains[game.Proxy] = game.L2ChainID
+			l1Chains[game.Proxy] = game.L1ChainID
+		}
+		require.Equal(t, mockFactory, factories[common.Address{0xaa}])
+		require.Equal(t, otherFactory, factories[common.Address{0xbb}])
+		require.Zero(t, chains[common.Address{0xaa}])
+		require.Equal(t, uint64(42), chains[common.Address{0xbb}])
+		require.Equal(t, uint64(11155111), l1Chains[common.Address{0xbb}])
+	})
+
+	t.Run("ExtractRangeExcludesNewerGames", func(t *testing.T) {
+		extractor, _, games, _ := setupExtractorTest(t)
+		games.games = []gameTypes.GameMetadata{
+			{Proxy: common.Address{0xaa}, Timestamp: 10},
+			{Proxy: common.Address{0xbb}, Timestamp: 20},
+			{Proxy: common.Address{0xcc}, Timestamp: 30},
+		}
+		enriched, ignored, failed, err := extractor.ExtractRange(context.Background(), common.Hash{}, 0, 20)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 1)
+		require.Equal(t, common.Address{0xaa}, enriched[0].Proxy)
+	})
+
+	t.Run("RetainsListedGames", func(t *testing.T) {
+		extractor, creator, games, _ := setupExtractorTest(t)
+		extractor.gameTypes = map[uint32]bool{0: true}
+		games.games = []gameTypes.GameMetadata{
+			{GameType: 0, Proxy: common.Address{0xaa}},
+			{GameType: 1, Proxy: common.Address{0xbb}},
+			{GameType: 0, Proxy: ignoredGames[0]},
+		}
+		creator.caller.metadataErr = errors.New("boom")
+		var retained []gameTypes.GameMetadata
+		extractor.AddRetainer(func(games []gameTypes.GameMetadata) {
+			retained = games
+		})
+		enriched, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Empty(t, enriched)
+		require.Equal(t, 1, failed)
+		require.Equal(t, games.games, retained, "should retain filtered, ignored and failed games")
+	})
+
+	t.Run("ExtractEachHandlesEachGame", func(t *testing.T) {
+		extractor, creator, games, _ := setupExtractorTest(t)
+		extractor.gameTypes = map[uint32]bool{0: true}
+		games.games = []gameTypes.GameMetadata{
+			{GameType: 0, Proxy: common.Address{0xaa}},
+			{GameType: 1, Proxy: common.Address{0xbb}},
+			{GameType: 0, Proxy: common.Address{0xcc}},
+			{GameType: 0, Proxy: ignoredGames[0]},
+		}
+		var retained []gameTypes.GameMetadata
+		extractor.AddRetainer(func(games []gameTypes.GameMetadata) {
+			retained = games
+		})
+		var handled []common.Address
+		ignored, failed, err := extractor.ExtractEach(context.Background(), common.Hash{}, 0, func(game *monTypes.EnrichedGameData) {
+			handled = append(handled, game.Proxy)
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, ignored)
+		require.Zero(t, failed)
+		require.ElementsMatch(t, []common.Address{{0xaa}, {0xcc}}, handled)
+		require.Equal(t, 2, creator.calls)
+		require.Equal(t, games.games, retained)
+	})
+
+	t.Run("FetchGamesErrorFromAdditionalFactory", func(t *testing.T) {
+		extractor, _, games, _ := setupExtractorTest(t)
+		games.games = []gameTypes.GameMetadata{{}}
+		otherGames := &mockGameFetcher{err: errors.New("boom")}
+		extractor.sources = append(extractor.sources, GameSource{Factory: common.Address{0xfa}, FetchGames: otherGames.FetchGames})
+		_, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.ErrorIs(t, err, otherGames.err)
+	})
+}
+
+func TestExtractor_ExtractCancelled(t *testing.T) {
+	extractor, creator, games, _ := setupExtractorTest(t)
+	// More games than the workers and channel buffer can hold so publishing would block if not cancelled
+	for i := 0; i < 100; i++ {
+		games.games = append(games.games, gameTypes.GameMetadata{Proxy: common.Address{byte(i)}})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	enriched, _, _, err := extractor.Extract(ctx, common.Hash{}, 0)
+	require.NoError(t, err)
+	require.Less(t, len(enriched), len(games.games))
+	require.Less(t, creator.calls, len(games.games))
+}
+
+func TestExtractor_MetadataTimeout(t *testing.T) {
+	t.Run("TimesOut", func(t *testing.T) {
+		enricher := &mockEnricher{}
+		extractor, creator, games, logs := setupExtractorTest(t, enricher)
+		extractor.metadataTimeout = 10 * time.Millisecond
+		creator.caller.metadataBlocks = true
+		games.games = []gameTypes.GameMetadata{{}}
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Equal(t, 1, failed)
+		require.Len(t, enriched, 0)
+		require.Zero(t, enricher.calls)
+		l := logs.FindLog(
+			testlog.NewLevelFilter(log.LevelError),
+			testlog.NewAttributesContainsFilter("err", "failed to fetch game metadata"))
+		require.NotNil(t, l)
+		require.ErrorIs(t, l.AttrValue("err").(error), context.DeadlineExceeded)
+	})
+
+	t.Run("NotAppliedToEnrichers", func(t *testing.T) {
+		enricher := &mockEnricher{}
+		extractor, _, games, _ := setupExtractorTest(t, enricher)
+		extractor.metadataTimeout = time.Minute
+		games.games = []gameTypes.GameMetadata{{}}
+		enriched, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 1)
+		require.Equal(t, 1, enricher.calls)
+		require.False(t, enricher.hasDeadline)
+	})
+}
+
+func TestExtractor_Filter(t *testing.T) {
+	newAgreementEnricher := func(t *testing.T) (*AgreementEnricher, *blockingRollupClient) {
+		client := &blockingRollupClient{release: make(chan struct{})}
+		close(client.release)
+		return NewAgreementEnricher(testlog.Logger(t, log.LvlInfo), &concurrentOutputMetrics{}, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, monTypes.AgreementHeadSafe, nil, nil, nil), client
+	}
+
+	t.Run("NoFilter", func(t *testing.T) {
+		enricher, client := newAgreementEnricher(t)
+		extractor, _, games, _, metrics := setupFilterTest(t, enricher)
+		games.games = []gameTypes.GameMetadata{{GameType: 0}, {GameType: 1}}
+		enriched, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 2)
+		require.EqualValues(t, 2, client.outputCalls.Load())
+		require.Zero(t, metrics.filtered)
+	})
+
+	t.Run("GameType", func(t *testing.T) {
+		enricher, client := newAgreementEnricher(t)
+		extractor, creator, games, logs, metrics := setupFilterTest(t, enricher)
+		extractor.gameTypes = map[uint32]bool{0: true, 2: true}
+		games.games = []gameTypes.GameMetadata{
+			{GameType: 0, Proxy: common.Address{0xaa}},
+			{GameType: 1, Proxy: common.Address{0xbb}},
+			{GameType: 2, Proxy: common.Address{0xcc}},
+		}
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 2)
+		for _, game := range enriched {
+			require.NotEqual(t, uint32(1), game.GameType)
+		}
+		require.Equal(t, 2, creator.calls, "Should not create contracts for filtered games")
+		require.EqualValues(t, 2, client.outputCalls.Load(), "Filtered games should not be compared")
+		require.Equal(t, 1, metrics.filtered)
+		require.NotNil(t, logs.FindLog(
+			testlog.NewLevelFilter(log.LevelDebug),
+			testlog.NewMessageFilter("Filtered game"),
+			testlog.NewAttributesFilter("game", common.Address{0xbb}.Hex())))
+	})
+
+	t.Run("MinBond", func(t *testing.T) {
+		enricher, client := newAgreementEnricher(t)
+		extractor, creator, games, _, metrics := setupFilterTest(t, enricher)
+		extractor.minBond = big.NewInt(100)
+		creator.caller.claims = []faultTypes.Claim{{ClaimData: faultTypes.ClaimData{Bond: big.NewInt(99)}}}
+		games.games = []gameTypes.GameMetadata{{}, {}}
+		enriched, _, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Empty(t, enriched)
+		require.Zero(t, client.outputCalls.Load(), "Filtered games should not be compared")
+		require.Equal(t, 2, metrics.filtered)
+
+		creator.caller.claims = []faultTypes.Claim{{ClaimData: faultTypes.ClaimData{Bond: big.NewInt(100)}}}
+		enriched, _, failed, err = extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 2)
+		require.EqualValues(t, 2, client.outputCalls.Load())
+		require.Zero(t, metrics.filtered)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		enricher, client := newAgreementEnricher(t)
+		extractor, creator, games, _, metrics := setupFilterTest(t, enricher)
+		extractor.statuses = map[gameTypes.GameStatus]bool{gameTypes.GameStatusInProgress: true}
+		games.games = []gameTypes.GameMetadata{{}, {}}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, ignored)
+		require.Zero(t, failed)
+		require.Empty(t, enriched)
+		require.Zero(t, client.outputCalls.Load(), "Filtered games should not be compared")
+		require.Equal(t, 2, metrics.filtered)
+
+		creator.caller.status = gameTypes.GameStatusInProgress
+		enriched, _, failed, err = extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+		require.Len(t, enriched, 2)
+		require.EqualValues(t, 2, client.outputCalls.Load())
+		require.Zero(t, metrics.filtered)
+	})
+
+	t.Run("MinBondNoClaims", func(t *testing.T) {
+		enricher, client := newAgreementEnricher(t)
+		extractor, _, games, _, metrics := setupFilterTest(t, enricher)
+		extractor.minBond = big.NewInt(1)
+		games.games = []gameTypes.GameMetadata{{}}
+		enriched, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Empty(t, enriched)
+		require.Zero(t, client.outputCalls.Load())
+		require.Equal(t, 1, metrics.filtered)
+	})
+}
+
+func TestExtractor_ImplausibleBlock(t *testing.T) {
+	newAgreementEnricher := func(t *testing.T) (*AgreementEnricher, *blockingRollupClient) {
+		client := &blockingRollupClient{release: make(chan struct{})}
+		close(client.release)
+		return NewAgreementEnricher(testlog.Logger(t, log.LvlInfo), &concurrentOutputMetrics{}, clock.NewDeterministicClock(time.Unix(1000, 0)), client, 0, monTypes.AgreementHeadSafe, nil, nil, nil), client
+	}
+
+	tests := []struct {
+		name        string
+		l2BlockNum  uint64
+		implausible bool
+	}{
+		{name: "Plausible", l2BlockNum: mockL2Block},
+		{name: "MaxBlock", l2BlockNum: maxL2Block},
+		{name: "BlockZero", l2BlockNum: 0, implausible: true},
+		{name: "BeyondMaxBlock", l2BlockNum: maxL2Block + 1, implausible: true},
+		{name: "WildlyLargeBlock", l2BlockNum: math.MaxUint64, implausible: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			enricher, client := newAgreementEnricher(t)
+			extractor, creator, games, logs, metrics := setupFilterTest(t, enricher)
+			creator.caller.l2BlockNum = test.l2BlockNum
+			games.games = []gameTypes.GameMetadata{{Proxy: common.Address{0xaa}}}
+			enriched, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+			require.NoError(t, err)
+			require.Zero(t, ignored)
+			require.Zero(t, failed)
+			require.Len(t, enriched, 1)
+			require.Equal(t, test.implausible, enriched[0].ImplausibleBlock)
+			levelFilter := testlog.NewLevelFilter(log.LevelWarn)
+			msgFilter := testlog.NewMessageFilter("Game disputes an implausible L2 block, not classifying agreement")
+			if test.implausible {
+				require.Equal(t, 1, metrics.implausible)
+				require.True(t, enriched[0].Indeterminate)
+				require.False(t, enriched[0].AgreeWithClaim)
+				require.Zero(t, client.outputCalls.Load(), "should not compare against the rollup node")
+				require.NotNil(t, logs.FindLog(levelFilter, msgFilter))
+			} else {
+				require.Zero(t, metrics.implausible)
+				require.False(t, enriched[0].Indeterminate)
+				require.EqualValues(t, 1, client.outputCalls.Load())
+				require.Nil(t, logs.FindLog(levelFilter, msgFilter))
+			}
+		})
+	}
 }
 
 func verifyLogs(t *testing.T, logs *testlog.CapturingHandler, createErr, metadataErr, claimsErr, durationErr int) {
@@ -170,20 +510,153 @@ func verifyLogs(t *testing.T, logs *testlog.CapturingHandler, createErr, metadat
 	require.Len(t, l, durationErr)
 }
 
+func TestExtractor_Sample(t *testing.T) {
+	newGames := func(count int) []gameTypes.GameMetadata {
+		games := make([]gameTypes.GameMetadata, count)
+		for i := range games {
+			games[i] = gameTypes.GameMetadata{Proxy: common.BigToAddress(big.NewInt(int64(i + 1)))}
+		}
+		return games
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		extractor, _, games, _, metrics := setupFilterTest(t)
+		games.games = newGames(10)
+		enriched, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Len(t, enriched, 10)
+		require.Equal(t, 10, metrics.sampledGames)
+		require.Equal(t, 10, metrics.sampledOfTotal)
+	})
+
+	t.Run("ApproximatesRate", func(t *testing.T) {
+		extractor, _, games, _, metrics := setupFilterTest(t)
+		extractor.sampleRate = 0.25
+		games.games = newGames(2000)
+		enriched, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2000, metrics.sampledOfTotal)
+		require.Len(t, enriched, metrics.sampledGames)
+		require.InDelta(t, 500, metrics.sampledGames, 50)
+	})
+
+	t.Run("Stable", func(t *testing.T) {
+		extractor, _, games, _, _ := setupFilterTest(t)
+		extractor.sampleRate = 0.5
+		games.games = newGames(100)
+		sampledProxies := func() map[common.Address]bool {
+			enriched, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+			require.NoError(t, err)
+			proxies := make(map[common.Address]bool)
+			for _, game := range enriched {
+				proxies[game.Proxy] = true
+			}
+			return proxies
+		}
+		first := sampledProxies()
+		require.NotEmpty(t, first)
+		require.Equal(t, first, sampledProxies())
+	})
+}
+
+func TestExtractor_GameLatencyPercentiles(t *testing.T) {
+	t.Run("NoGames", func(t *testing.T) {
+		extractor, _, _, _, metrics := setupFilterTest(t)
+		_, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []time.Duration{0, 0, 0}, metrics.latencies)
+	})
+
+	t.Run("KnownDurations", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		// Game i takes i seconds to enrich
+		enricher := &advancingEnricher{clock: cl}
+		extractor, _, games, _, metrics := setupFilterTest(t, enricher)
+		extractor.clock = cl
+		// Run serially so the clock is only advanced by one game at a time
+		extractor.maxConcurrency = 1
+		for i := 1; i <= 100; i++ {
+			games.games = append(games.games, gameTypes.GameMetadata{Proxy: common.BigToAddress(big.NewInt(int64(i)))})
+		}
+		enriched, _, _, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Len(t, enriched, 100)
+		require.Equal(t, []time.Duration{50 * time.Second, 90 * time.Second, 99 * time.Second}, metrics.latencies)
+	})
+
+	t.Run("Percentile", func(t *testing.T) {
+		latencies := []time.Duration{5 * time.Second, time.Second, 3 * time.Second}
+		require.Equal(t, 3*time.Second, percentile(latencies, 0.5))
+		require.Equal(t, 5*time.Second, percentile(latencies, 0.99))
+		require.Equal(t, time.Second, percentile(latencies, 0))
+		require.Equal(t, []time.Duration{5 * time.Second, time.Second, 3 * time.Second}, latencies, "should not reorder input")
+	})
+}
+
+type advancingEnricher struct {
+	clock *clock.DeterministicClock
+}
+
+func (a *advancingEnricher) Enrich(_ context.Context, _ rpcblock.Block, _ GameCaller, game *monTypes.EnrichedGameData) error {
+	a.clock.AdvanceTime(time.Duration(new(big.Int).SetBytes(game.Proxy[:]).Int64()) * time.Second)
+	return nil
+}
+
 func setupExtractorTest(t *testing.T, enrichers ...Enricher) (*Extractor, *mockGameCallerCreator, *mockGameFetcher, *testlog.CapturingHandler) {
+	extractor, creator, games, capturedLogs, _ := setupFilterTest(t, enrichers...)
+	return extractor, creator, games, capturedLogs
+}
+
+func setupFilterTest(t *testing.T, enrichers ...Enricher) (*Extractor, *mockGameCallerCreator, *mockGameFetcher, *testlog.CapturingHandler, *stubExtractorMetrics) {
 	logger, capturedLogs := testlog.CaptureLogger(t, log.LvlDebug)
+	metrics := &stubExtractorMetrics{}
 	games := &mockGameFetcher{}
-	caller := &mockGameCaller{rootClaim: mockRootClaim}
+	caller := &mockGameCaller{rootClaim: mockRootClaim, l2BlockNum: mockL2Block}
 	creator := &mockGameCallerCreator{caller: caller}
 	extractor := NewExtractor(
 		logger,
+		metrics,
+		clock.NewDeterministicClock(time.Unix(1000, 0)),
 		creator.CreateGameCaller,
-		games.FetchGames,
+		[]GameSource{{Factory: mockFactory, FetchGames: games.FetchGames}},
 		ignoredGames,
+		GameFilter{},
 		5,
+		0,
+		maxL2Block,
 		enrichers...,
 	)
-	return extractor, creator, games, capturedLogs
+	return extractor, creator, games, capturedLogs, metrics
+}
+
+type stubExtractorMetrics struct {
+	filtered       int
+	vanished       int
+	implausible    int
+	sampledGames   int
+	sampledOfTotal int
+	latencies      []time.Duration
+}
+
+func (s *stubExtractorMetrics) RecordGameLatencyPercentiles(p50, p90, p99 time.Duration) {
+	s.latencies = []time.Duration{p50, p90, p99}
+}
+
+func (s *stubExtractorMetrics) RecordFilteredGames(count int) {
+	s.filtered = count
+}
+
+func (s *stubExtractorMetrics) RecordVanishedGames(count int) {
+	s.vanished = count
+}
+
+func (s *stubExtractorMetrics) RecordImplausibleBlockGames(count int) {
+	s.implausible = count
+}
+
+func (s *stubExtractorMetrics) RecordSampledGames(processed, total int) {
+	s.sampledGames = processed
+	s.sampledOfTotal = total
 }
 
 type mockGameFetcher struct {
@@ -217,6 +690,9 @@ func (m *mockGameCallerCreator) CreateGameCaller(_ context.Context, _ gameTypes.
 type mockGameCaller struct {
 	metadataCalls    int
 	metadataErr      error
+	metadataBlocks   bool
+	status           gameTypes.GameStatus
+	l2BlockNum       uint64
 	claimsCalls      int
 	claimsErr        error
 	rootClaim        common.Hash
@@ -234,6 +710,18 @@ type mockGameCaller struct {
 	withdrawals      []*contracts.WithdrawalRequest
 	resolvedErr      error
 	resolved         map[int]bool
+	splitDepth       faultTypes.Depth
+	splitDepthErr    error
+	prestateBlock    uint64
+	poststateBlock   uint64
+	blockRangeErr    error
+	maxGameDepth     faultTypes.Depth
+	maxGameDepthErr  error
+	requiredBond     *big.Int
+	requiredBondErr  error
+	bondPositions    []*big.Int
+	resolvedAt       time.Time
+	resolvedAtErr    error
 }
 
 func (m *mockGameCaller) GetWithdrawals(_ context.Context, _ rpcblock.Block, _ ...common.Address) ([]*contracts.WithdrawalRequest, error) {
@@ -256,14 +744,20 @@ func (m *mockGameCaller) GetWithdrawals(_ context.Context, _ rpcblock.Block, _ .
 	}, nil
 }
 
-func (m *mockGameCaller) GetGameMetadata(_ context.Context, _ rpcblock.Block) (contracts.GameMetadata, error) {
+func (m *mockGameCaller) GetGameMetadata(ctx context.Context, _ rpcblock.Block) (contracts.GameMetadata, error) {
 	m.metadataCalls++
+	if m.metadataBlocks {
+		<-ctx.Done()
+		return contracts.GameMetadata{}, ctx.Err()
+	}
 	if m.metadataErr != nil {
 		return contracts.GameMetadata{}, m.metadataErr
 	}
 	return contracts.GameMetadata{
-		L1Head:    common.Hash{0xaa},
-		RootClaim: mockRootClaim,
+		L1Head:     common.Hash{0xaa},
+		RootClaim:  mockRootClaim,
+		Status:     m.status,
+		L2BlockNum: m.l2BlockNum,
 	}, nil
 }
 
@@ -310,12 +804,42 @@ func (m *mockGameCaller) IsResolved(_ context.Context, _ rpcblock.Block, claims
 	return resolved, nil
 }
 
+func (m *mockGameCaller) GetSplitDepth(_ context.Context) (faultTypes.Depth, error) {
+	return m.splitDepth, m.splitDepthErr
+}
+
+func (m *mockGameCaller) GetBlockRange(_ context.Context) (uint64, uint64, error) {
+	return m.prestateBlock, m.poststateBlock, m.blockRangeErr
+}
+
+func (m *mockGameCaller) GetMaxGameDepth(_ context.Context) (faultTypes.Depth, error) {
+	return m.maxGameDepth, m.maxGameDepthErr
+}
+
+func (m *mockGameCaller) GetResolvedAt(_ context.Context, _ rpcblock.Block) (time.Time, error) {
+	return m.resolvedAt, m.resolvedAtErr
+}
+
+func (m *mockGameCaller) GetRequiredBonds(_ context.Context, _ rpcblock.Block, positions ...*big.Int) ([]*big.Int, error) {
+	m.bondPositions = append(m.bondPositions, positions...)
+	if m.requiredBondErr != nil {
+		return nil, m.requiredBondErr
+	}
+	bonds := make([]*big.Int, len(positions))
+	for i := range positions {
+		bonds[i] = m.requiredBond
+	}
+	return bonds, nil
+}
+
 type mockEnricher struct {
-	err   error
-	calls int
+	err         error
+	calls       int
+	hasDeadline bool
 }
 
-func (m *mockEnricher) Enrich(_ context.Context, _ rpcblock.Block, _ GameCaller, _ *monTypes.EnrichedGameData) error {
+func (m *mockEnricher) Enrich(ctx context.Context, _ rpcblock.Block, _ GameCaller, _ *monTypes.EnrichedGameData) error {
 	m.calls++
+	_, m.hasDeadline = ctx.Deadline()
 	return m.err
 }
@@ -0,0 +1,129 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type IncrementalMetrics interface {
+	RecordIncrementalGamesProcessed(count int)
+}
+
+// BlockNumberByHash returns the number of the L1 block with the specified hash.
+type BlockNumberByHash func(ctx context.Context, blockHash common.Hash) (uint64, error)
+
+// IncrementalExtractor only loads the games that may have changed since the last processed L1 block.
+// Games that were already resolved as of the cursor block can't change so the data loaded for them is reused
+// instead of being loaded again. New and in-progress games are loaded every cycle. The bond credits and withdrawals of
+// reused games are only refreshed by a full rescan, so rescanInterval bounds how stale they can become.
+// A full rescan reloads every game and is performed every rescanInterval cycles, when forced and whenever the
+// L1 block moves backwards, such as after a reorg or when the monitor is pointed at an older block.
+type IncrementalExtractor struct {
+	logger           log.Logger
+	metrics          IncrementalMetrics
+	extractor        *Extractor
+	fetchBlockNumber BlockNumberByHash
+	rescanInterval   uint
+
+	forceRescan atomic.Bool
+
+	// Only accessed from Extract.
+	cursor   uint64
+	started  bool
+	cycles   uint
+	resolved map[common.Address]*monTypes.EnrichedGameData
+}
+
+func NewIncrementalExtractor(logger log.Logger, metrics IncrementalMetrics, extractor *Extractor, fetchBlockNumber BlockNumberByHash, rescanInterval uint) *IncrementalExtractor {
+	return &IncrementalExtractor{
+		logger:           logger,
+		metrics:          metrics,
+		extractor:        extractor,
+		fetchBlockNumber: fetchBlockNumber,
+		rescanInterval:   rescanInterval,
+		resolved:         make(map[common.Address]*monTypes.EnrichedGameData),
+	}
+}
+
+// Cursor returns the last processed L1 block number.
+func (e *IncrementalExtractor) Cursor() uint64 {
+	return e.cursor
+}
+
+// ForceFullRescan causes the next cycle to reload every game.
+func (e *IncrementalExtractor) ForceFullRescan() {
+	e.forceRescan.Store(true)
+}
+
+func (e *IncrementalExtractor) Extract(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*monTypes.EnrichedGameData, int, int, error) {
+	blockNumber, err := e.fetchBlockNumber(ctx, blockHash)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	games, err := e.extractor.listGames(ctx, blockHash, minTimestamp, math.MaxUint64)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	rescan := e.rescanDue(blockNumber)
+	if rescan {
+		e.logger.Info("Performing full rescan of games", "blockNumber", blockNumber, "cursor", e.cursor)
+	}
+	var pending []factoryGame
+	var reused []*monTypes.EnrichedGameData
+	for _, game := range e.extractor.sample(games) {
+		if cached, ok := e.resolved[game.Proxy]; ok && !rescan {
+			// Return a copy so changes made later in the cycle, such as overrides, don't alter the cached data
+			cpy := *cached
+			reused = append(reused, &cpy)
+			continue
+		}
+		pending = append(pending, game)
+	}
+	enriched, ignored, failed := e.extractor.enrichGames(ctx, blockHash, pending)
+	e.extractor.retainGames(games)
+	e.metrics.RecordIncrementalGamesProcessed(len(pending))
+
+	// Rebuild the cache from the games still being monitored so games leaving the game window are evicted
+	resolved := make(map[common.Address]*monTypes.EnrichedGameData, len(reused)+len(enriched))
+	for _, game := range reused {
+		resolved[game.Proxy] = e.resolved[game.Proxy]
+	}
+	for _, game := range enriched {
+		if isFullyResolved(game) {
+			cpy := *game
+			resolved[game.Proxy] = &cpy
+		}
+	}
+	e.resolved = resolved
+	e.cursor = blockNumber
+	e.started = true
+	e.cycles++
+	return append(enriched, reused...), ignored, failed, nil
+}
+
+// rescanDue returns true if every game should be reloaded rather than reusing previously loaded data.
+func (e *IncrementalExtractor) rescanDue(blockNumber uint64) bool {
+	forced := e.forceRescan.Swap(false)
+	if !e.started || forced || blockNumber < e.cursor {
+		e.cycles = 0
+		return true
+	}
+	if e.rescanInterval != 0 && e.cycles >= e.rescanInterval {
+		e.cycles = 0
+		return true
+	}
+	return false
+}
+
+// isFullyResolved returns true if the game is resolved and its classification is final, so it won't change in
+// future cycles.
+func isFullyResolved(game *monTypes.EnrichedGameData) bool {
+	return game.Status != gameTypes.GameStatusInProgress && !game.Pending && !game.Indeterminate
+}
@@ -0,0 +1,217 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncrementalExtractor(t *testing.T) {
+	resolvedGame := gameTypes.GameMetadata{Proxy: common.Address{0xaa}}
+	inProgressGame := gameTypes.GameMetadata{Proxy: common.Address{0xbb}}
+
+	t.Run("AdvancesCursor", func(t *testing.T) {
+		incremental, blocks, _, _, metrics := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		blocks[common.Hash{0x02}] = 12
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), incremental.Cursor())
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x02}, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(12), incremental.Cursor())
+		require.Equal(t, 0, metrics.processed)
+	})
+
+	t.Run("SkipsResolvedGames", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		blocks[common.Hash{0x02}] = 12
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		enriched, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Len(t, enriched, 1)
+		require.Equal(t, 1, metrics.processed)
+		require.Equal(t, 1, creator.caller.metadataCalls)
+
+		// A new in-progress game is loaded but the resolved game is reused
+		games.games = []gameTypes.GameMetadata{resolvedGame, inProgressGame}
+		creator.caller.status = gameTypes.GameStatusInProgress
+		enriched, _, _, err = incremental.Extract(context.Background(), common.Hash{0x02}, 0)
+		require.NoError(t, err)
+		require.Len(t, enriched, 2)
+		require.Equal(t, 1, metrics.processed)
+		require.Equal(t, 2, creator.caller.metadataCalls)
+		statuses := make(map[common.Address]gameTypes.GameStatus)
+		for _, game := range enriched {
+			statuses[game.Proxy] = game.Status
+		}
+		require.Equal(t, gameTypes.GameStatusDefenderWon, statuses[resolvedGame.Proxy])
+		require.Equal(t, gameTypes.GameStatusInProgress, statuses[inProgressGame.Proxy])
+
+		// The in-progress game is loaded every cycle
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x02}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, metrics.processed)
+		require.Equal(t, 3, creator.caller.metadataCalls)
+	})
+
+	t.Run("ReusedGamesAreCopied", func(t *testing.T) {
+		incremental, blocks, creator, games, _ := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		enriched, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		enriched[0].AgreeWithClaim = true
+		enriched, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.False(t, enriched[0].AgreeWithClaim)
+	})
+
+	t.Run("EvictsGamesOutsideWindow", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+
+		games.games = nil
+		enriched, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Empty(t, enriched)
+		require.Empty(t, incremental.resolved)
+		require.Equal(t, 0, metrics.processed)
+	})
+
+	t.Run("RetainsListedGames", func(t *testing.T) {
+		incremental, blocks, creator, games, _ := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		var retained []gameTypes.GameMetadata
+		incremental.extractor.AddRetainer(func(games []gameTypes.GameMetadata) {
+			retained = games
+		})
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		retained = nil
+
+		// Reused games are still retained
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, []gameTypes.GameMetadata{resolvedGame}, retained)
+	})
+
+	t.Run("ForcedFullRescan", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, metrics.processed)
+
+		incremental.ForceFullRescan()
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, metrics.processed)
+		require.Equal(t, 2, creator.caller.metadataCalls)
+
+		// Only a single cycle is rescanned
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, metrics.processed)
+	})
+
+	t.Run("RescanInterval", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 3)
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		var processed []int
+		for i := 0; i < 7; i++ {
+			_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+			require.NoError(t, err)
+			processed = append(processed, metrics.processed)
+		}
+		require.Equal(t, []int{1, 0, 0, 1, 0, 0, 1}, processed)
+	})
+
+	t.Run("RescanWhenBlockMovesBackwards", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 0)
+		blocks[common.Hash{0x01}] = 10
+		blocks[common.Hash{0x02}] = 9
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x02}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, metrics.processed)
+		require.Equal(t, uint64(9), incremental.Cursor())
+	})
+
+	t.Run("PendingGamesNotReused", func(t *testing.T) {
+		incremental, blocks, creator, games, metrics := setupIncrementalTest(t, 0, &pendingEnricher{})
+		blocks[common.Hash{0x01}] = 10
+		games.games = []gameTypes.GameMetadata{resolvedGame}
+		creator.caller.status = gameTypes.GameStatusDefenderWon
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		_, _, _, err = incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, metrics.processed)
+	})
+
+	t.Run("BlockNumberError", func(t *testing.T) {
+		incremental, _, _, games, _ := setupIncrementalTest(t, 0)
+		_, _, _, err := incremental.Extract(context.Background(), common.Hash{0x01}, 0)
+		require.ErrorIs(t, err, errUnknownBlock)
+		require.Zero(t, games.calls)
+	})
+}
+
+var errUnknownBlock = errors.New("unknown block")
+
+func setupIncrementalTest(t *testing.T, rescanInterval uint, enrichers ...Enricher) (*IncrementalExtractor, map[common.Hash]uint64, *mockGameCallerCreator, *mockGameFetcher, *stubIncrementalMetrics) {
+	extractor, creator, games, _, _ := setupFilterTest(t, enrichers...)
+	blocks := make(map[common.Hash]uint64)
+	fetchBlockNumber := func(_ context.Context, blockHash common.Hash) (uint64, error) {
+		number, ok := blocks[blockHash]
+		if !ok {
+			return 0, errUnknownBlock
+		}
+		return number, nil
+	}
+	metrics := &stubIncrementalMetrics{}
+	incremental := NewIncrementalExtractor(testlog.Logger(t, log.LvlDebug), metrics, extractor, fetchBlockNumber, rescanInterval)
+	return incremental, blocks, creator, games, metrics
+}
+
+type pendingEnricher struct{}
+
+func (p *pendingEnricher) Enrich(_ context.Context, _ rpcblock.Block, _ GameCaller, game *monTypes.EnrichedGameData) error {
+	game.Pending = true
+	return nil
+}
+
+type stubIncrementalMetrics struct {
+	processed int
+}
+
+func (s *stubIncrementalMetrics) RecordIncrementalGamesProcessed(count int) {
+	s.processed = count
+}
@@ -0,0 +1,65 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"golang.org/x/time/rate"
+)
+
+var ErrRateLimited = errors.New("rate limited")
+
+type RateLimitMetrics interface {
+	RecordRateLimited(count int)
+}
+
+// RateLimitedRollupClient applies a token-bucket rate limit to output requests made to the rollup node.
+// Requests that would have to wait beyond their context deadline fail immediately with ErrRateLimited
+// rather than blocking until the deadline is reached.
+type RateLimitedRollupClient struct {
+	OutputRollupClient
+	metrics RateLimitMetrics
+	limiter *rate.Limiter
+}
+
+func NewRateLimitedRollupClient(client OutputRollupClient, metrics RateLimitMetrics, limit rate.Limit, burst int) *RateLimitedRollupClient {
+	return &RateLimitedRollupClient{
+		OutputRollupClient: client,
+		metrics:            metrics,
+		limiter:            rate.NewLimiter(limit, burst),
+	}
+}
+
+func (r *RateLimitedRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.OutputRollupClient.OutputAtBlock(ctx, blockNum)
+}
+
+func (r *RateLimitedRollupClient) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	reservation := r.limiter.Reserve()
+	delay := reservation.Delay()
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		reservation.Cancel()
+		r.metrics.RecordRateLimited(1)
+		return ErrRateLimited
+	}
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
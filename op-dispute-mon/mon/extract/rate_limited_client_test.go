@@ -0,0 +1,78 @@
+package extract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitedRollupClient(t *testing.T) {
+	t.Run("CapsCallRate", func(t *testing.T) {
+		rollup := &stubRollupClient{}
+		client := NewRateLimitedRollupClient(rollup, &stubRateLimitMetrics{}, rate.Every(50*time.Millisecond), 1)
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			_, err := client.OutputAtBlock(context.Background(), uint64(i))
+			require.NoError(t, err)
+		}
+		// The first call uses the burst and each subsequent call must wait for a new token
+		require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+		require.Equal(t, 5, rollup.outputCalls)
+	})
+
+	t.Run("CancelledWhileWaiting", func(t *testing.T) {
+		rollup := &stubRollupClient{}
+		metrics := &stubRateLimitMetrics{}
+		client := NewRateLimitedRollupClient(rollup, metrics, rate.Every(time.Hour), 1)
+		_, err := client.OutputAtBlock(context.Background(), 1)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		_, err = client.OutputAtBlock(ctx, 2)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, rollup.outputCalls)
+		require.Zero(t, metrics.rateLimited)
+	})
+
+	t.Run("SkipsWhenWaitExceedsDeadline", func(t *testing.T) {
+		rollup := &stubRollupClient{}
+		metrics := &stubRateLimitMetrics{}
+		client := NewRateLimitedRollupClient(rollup, metrics, rate.Every(time.Hour), 1)
+		_, err := client.OutputAtBlock(context.Background(), 1)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		start := time.Now()
+		_, err = client.OutputAtBlock(ctx, 2)
+		require.ErrorIs(t, err, ErrRateLimited)
+		require.Less(t, time.Since(start), time.Second, "should not wait for the deadline")
+		require.Equal(t, 1, rollup.outputCalls)
+		require.Equal(t, 1, metrics.rateLimited)
+	})
+
+	t.Run("OtherRequestsNotLimited", func(t *testing.T) {
+		rollup := &stubRollupClient{}
+		client := NewRateLimitedRollupClient(rollup, &stubRateLimitMetrics{}, rate.Every(time.Hour), 1)
+		for i := 0; i < 3; i++ {
+			_, err := client.SyncStatus(context.Background())
+			require.NoError(t, err)
+		}
+		require.Equal(t, 3, rollup.syncStatusCalls)
+	})
+}
+
+type stubRateLimitMetrics struct {
+	rateLimited int
+}
+
+func (s *stubRateLimitMetrics) RecordRateLimited(count int) {
+	s.rateLimited += count
+}
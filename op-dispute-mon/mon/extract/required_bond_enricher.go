@@ -0,0 +1,48 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*RequiredBondEnricher)(nil)
+
+type RequiredBondCaller interface {
+	GetRequiredBonds(ctx context.Context, block rpcblock.Block, positions ...*big.Int) ([]*big.Int, error)
+}
+
+// RequiredBondEnricher loads the bond required for the next move in an in-progress game,
+// which is a move countering the latest claim. The required bond depends only on the depth of the move
+// so attacking and defending the latest claim require the same bond.
+// A claim at the maximum game depth is countered by a step which doesn't require a bond.
+type RequiredBondEnricher struct{}
+
+func NewRequiredBondEnricher() *RequiredBondEnricher {
+	return &RequiredBondEnricher{}
+}
+
+func (e *RequiredBondEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	game.NextMoveBond = nil
+	if game.Status != gameTypes.GameStatusInProgress || len(game.Claims) == 0 {
+		return nil
+	}
+	maxDepth, err := caller.GetMaxGameDepth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load max game depth: %w", err)
+	}
+	latest := game.Claims[len(game.Claims)-1]
+	if latest.Depth() >= maxDepth {
+		return nil
+	}
+	bonds, err := caller.GetRequiredBonds(ctx, block, latest.Position.Attack().ToGIndex())
+	if err != nil {
+		return fmt.Errorf("failed to load required bond: %w", err)
+	}
+	game.NextMoveBond = bonds[0]
+	return nil
+}
@@ -0,0 +1,79 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredBondEnricher(t *testing.T) {
+	claim := func(depth faultTypes.Depth) monTypes.EnrichedClaim {
+		return monTypes.EnrichedClaim{Claim: faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{Position: faultTypes.NewPosition(depth, big.NewInt(0))},
+		}}
+	}
+
+	t.Run("LoadsBondForMoveAgainstLatestClaim", func(t *testing.T) {
+		enricher := NewRequiredBondEnricher()
+		caller := &mockGameCaller{maxGameDepth: 4, requiredBond: big.NewInt(500)}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0), claim(1), claim(2)},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Equal(t, big.NewInt(500), game.NextMoveBond)
+		require.Equal(t, []*big.Int{faultTypes.NewPosition(3, big.NewInt(0)).ToGIndex()}, caller.bondPositions)
+	})
+
+	t.Run("NoBondForStep", func(t *testing.T) {
+		enricher := NewRequiredBondEnricher()
+		caller := &mockGameCaller{maxGameDepth: 2, requiredBond: big.NewInt(500)}
+		game := &monTypes.EnrichedGameData{
+			Status:       gameTypes.GameStatusInProgress,
+			Claims:       []monTypes.EnrichedClaim{claim(0), claim(1), claim(2)},
+			NextMoveBond: big.NewInt(1),
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Nil(t, game.NextMoveBond)
+		require.Empty(t, caller.bondPositions)
+	})
+
+	t.Run("SkipsResolvedGames", func(t *testing.T) {
+		enricher := NewRequiredBondEnricher()
+		caller := &mockGameCaller{maxGameDepth: 4, requiredBond: big.NewInt(500)}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusDefenderWon,
+			Claims: []monTypes.EnrichedClaim{claim(0)},
+		}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Nil(t, game.NextMoveBond)
+		require.Empty(t, caller.bondPositions)
+	})
+
+	t.Run("RequiredBondError", func(t *testing.T) {
+		enricher := NewRequiredBondEnricher()
+		caller := &mockGameCaller{maxGameDepth: 4, requiredBondErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0)},
+		}
+		require.ErrorIs(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game), caller.requiredBondErr)
+	})
+
+	t.Run("MaxGameDepthError", func(t *testing.T) {
+		enricher := NewRequiredBondEnricher()
+		caller := &mockGameCaller{maxGameDepthErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{
+			Status: gameTypes.GameStatusInProgress,
+			Claims: []monTypes.EnrichedClaim{claim(0)},
+		}
+		require.ErrorIs(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game), caller.maxGameDepthErr)
+	})
+}
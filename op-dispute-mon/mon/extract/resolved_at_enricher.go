@@ -0,0 +1,37 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*ResolvedAtEnricher)(nil)
+
+type ResolvedAtCaller interface {
+	GetResolvedAt(ctx context.Context, block rpcblock.Block) (time.Time, error)
+}
+
+// ResolvedAtEnricher loads the time a resolved game was resolved.
+type ResolvedAtEnricher struct{}
+
+func NewResolvedAtEnricher() *ResolvedAtEnricher {
+	return &ResolvedAtEnricher{}
+}
+
+func (e *ResolvedAtEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	game.ResolvedAt = time.Time{}
+	if game.Status == gameTypes.GameStatusInProgress {
+		return nil
+	}
+	resolvedAt, err := caller.GetResolvedAt(ctx, block)
+	if err != nil {
+		return fmt.Errorf("failed to load resolution time: %w", err)
+	}
+	game.ResolvedAt = resolvedAt
+	return nil
+}
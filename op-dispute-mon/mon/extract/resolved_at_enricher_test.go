@@ -0,0 +1,38 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvedAtEnricher(t *testing.T) {
+	t.Run("LoadsResolutionTime", func(t *testing.T) {
+		enricher := NewResolvedAtEnricher()
+		caller := &mockGameCaller{resolvedAt: time.Unix(5000, 0)}
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusDefenderWon}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.Equal(t, time.Unix(5000, 0), game.ResolvedAt)
+	})
+
+	t.Run("SkipsInProgressGames", func(t *testing.T) {
+		enricher := NewResolvedAtEnricher()
+		caller := &mockGameCaller{resolvedAtErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusInProgress, ResolvedAt: time.Unix(5000, 0)}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game))
+		require.True(t, game.ResolvedAt.IsZero())
+	})
+
+	t.Run("ResolvedAtError", func(t *testing.T) {
+		enricher := NewResolvedAtEnricher()
+		caller := &mockGameCaller{resolvedAtErr: errors.New("boom")}
+		game := &monTypes.EnrichedGameData{Status: gameTypes.GameStatusChallengerWon}
+		require.ErrorIs(t, enricher.Enrich(context.Background(), rpcblock.Latest, caller, game), caller.resolvedAtErr)
+	})
+}
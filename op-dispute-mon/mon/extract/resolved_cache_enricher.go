@@ -0,0 +1,113 @@
+package extract
+
+import (
+	"context"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ Enricher = (*ResolvedCacheEnricher)(nil)
+
+const (
+	resolvedCacheLabel = "resolved_games"
+	// resolvedCacheSize is the maximum number of resolved game classifications cached.
+	resolvedCacheSize = 10_000
+)
+
+// resolvedClassification is the cached classification of a resolved game.
+type resolvedClassification struct {
+	// The game the classification applies to. A change indicates an L1 reorg replaced the game.
+	rootClaim     common.Hash
+	l2BlockNumber uint64
+	status        gameTypes.GameStatus
+
+	agreeWithClaim    bool
+	expectedRootClaim common.Hash
+	l2BlockTimestamp  uint64
+	rollupSafeHead    eth.L2BlockRef
+	beyondOutputRange bool
+	archiveFallback   bool
+	inconsistent      bool
+
+	// since is the time the classification was first seen, to determine when it is confirmed.
+	since time.Time
+}
+
+func (c resolvedClassification) matches(game *monTypes.EnrichedGameData) bool {
+	return c.rootClaim == game.RootClaim && c.l2BlockNumber == game.L2BlockNumber && c.status == game.Status
+}
+
+func (c resolvedClassification) apply(game *monTypes.EnrichedGameData) {
+	game.AgreeWithClaim = c.agreeWithClaim
+	game.ExpectedRootClaim = c.expectedRootClaim
+	game.L2BlockTimestamp = c.l2BlockTimestamp
+	game.RollupSafeHead = c.rollupSafeHead
+	game.BeyondOutputRange = c.beyondOutputRange
+	game.ArchiveFallback = c.archiveFallback
+	game.RollupInternalInconsistency = c.inconsistent
+}
+
+// ResolvedCacheEnricher skips re-evaluating resolved games once their classification is confirmed, reusing the
+// cached classification instead of querying the rollup node every cycle.
+// A classification is confirmed once it has remained unchanged for the grace period. If the game's root claim,
+// disputed block or status changes, such as after an L1 reorg, the game is evaluated again.
+type ResolvedCacheEnricher struct {
+	clock RClock
+	inner Enricher
+	grace time.Duration
+	cache *caching.LRUCache[common.Address, resolvedClassification]
+}
+
+func NewResolvedCacheEnricher(m caching.Metrics, clock RClock, inner Enricher, grace time.Duration) *ResolvedCacheEnricher {
+	return &ResolvedCacheEnricher{
+		clock: clock,
+		inner: inner,
+		grace: grace,
+		cache: caching.NewLRUCache[common.Address, resolvedClassification](m, resolvedCacheLabel, resolvedCacheSize),
+	}
+}
+
+func (e *ResolvedCacheEnricher) Enrich(ctx context.Context, block rpcblock.Block, caller GameCaller, game *monTypes.EnrichedGameData) error {
+	now := e.clock.Now()
+	var previous resolvedClassification
+	var cached bool
+	if game.Status != gameTypes.GameStatusInProgress {
+		previous, cached = e.cache.Get(game.Proxy)
+		cached = cached && previous.matches(game)
+		if cached && now.Sub(previous.since) >= e.grace {
+			previous.apply(game)
+			return nil
+		}
+	}
+	if err := e.inner.Enrich(ctx, block, caller, game); err != nil {
+		return err
+	}
+	if game.Status == gameTypes.GameStatusInProgress || game.Pending || game.Indeterminate {
+		return nil
+	}
+	classification := resolvedClassification{
+		rootClaim:         game.RootClaim,
+		l2BlockNumber:     game.L2BlockNumber,
+		status:            game.Status,
+		agreeWithClaim:    game.AgreeWithClaim,
+		expectedRootClaim: game.ExpectedRootClaim,
+		l2BlockTimestamp:  game.L2BlockTimestamp,
+		rollupSafeHead:    game.RollupSafeHead,
+		beyondOutputRange: game.BeyondOutputRange,
+		archiveFallback:   game.ArchiveFallback,
+		inconsistent:      game.RollupInternalInconsistency,
+		since:             now,
+	}
+	// Only an unchanged classification counts towards the grace period
+	if cached && previous.agreeWithClaim == classification.agreeWithClaim && previous.expectedRootClaim == classification.expectedRootClaim {
+		classification.since = previous.since
+	}
+	e.cache.Add(game.Proxy, classification)
+	return nil
+}
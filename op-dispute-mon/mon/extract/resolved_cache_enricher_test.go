@@ -0,0 +1,82 @@
+package extract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvedCacheEnricher(t *testing.T) {
+	const grace = time.Hour
+	setup := func(t *testing.T) (*ResolvedCacheEnricher, *stubRollupClient, *clock.DeterministicClock) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		client := &stubRollupClient{
+			safeHeadNum:    99999999999,
+			unsafeHeadNum:  99999999999,
+			safeL2Num:      99999999999,
+			finalizedL2Num: 99999999999,
+		}
+		validator := NewAgreementEnricher(testlog.Logger(t, log.LvlInfo), &stubOutputMetrics{}, cl, client, 0, monTypes.AgreementHeadSafe, nil, nil, nil)
+		return NewResolvedCacheEnricher(nil, cl, validator, grace), client, cl
+	}
+	newGame := func(status gameTypes.GameStatus) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			GameMetadata:  gameTypes.GameMetadata{Proxy: common.Address{0xaa}},
+			L1HeadNum:     200,
+			L2BlockNumber: 100,
+			RootClaim:     mockRootClaim,
+			Status:        status,
+		}
+	}
+
+	t.Run("ConfirmedResolvedGameNotRequeried", func(t *testing.T) {
+		enricher, client, cl := setup(t)
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, newGame(gameTypes.GameStatusDefenderWon)))
+		require.Equal(t, 1, client.outputCalls)
+
+		// Re-evaluated until the classification is confirmed
+		cl.AdvanceTime(grace - time.Second)
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, newGame(gameTypes.GameStatusDefenderWon)))
+		require.Equal(t, 2, client.outputCalls)
+
+		cl.AdvanceTime(time.Second)
+		for i := 0; i < 3; i++ {
+			game := newGame(gameTypes.GameStatusDefenderWon)
+			require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+			require.True(t, game.AgreeWithClaim)
+			require.Equal(t, mockRootClaim, game.ExpectedRootClaim)
+		}
+		require.Equal(t, 2, client.outputCalls)
+	})
+
+	t.Run("InProgressGameAlwaysEvaluated", func(t *testing.T) {
+		enricher, client, cl := setup(t)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, newGame(gameTypes.GameStatusInProgress)))
+			cl.AdvanceTime(grace)
+		}
+		require.Equal(t, 3, client.outputCalls)
+	})
+
+	t.Run("ReevaluatedWhenGameChanges", func(t *testing.T) {
+		enricher, client, cl := setup(t)
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, newGame(gameTypes.GameStatusDefenderWon)))
+		cl.AdvanceTime(grace)
+
+		// An L1 reorg replaced the game with one making a different claim
+		game := newGame(gameTypes.GameStatusDefenderWon)
+		game.RootClaim = common.Hash{0xba, 0xd0}
+		require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, nil, game))
+		require.Equal(t, 2, client.outputCalls)
+		require.False(t, game.AgreeWithClaim)
+	})
+}
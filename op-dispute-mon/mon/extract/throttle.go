@@ -0,0 +1,51 @@
+package extract
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+// Throttle spaces out the games it loads by at least the configured interval.
+// Wrapping the game caller creator it limits the rate at which games are loaded, including their metadata.
+type Throttle struct {
+	clock    clock.Clock
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func NewThrottle(cl clock.Clock, interval time.Duration) *Throttle {
+	return &Throttle{
+		clock:    cl,
+		interval: interval,
+	}
+}
+
+// WrapCreator returns a CreateGameCaller that waits for the throttle before creating each caller with create.
+func (t *Throttle) WrapCreator(create CreateGameCaller) CreateGameCaller {
+	return func(ctx context.Context, game gameTypes.GameMetadata) (GameCaller, error) {
+		if err := t.Wait(ctx); err != nil {
+			return nil, err
+		}
+		return create(ctx, game)
+	}
+}
+
+// Wait blocks until at least the interval has passed since the previous call returned.
+func (t *Throttle) Wait(ctx context.Context) error {
+	// Hold the lock while waiting so concurrent games are released one interval apart
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if wait := t.next.Sub(t.clock.Now()); wait > 0 {
+		if err := t.clock.SleepCtx(ctx, wait); err != nil {
+			return err
+		}
+	}
+	t.next = t.clock.Now().Add(t.interval)
+	return nil
+}
@@ -0,0 +1,77 @@
+package extract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Run("FirstGameNotDelayed", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		throttle := NewThrottle(cl, time.Minute)
+		require.NoError(t, throttle.Wait(context.Background()))
+	})
+
+	t.Run("WaitsForInterval", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		throttle := NewThrottle(cl, time.Minute)
+		require.NoError(t, throttle.Wait(context.Background()))
+
+		done := make(chan error, 1)
+		go func() {
+			done <- throttle.Wait(context.Background())
+		}()
+		require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second))
+		cl.AdvanceTime(59 * time.Second)
+		select {
+		case <-done:
+			t.Fatal("game loaded before interval elapsed")
+		default:
+		}
+		cl.AdvanceTime(time.Second)
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("game not loaded after interval elapsed")
+		}
+	})
+
+	t.Run("NoWaitAfterIntervalElapsed", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		throttle := NewThrottle(cl, time.Minute)
+		require.NoError(t, throttle.Wait(context.Background()))
+		cl.AdvanceTime(time.Minute)
+		require.NoError(t, throttle.Wait(context.Background()))
+	})
+
+	t.Run("ContextCancelled", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		throttle := NewThrottle(cl, time.Minute)
+		require.NoError(t, throttle.Wait(context.Background()))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, throttle.Wait(ctx), context.Canceled)
+	})
+
+	t.Run("WrapCreatorThrottlesBeforeCreating", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		throttle := NewThrottle(cl, time.Minute)
+		creator := &mockGameCallerCreator{caller: &mockGameCaller{}}
+		create := throttle.WrapCreator(creator.CreateGameCaller)
+		_, err := create(context.Background(), gameTypes.GameMetadata{})
+		require.NoError(t, err)
+		require.Equal(t, 1, creator.calls)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = create(ctx, gameTypes.GameMetadata{})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, creator.calls, "should not create the caller or load its metadata until throttled")
+	})
+}
@@ -0,0 +1,41 @@
+package extract
+
+import (
+	"context"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+)
+
+var _ Enricher = (*TurnEnricher)(nil)
+
+// TurnEnricher determines whether it is the honest actors' turn to move in an in-progress game.
+// It is our turn when the honest actors have made a claim in the game and the most recent claim was made by an opponent.
+type TurnEnricher struct {
+	honestActors monTypes.HonestActors
+}
+
+func NewTurnEnricher(honestActors monTypes.HonestActors) *TurnEnricher {
+	return &TurnEnricher{
+		honestActors: honestActors,
+	}
+}
+
+func (e *TurnEnricher) Enrich(_ context.Context, _ rpcblock.Block, _ GameCaller, game *monTypes.EnrichedGameData) error {
+	game.OurTurn = false
+	if game.Status != gameTypes.GameStatusInProgress || len(game.Claims) == 0 {
+		return nil
+	}
+	// Claims are stored in the order they were made so the last claim is the most recent move.
+	if e.honestActors.Contains(game.Claims[len(game.Claims)-1].Claimant) {
+		return nil
+	}
+	for _, claim := range game.Claims {
+		if e.honestActors.Contains(claim.Claimant) {
+			game.OurTurn = true
+			return nil
+		}
+	}
+	return nil
+}
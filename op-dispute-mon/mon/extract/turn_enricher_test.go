@@ -0,0 +1,67 @@
+package extract
+
+import (
+	"context"
+	"testing"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTurnEnricher(t *testing.T) {
+	honest := common.Address{0xaa}
+	opponent := common.Address{0xbb}
+	claimBy := func(claimant common.Address) monTypes.EnrichedClaim {
+		return monTypes.EnrichedClaim{Claim: faultTypes.Claim{Claimant: claimant}}
+	}
+	enricher := NewTurnEnricher(monTypes.NewHonestActors([]common.Address{honest}))
+
+	tests := []struct {
+		name     string
+		status   gameTypes.GameStatus
+		claims   []monTypes.EnrichedClaim
+		expected bool
+	}{
+		{
+			name:     "OpponentMovedLast",
+			status:   gameTypes.GameStatusInProgress,
+			claims:   []monTypes.EnrichedClaim{claimBy(opponent), claimBy(honest), claimBy(opponent)},
+			expected: true,
+		},
+		{
+			name:     "HonestMovedLast",
+			status:   gameTypes.GameStatusInProgress,
+			claims:   []monTypes.EnrichedClaim{claimBy(opponent), claimBy(honest)},
+			expected: false,
+		},
+		{
+			name:     "NotParticipating",
+			status:   gameTypes.GameStatusInProgress,
+			claims:   []monTypes.EnrichedClaim{claimBy(opponent), claimBy(opponent)},
+			expected: false,
+		},
+		{
+			name:     "Resolved",
+			status:   gameTypes.GameStatusDefenderWon,
+			claims:   []monTypes.EnrichedClaim{claimBy(opponent), claimBy(honest), claimBy(opponent)},
+			expected: false,
+		},
+		{
+			name:     "NoClaims",
+			status:   gameTypes.GameStatusInProgress,
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			game := &monTypes.EnrichedGameData{Status: test.status, Claims: test.claims}
+			require.NoError(t, enricher.Enrich(context.Background(), rpcblock.Latest, &mockGameCaller{}, game))
+			require.Equal(t, test.expected, game.OurTurn)
+		})
+	}
+}
@@ -2,11 +2,15 @@ package mon
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/history"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/transform"
 	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -16,10 +20,30 @@ var (
 
 type ForecastMetrics interface {
 	RecordGameAgreement(status metrics.GameAgreementStatus, count int)
+	RecordGameAgreementByFactory(factory common.Address, status metrics.GameAgreementStatus, count int)
+	RecordGameAgreementByL1Chain(chainID uint64, status metrics.GameAgreementStatus, count int)
+	RecordGameAgreementByGroup(group string, status metrics.GameAgreementStatus, count int)
 	RecordLatestValidProposalL2Block(validL2Block uint64)
 	RecordLatestProposals(validTimestamp, invalidTimestamp uint64)
 	RecordIgnoredGames(count int)
 	RecordFailedGames(count int)
+	RecordCyclesSinceLastDisagreement(count int)
+	RecordProjectedOutcome(outcome metrics.ProjectedOutcome, count int)
+	RecordUnknownStatusGames(raw uint8, count int)
+	RecordHonestActorStanding(favorable, unfavorable int)
+	RecordPendingGames(count int)
+	RecordIndeterminateGames(count int)
+	RecordRollupInconsistentGames(count int)
+	RecordTimeSinceLastFavorableResolution(dur time.Duration)
+	RecordValueAtRisk(status metrics.GameAgreementStatus, wei *big.Int)
+	RecordDisagreementRateEWMA(rate float64)
+	RecordProjectionAccuracy(projected, actual metrics.ProjectedOutcome, count int)
+	RecordAtRiskGames(count int)
+}
+
+// HistoryRecorder records the result of each forecast for offline analysis.
+type HistoryRecorder interface {
+	Append(gamesHash common.Hash, batch any)
 }
 
 type forecastBatch struct {
@@ -36,28 +60,390 @@ type forecastBatch struct {
 	LatestValidProposalL2Block uint64
 	LatestInvalidProposal      uint64
 	LatestValidProposal        uint64
+
+	// Pending counts games that can't be classified until the agreement head reaches the disputed block.
+	Pending int
+
+	// Indeterminate counts games where the rollup node couldn't provide an output to compare against.
+	Indeterminate int
+
+	// RollupInconsistent counts games where the rollup node's output was inconsistent with its block.
+	RollupInconsistent int
+
+	// SuppressedDisagreements counts in progress games from trusted proposers that disagree with the reference node.
+	// They are included in the agreement counts but don't reset the cycles since the last disagreement.
+	SuppressedDisagreements int
+
+	// Benign counts disagreeing games known to be benign. They are reported as ignored rather than by agreement status.
+	Benign int
+
+	// UnknownStatuses counts games by raw status value for statuses the monitor does not recognise.
+	UnknownStatuses map[uint8]int
+
+	// ValueAtRisk is the total bond posted in disagreeing games, in wei, for each disagree status.
+	ValueAtRisk map[metrics.GameAgreementStatus]*big.Int
+
+	// Projections is the projected outcome of each in progress game, to compare against its actual outcome once
+	// it resolves. Not included in the history.
+	Projections map[common.Address]metrics.ProjectedOutcome `json:"-"`
+
+	// FavorableResolutions is the set of resolved games whose result matches our agreement with the root claim,
+	// to detect new favourable resolutions. Not included in the history.
+	FavorableResolutions map[common.Address]bool `json:"-"`
+}
+
+func newForecastBatch() *forecastBatch {
+	return &forecastBatch{
+		UnknownStatuses: make(map[uint8]int),
+		Projections:     make(map[common.Address]metrics.ProjectedOutcome),
+
+		FavorableResolutions: make(map[common.Address]bool),
+	}
+}
+
+// add includes the counts from other in this batch.
+func (b *forecastBatch) add(other *forecastBatch) {
+	b.AgreeDefenderAhead += other.AgreeDefenderAhead
+	b.DisagreeDefenderAhead += other.DisagreeDefenderAhead
+	b.AgreeChallengerAhead += other.AgreeChallengerAhead
+	b.DisagreeChallengerAhead += other.DisagreeChallengerAhead
+
+	b.AgreeDefenderWins += other.AgreeDefenderWins
+	b.DisagreeDefenderWins += other.DisagreeDefenderWins
+	b.AgreeChallengerWins += other.AgreeChallengerWins
+	b.DisagreeChallengerWins += other.DisagreeChallengerWins
+
+	b.LatestValidProposalL2Block = max(b.LatestValidProposalL2Block, other.LatestValidProposalL2Block)
+	b.LatestInvalidProposal = max(b.LatestInvalidProposal, other.LatestInvalidProposal)
+	b.LatestValidProposal = max(b.LatestValidProposal, other.LatestValidProposal)
+
+	b.Pending += other.Pending
+	b.Indeterminate += other.Indeterminate
+	b.RollupInconsistent += other.RollupInconsistent
+	b.SuppressedDisagreements += other.SuppressedDisagreements
+	b.Benign += other.Benign
+	for raw, count := range other.UnknownStatuses {
+		b.UnknownStatuses[raw] += count
+	}
+	for status, value := range other.ValueAtRisk {
+		b.addValueAtRisk(status, value)
+	}
+	for game, outcome := range other.Projections {
+		b.Projections[game] = outcome
+	}
+	for game := range other.FavorableResolutions {
+		b.FavorableResolutions[game] = true
+	}
+}
+
+// merge returns a new batch combining the counts from b and other. Neither batch is modified so partial batches can
+// be combined without sharing state.
+func (b forecastBatch) merge(other forecastBatch) forecastBatch {
+	merged := newForecastBatch()
+	merged.add(&b)
+	merged.add(&other)
+	return *merged
+}
+
+func (b *forecastBatch) addValueAtRisk(status metrics.GameAgreementStatus, wei *big.Int) {
+	if b.ValueAtRisk == nil {
+		b.ValueAtRisk = make(map[metrics.GameAgreementStatus]*big.Int)
+	}
+	if b.ValueAtRisk[status] == nil {
+		b.ValueAtRisk[status] = new(big.Int)
+	}
+	b.ValueAtRisk[status].Add(b.ValueAtRisk[status], wei)
+}
+
+// valueAtRisk returns the total bond posted in disagreeing games with the specified status, in wei.
+func (b *forecastBatch) valueAtRisk(status metrics.GameAgreementStatus) *big.Int {
+	if value, ok := b.ValueAtRisk[status]; ok {
+		return value
+	}
+	return new(big.Int)
+}
+
+// agreements returns the number of games with each agreement status.
+func (b *forecastBatch) agreements() map[metrics.GameAgreementStatus]int {
+	return map[metrics.GameAgreementStatus]int{
+		metrics.AgreeDefenderWins:       b.AgreeDefenderWins,
+		metrics.DisagreeDefenderWins:    b.DisagreeDefenderWins,
+		metrics.AgreeChallengerWins:     b.AgreeChallengerWins,
+		metrics.DisagreeChallengerWins:  b.DisagreeChallengerWins,
+		metrics.AgreeChallengerAhead:    b.AgreeChallengerAhead,
+		metrics.DisagreeChallengerAhead: b.DisagreeChallengerAhead,
+		metrics.AgreeDefenderAhead:      b.AgreeDefenderAhead,
+		metrics.DisagreeDefenderAhead:   b.DisagreeDefenderAhead,
+	}
+}
+
+// atRisk returns the number of games in the batch where an invalid root claim has won or has not yet been defeated.
+func (b forecastBatch) atRisk() int {
+	return b.DisagreeDefenderWins + b.DisagreeDefenderAhead + b.DisagreeChallengerAhead
+}
+
+// hasDisagreement returns true if any game in the batch disagrees with the reference node,
+// excluding suppressed disagreements from trusted proposers.
+func (b forecastBatch) hasDisagreement() bool {
+	return b.DisagreeDefenderAhead+b.DisagreeChallengerAhead > b.SuppressedDisagreements ||
+		b.DisagreeDefenderWins > 0 || b.DisagreeChallengerWins > 0
 }
 
 type Forecast struct {
 	logger  log.Logger
 	metrics ForecastMetrics
+	clock   RClock
+	dryRun  bool
+	history HistoryRecorder
+
+	// cyclesSinceLastDisagreement is the number of consecutive forecasts that found no disagreeing games.
+	cyclesSinceLastDisagreement int
+
+	// favorableResolutions is the set of games resolved in our favour in the previous forecast and
+	// lastFavorableResolution is the time a game was last added to it.
+	favorableResolutions    map[common.Address]bool
+	lastFavorableResolution time.Time
+
+	// reportedUnknownStatuses is the set of unknown status values previously reported,
+	// so they can be reset once no games with that status remain.
+	reportedUnknownStatuses map[uint8]bool
+
+	// reportedFactories is the set of factories previously reported,
+	// so their counts can be reset once no games from that factory remain.
+	reportedFactories map[common.Address]bool
+
+	// reportedL1Chains is the set of L1 chain IDs previously reported,
+	// so their counts can be reset once no games anchored to that chain remain.
+	reportedL1Chains map[uint64]bool
+
+	// groupKey groups games for the grouped agreement counts. nil if games are not grouped.
+	groupKey GroupKey
+
+	// reportedGroups is the set of groups previously reported,
+	// so their counts can be reset once no games in that group remain.
+	reportedGroups map[string]bool
+
+	// trustedProposers are proposers whose in progress games are not expected to remain in disagreement.
+	trustedProposers map[common.Address]bool
+
+	// benignGames are games known to disagree, such as test games, that should not be alerted on.
+	benignGames map[common.Address]bool
+
+	// projections is the projected outcome of each in progress game in the previous forecast.
+	projections map[common.Address]metrics.ProjectedOutcome
+
+	// disagreementRate is the exponentially weighted moving average of the fraction of resolved games we disagree with.
+	// Each cycle with resolved games moves it towards that cycle's rate by disagreementSmoothing.
+	disagreementSmoothing float64
+	disagreementRate      float64
+	hasDisagreementRate   bool
 }
 
-func NewForecast(logger log.Logger, metrics ForecastMetrics) *Forecast {
+// NewForecast creates a new Forecast.
+// In dry-run mode the classification of each game is logged at info level since metrics are not recorded.
+// If history is not nil, the result of each forecast is appended to it.
+// Disagreements in in-progress games proposed by trustedProposers are still counted but are not logged as warnings
+// and don't reset the cycles since the last disagreement.
+// Disagreeing benignGames are reported as ignored rather than in a disagree status and are never alerted on.
+// disagreementSmoothing is the weight, between 0 and 1, given to the latest cycle in the smoothed disagreement rate.
+// If groupKey is not nil, agreement counts are also reported for each group of games it returns.
+func NewForecast(logger log.Logger, metrics ForecastMetrics, clock RClock, dryRun bool, history HistoryRecorder, trustedProposers []common.Address, benignGames []common.Address, disagreementSmoothing float64, groupKey GroupKey) *Forecast {
+	trusted := make(map[common.Address]bool, len(trustedProposers))
+	for _, proposer := range trustedProposers {
+		trusted[proposer] = true
+	}
+	benign := make(map[common.Address]bool, len(benignGames))
+	for _, game := range benignGames {
+		benign[game] = true
+	}
 	return &Forecast{
 		logger:  logger,
 		metrics: metrics,
+		clock:   clock,
+		dryRun:  dryRun,
+		history: history,
+
+		favorableResolutions:    make(map[common.Address]bool),
+		lastFavorableResolution: clock.Now(),
+
+		reportedUnknownStatuses: make(map[uint8]bool),
+		reportedFactories:       make(map[common.Address]bool),
+		reportedL1Chains:        make(map[uint64]bool),
+		groupKey:                groupKey,
+		reportedGroups:          make(map[string]bool),
+		trustedProposers:        trusted,
+		benignGames:             benign,
+		disagreementSmoothing:   disagreementSmoothing,
 	}
 }
 
 func (f *Forecast) Forecast(games []*monTypes.EnrichedGameData, ignoredCount, failedCount int) {
-	batch := forecastBatch{}
+	factoryBatches := make(map[common.Address]*forecastBatch)
+	factoryL1Chains := make(map[common.Address]uint64)
+	groupBatches := make(map[string]*forecastBatch)
 	for _, game := range games {
-		if err := f.forecastGame(game, &batch); err != nil {
+		factoryBatch, ok := factoryBatches[game.Factory]
+		if !ok {
+			factoryBatch = newForecastBatch()
+			factoryBatches[game.Factory] = factoryBatch
+			factoryL1Chains[game.Factory] = game.L1ChainID
+		}
+		gameBatch := factoryBatch
+		if f.groupKey != nil {
+			// Forecast the game on its own so it can be included in both its factory and group batches
+			gameBatch = newForecastBatch()
+		}
+		if err := f.forecastGame(game, gameBatch); err != nil {
 			f.logger.Error("Failed to forecast game", "err", err)
 		}
+		if f.groupKey != nil {
+			factoryBatch.add(gameBatch)
+			group := f.groupKey(game)
+			groupBatch, ok := groupBatches[group]
+			if !ok {
+				groupBatch = newForecastBatch()
+				groupBatches[group] = groupBatch
+			}
+			groupBatch.add(gameBatch)
+		}
+		if f.dryRun {
+			f.logger.Info("Classified game",
+				"game", game.Proxy, "blockNum", game.L2BlockNumber, "status", game.Status,
+				"agreement", game.AgreeWithClaim, "rootClaim", game.RootClaim, "expected", game.ExpectedRootClaim)
+		}
+	}
+	batch := *newForecastBatch()
+	for _, factoryBatch := range factoryBatches {
+		batch = batch.merge(*factoryBatch)
 	}
 	f.recordBatch(batch, ignoredCount, failedCount)
+	f.recordProjectionAccuracy(games, batch.Projections)
+	f.recordFactoryBatches(factoryBatches)
+	f.recordL1ChainBatches(factoryBatches, factoryL1Chains)
+	if f.groupKey != nil {
+		f.recordGroupBatches(groupBatches)
+	}
+	if f.history != nil {
+		f.history.Append(gamesHash(games), batch)
+	}
+}
+
+// recordProjectionAccuracy compares the outcome of games that resolved since the previous forecast against the
+// outcome projected for them in the previous forecast.
+func (f *Forecast) recordProjectionAccuracy(games []*monTypes.EnrichedGameData, projections map[common.Address]metrics.ProjectedOutcome) {
+	outcomes := []metrics.ProjectedOutcome{metrics.ProjectedOutcomeFavorable, metrics.ProjectedOutcomeUnfavorable}
+	counts := make(map[metrics.ProjectedOutcome]map[metrics.ProjectedOutcome]int)
+	for _, outcome := range outcomes {
+		counts[outcome] = make(map[metrics.ProjectedOutcome]int)
+	}
+	for _, game := range games {
+		projected, ok := f.projections[game.Proxy]
+		if !ok || game.Pending || game.Indeterminate {
+			continue
+		}
+		var actual metrics.ProjectedOutcome
+		switch game.Status {
+		case types.GameStatusDefenderWon, types.GameStatusChallengerWon:
+			// Resolved games are favourable if the result matches our agreement with the root claim.
+			if (game.Status == types.GameStatusDefenderWon) == game.AgreeWithClaim {
+				actual = metrics.ProjectedOutcomeFavorable
+			} else {
+				actual = metrics.ProjectedOutcomeUnfavorable
+			}
+		default:
+			continue
+		}
+		if projected != actual {
+			f.logger.Warn("Game resolved differently to its projected outcome",
+				"game", game.Proxy, "blockNum", game.L2BlockNumber, "status", game.Status, "agreement", game.AgreeWithClaim)
+		}
+		counts[projected][actual]++
+	}
+	for _, projected := range outcomes {
+		for _, actual := range outcomes {
+			f.metrics.RecordProjectionAccuracy(projected, actual, counts[projected][actual])
+		}
+	}
+	f.projections = projections
+}
+
+func (f *Forecast) recordFactoryBatches(batches map[common.Address]*forecastBatch) {
+	reported := make(map[common.Address]bool, len(batches))
+	for factory, batch := range batches {
+		for status, count := range batch.agreements() {
+			f.metrics.RecordGameAgreementByFactory(factory, status, count)
+		}
+		reported[factory] = true
+	}
+	// Reset the counts for factories that no longer have any games
+	empty := newForecastBatch()
+	for factory := range f.reportedFactories {
+		if !reported[factory] {
+			for status, count := range empty.agreements() {
+				f.metrics.RecordGameAgreementByFactory(factory, status, count)
+			}
+		}
+	}
+	f.reportedFactories = reported
+}
+
+// recordL1ChainBatches combines the factory batches by the L1 chain each factory is deployed on.
+func (f *Forecast) recordL1ChainBatches(factoryBatches map[common.Address]*forecastBatch, factoryL1Chains map[common.Address]uint64) {
+	batches := make(map[uint64]*forecastBatch)
+	for factory, factoryBatch := range factoryBatches {
+		chainID := factoryL1Chains[factory]
+		batch, ok := batches[chainID]
+		if !ok {
+			batch = newForecastBatch()
+			batches[chainID] = batch
+		}
+		batch.add(factoryBatch)
+	}
+	reported := make(map[uint64]bool, len(batches))
+	for chainID, batch := range batches {
+		for status, count := range batch.agreements() {
+			f.metrics.RecordGameAgreementByL1Chain(chainID, status, count)
+		}
+		reported[chainID] = true
+	}
+	// Reset the counts for L1 chains that no longer have any games
+	empty := newForecastBatch()
+	for chainID := range f.reportedL1Chains {
+		if !reported[chainID] {
+			for status, count := range empty.agreements() {
+				f.metrics.RecordGameAgreementByL1Chain(chainID, status, count)
+			}
+		}
+	}
+	f.reportedL1Chains = reported
+}
+
+func (f *Forecast) recordGroupBatches(batches map[string]*forecastBatch) {
+	reported := make(map[string]bool, len(batches))
+	for group, batch := range batches {
+		for status, count := range batch.agreements() {
+			f.metrics.RecordGameAgreementByGroup(group, status, count)
+		}
+		reported[group] = true
+	}
+	// Reset the counts for groups that no longer have any games
+	empty := newForecastBatch()
+	for group := range f.reportedGroups {
+		if !reported[group] {
+			for status, count := range empty.agreements() {
+				f.metrics.RecordGameAgreementByGroup(group, status, count)
+			}
+		}
+	}
+	f.reportedGroups = reported
+}
+
+func gamesHash(games []*monTypes.EnrichedGameData) common.Hash {
+	proxies := make([]common.Address, len(games))
+	for i, game := range games {
+		proxies[i] = game.Proxy
+	}
+	return history.GamesHash(proxies)
 }
 
 func (f *Forecast) recordBatch(batch forecastBatch, ignoredCount, failedCount int) {
@@ -71,14 +457,124 @@ func (f *Forecast) recordBatch(batch forecastBatch, ignoredCount, failedCount in
 	f.metrics.RecordGameAgreement(metrics.AgreeDefenderAhead, batch.AgreeDefenderAhead)
 	f.metrics.RecordGameAgreement(metrics.DisagreeDefenderAhead, batch.DisagreeDefenderAhead)
 
+	for _, status := range []metrics.GameAgreementStatus{metrics.DisagreeDefenderWins, metrics.DisagreeChallengerWins, metrics.DisagreeChallengerAhead, metrics.DisagreeDefenderAhead} {
+		f.metrics.RecordValueAtRisk(status, batch.valueAtRisk(status))
+	}
+	f.metrics.RecordAtRiskGames(batch.atRisk())
+
+	// Resolved games are favourable if the result matches our agreement with the root claim, so disagreeing games
+	// the challenger won are favourable and agreeing games the challenger won are not. This differs from counting
+	// all agreeing games as favourable, which would report a valid root claim being defeated as a win.
+	f.metrics.RecordHonestActorStanding(batch.AgreeDefenderWins+batch.DisagreeChallengerWins, batch.AgreeChallengerWins+batch.DisagreeDefenderWins)
+	// Games leave the game window so only a game not previously resolved in our favour is a new favourable resolution
+	now := f.clock.Now()
+	for game := range batch.FavorableResolutions {
+		if !f.favorableResolutions[game] {
+			f.lastFavorableResolution = now
+			break
+		}
+	}
+	f.favorableResolutions = batch.FavorableResolutions
+	f.metrics.RecordTimeSinceLastFavorableResolution(now.Sub(f.lastFavorableResolution))
+
+	// In progress games are projected to resolve in our favour if the currently leading side matches our agreement.
+	f.metrics.RecordProjectedOutcome(metrics.ProjectedOutcomeFavorable, batch.AgreeDefenderAhead+batch.DisagreeChallengerAhead)
+	f.metrics.RecordProjectedOutcome(metrics.ProjectedOutcomeUnfavorable, batch.AgreeChallengerAhead+batch.DisagreeDefenderAhead)
+
 	f.metrics.RecordLatestValidProposalL2Block(batch.LatestValidProposalL2Block)
 	f.metrics.RecordLatestProposals(batch.LatestValidProposal, batch.LatestInvalidProposal)
 
-	f.metrics.RecordIgnoredGames(ignoredCount)
+	f.metrics.RecordIgnoredGames(ignoredCount + batch.Benign)
 	f.metrics.RecordFailedGames(failedCount)
+	f.metrics.RecordPendingGames(batch.Pending)
+	f.metrics.RecordIndeterminateGames(batch.Indeterminate)
+	f.metrics.RecordRollupInconsistentGames(batch.RollupInconsistent)
+
+	for raw := range f.reportedUnknownStatuses {
+		if _, ok := batch.UnknownStatuses[raw]; !ok {
+			f.metrics.RecordUnknownStatusGames(raw, 0)
+			delete(f.reportedUnknownStatuses, raw)
+		}
+	}
+	for raw, count := range batch.UnknownStatuses {
+		f.metrics.RecordUnknownStatusGames(raw, count)
+		f.reportedUnknownStatuses[raw] = true
+	}
+
+	if batch.hasDisagreement() {
+		f.cyclesSinceLastDisagreement = 0
+	} else {
+		f.cyclesSinceLastDisagreement++
+	}
+	f.metrics.RecordCyclesSinceLastDisagreement(f.cyclesSinceLastDisagreement)
+
+	f.updateDisagreementRate(batch)
+}
+
+// updateDisagreementRate includes the fraction of resolved games in batch that we disagree with in the smoothed
+// disagreement rate. Cycles without resolved games leave the rate unchanged.
+func (f *Forecast) updateDisagreementRate(batch forecastBatch) {
+	disagree := batch.DisagreeDefenderWins + batch.DisagreeChallengerWins
+	resolved := disagree + batch.AgreeDefenderWins + batch.AgreeChallengerWins
+	if resolved > 0 {
+		rate := float64(disagree) / float64(resolved)
+		if f.hasDisagreementRate {
+			f.disagreementRate += f.disagreementSmoothing * (rate - f.disagreementRate)
+		} else {
+			f.disagreementRate = rate
+			f.hasDisagreementRate = true
+		}
+	}
+	f.metrics.RecordDisagreementRateEWMA(f.disagreementRate)
 }
 
-func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, metrics *forecastBatch) error {
+func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, batch *forecastBatch) error {
+	switch game.Status {
+	case types.GameStatusInProgress, types.GameStatusChallengerWon, types.GameStatusDefenderWon:
+	default:
+		// A contract upgrade may introduce new statuses. Avoid misclassifying them as in progress or resolved.
+		f.logger.Warn("Found game with unknown status",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "status", uint8(game.Status))
+		batch.UnknownStatuses[uint8(game.Status)]++
+		return nil
+	}
+
+	if game.Pending {
+		f.logger.Debug("Game pending until agreement head reaches disputed block",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+		batch.Pending++
+		return nil
+	}
+
+	if game.ImplausibleBlock {
+		// Already reported by the extractor so isn't also counted as indeterminate
+		f.logger.Debug("Not classifying game disputing implausible block",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+		return nil
+	}
+
+	if game.Indeterminate {
+		f.logger.Warn("Unable to determine agreement with game",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+		batch.Indeterminate++
+		return nil
+	}
+
+	if game.RollupInternalInconsistency {
+		// Already logged by the agreement enricher with the inconsistent output
+		f.logger.Debug("Not classifying game with inconsistent rollup node output",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+		batch.RollupInconsistent++
+		return nil
+	}
+
+	if !game.AgreeWithClaim && f.benignGames[game.Proxy] {
+		f.logger.Debug("Ignoring disagreement with known benign game",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim)
+		batch.Benign++
+		return nil
+	}
+
 	// Check the root agreement.
 	agreement := game.AgreeWithClaim
 	expected := game.ExpectedRootClaim
@@ -86,15 +582,15 @@ func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, metrics *foreca
 	expectedResult := types.GameStatusDefenderWon
 	if !agreement {
 		expectedResult = types.GameStatusChallengerWon
-		if metrics.LatestInvalidProposal < game.Timestamp {
-			metrics.LatestInvalidProposal = game.Timestamp
+		if batch.LatestInvalidProposal < game.Timestamp {
+			batch.LatestInvalidProposal = game.Timestamp
 		}
 	} else {
-		if metrics.LatestValidProposal < game.Timestamp {
-			metrics.LatestValidProposal = game.Timestamp
+		if batch.LatestValidProposal < game.Timestamp {
+			batch.LatestValidProposal = game.Timestamp
 		}
-		if metrics.LatestValidProposalL2Block < game.L2BlockNumber {
-			metrics.LatestValidProposalL2Block = game.L2BlockNumber
+		if batch.LatestValidProposalL2Block < game.L2BlockNumber {
+			batch.LatestValidProposalL2Block = game.L2BlockNumber
 		}
 	}
 
@@ -108,15 +604,19 @@ func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, metrics *foreca
 		switch game.Status {
 		case types.GameStatusDefenderWon:
 			if agreement {
-				metrics.AgreeDefenderWins++
+				batch.AgreeDefenderWins++
+				batch.FavorableResolutions[game.Proxy] = true
 			} else {
-				metrics.DisagreeDefenderWins++
+				batch.DisagreeDefenderWins++
+				batch.addValueAtRisk(metrics.DisagreeDefenderWins, game.TotalBond())
 			}
 		case types.GameStatusChallengerWon:
 			if agreement {
-				metrics.AgreeChallengerWins++
+				batch.AgreeChallengerWins++
 			} else {
-				metrics.DisagreeChallengerWins++
+				batch.DisagreeChallengerWins++
+				batch.FavorableResolutions[game.Proxy] = true
+				batch.addValueAtRisk(metrics.DisagreeChallengerWins, game.TotalBond())
 			}
 		}
 		return nil
@@ -136,28 +636,44 @@ func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, metrics *foreca
 		forecastStatus = Resolve(tree)
 	}
 
+	if forecastStatus == expectedResult {
+		batch.Projections[game.Proxy] = metrics.ProjectedOutcomeFavorable
+	} else {
+		batch.Projections[game.Proxy] = metrics.ProjectedOutcomeUnfavorable
+	}
+
 	if agreement {
 		// If we agree with the output root proposal, the Defender should win, defending that claim.
 		if forecastStatus == types.GameStatusChallengerWon {
-			metrics.AgreeChallengerAhead++
+			batch.AgreeChallengerAhead++
 			f.logger.Warn("Forecasting unexpected game result", "status", forecastStatus,
 				"game", game.Proxy, "blockNum", game.L2BlockNumber,
 				"rootClaim", game.RootClaim, "expected", expected)
 		} else {
-			metrics.AgreeDefenderAhead++
+			batch.AgreeDefenderAhead++
 			f.logger.Debug("Forecasting expected game result", "status", forecastStatus,
 				"game", game.Proxy, "blockNum", game.L2BlockNumber,
 				"rootClaim", game.RootClaim, "expected", expected)
 		}
 	} else {
+		trusted := f.isTrustedProposer(game)
+		if trusted {
+			batch.SuppressedDisagreements++
+		}
 		// If we disagree with the output root proposal, the Challenger should win, challenging that claim.
 		if forecastStatus == types.GameStatusDefenderWon {
-			metrics.DisagreeDefenderAhead++
-			f.logger.Warn("Forecasting unexpected game result", "status", forecastStatus,
+			batch.DisagreeDefenderAhead++
+			batch.addValueAtRisk(metrics.DisagreeDefenderAhead, game.TotalBond())
+			logUnexpected := f.logger.Warn
+			if trusted {
+				logUnexpected = f.logger.Info
+			}
+			logUnexpected("Forecasting unexpected game result", "status", forecastStatus,
 				"game", game.Proxy, "blockNum", game.L2BlockNumber,
-				"rootClaim", game.RootClaim, "expected", expected)
+				"rootClaim", game.RootClaim, "expected", expected, "trustedProposer", trusted)
 		} else {
-			metrics.DisagreeChallengerAhead++
+			batch.DisagreeChallengerAhead++
+			batch.addValueAtRisk(metrics.DisagreeChallengerAhead, game.TotalBond())
 			f.logger.Debug("Forecasting expected game result", "status", forecastStatus,
 				"game", game.Proxy, "blockNum", game.L2BlockNumber,
 				"rootClaim", game.RootClaim, "expected", expected)
@@ -166,3 +682,11 @@ func (f *Forecast) forecastGame(game *monTypes.EnrichedGameData, metrics *foreca
 
 	return nil
 }
+
+// isTrustedProposer returns true if the game's root claim was proposed by a trusted proposer.
+func (f *Forecast) isTrustedProposer(game *monTypes.EnrichedGameData) bool {
+	if len(game.Claims) == 0 {
+		return false
+	}
+	return f.trustedProposers[game.Claims[0].Claimant]
+}
@@ -3,12 +3,17 @@ package mon
 import (
 	"math"
 	"math/big"
+	"reflect"
 	"testing"
+	"time"
 
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/history"
 	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -296,6 +301,665 @@ func TestForecast_Forecast_MultipleGames(t *testing.T) {
 	require.EqualValues(t, 8, m.latestValidProposalL2Block)
 	require.EqualValues(t, 7, m.latestInvalidProposal)
 	require.EqualValues(t, 8, m.latestValidProposal)
+	// AgreeChallengerWins and DisagreeDefenderWins are unfavorable
+	require.Equal(t, 2, m.favorableGames)
+	require.Equal(t, 3, m.unfavorableGames)
+}
+
+func TestForecast_ProjectedOutcome(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		// Agree with the root claim and the defender is leading
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+		// Disagree with the root claim and the challenger is leading
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:2]},
+		// Disagree with the root claim because the block number was challenged
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, BlockNumberChallenged: true, Claims: createDeepClaimList()[:1]},
+		// Agree with the root claim but the challenger is leading
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, Claims: createDeepClaimList()[:2]},
+		// Disagree with the root claim but the defender is leading
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:3]},
+		// Resolved games are not projected
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusChallengerWon, AgreeWithClaim: true, Claims: createDeepClaimList()[:2]},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 3, m.projectedOutcomes[metrics.ProjectedOutcomeFavorable])
+	require.Equal(t, 3, m.projectedOutcomes[metrics.ProjectedOutcomeUnfavorable])
+}
+
+func TestForecast_PendingGames(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Pending: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Pending: true, Claims: createDeepClaimList()[:2]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 2, m.pendingGames)
+
+	// Pending games are not classified as disagreeing
+	expectedMetrics := zeroGameAgreement()
+	expectedMetrics[metrics.DisagreeDefenderAhead] = 1
+	require.Equal(t, expectedMetrics, m.gameAgreement)
+}
+
+func TestForecast_IndeterminateGames(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Indeterminate: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: false, Indeterminate: true},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		// Games disputing an implausible block are reported separately
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Indeterminate: true, ImplausibleBlock: true, Claims: createDeepClaimList()[:1]},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 2, m.indeterminateGames)
+
+	// Indeterminate games are not classified as disagreeing
+	expectedMetrics := zeroGameAgreement()
+	expectedMetrics[metrics.DisagreeDefenderAhead] = 1
+	require.Equal(t, expectedMetrics, m.gameAgreement)
+}
+
+func TestForecast_RollupInconsistentGames(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, RollupInternalInconsistency: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: false, RollupInternalInconsistency: true},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 2, m.rollupInconsistentGames)
+	require.Zero(t, m.indeterminateGames)
+
+	// Games with an inconsistent output are not classified by agreement
+	expectedMetrics := zeroGameAgreement()
+	expectedMetrics[metrics.DisagreeDefenderAhead] = 1
+	require.Equal(t, expectedMetrics, m.gameAgreement)
+}
+
+func TestForecast_UnknownStatus(t *testing.T) {
+	forecast, m, logs := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		{GameMetadata: types.GameMetadata{Proxy: common.Address{0x01}}, Status: types.GameStatus(5), AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatus(5), AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatus(200), AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, map[uint8]int{5: 2, 200: 1}, m.unknownStatuses)
+
+	// Games with unknown statuses are not classified
+	expectedMetrics := zeroGameAgreement()
+	expectedMetrics[metrics.AgreeDefenderAhead] = 1
+	require.Equal(t, expectedMetrics, m.gameAgreement)
+
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Found game with unknown status"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0x01}, l.AttrValue("game"))
+	require.EqualValues(t, 5, l.AttrValue("status"))
+
+	// Statuses that are no longer present are reset
+	forecast.Forecast(games[2:], 0, 0)
+	require.Equal(t, map[uint8]int{5: 0, 200: 1}, m.unknownStatuses)
+}
+
+func TestForecast_ProjectionAccuracy(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	newGame := func(proxy byte, status types.GameStatus, agree bool, claims int) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			GameMetadata:   types.GameMetadata{Proxy: common.Address{proxy}},
+			Status:         status,
+			AgreeWithClaim: agree,
+			Claims:         createDeepClaimList()[:claims],
+		}
+	}
+	// The defender leads with a single claim and the challenger leads with two claims
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		newGame(0xaa, types.GameStatusInProgress, true, 1),  // Projected favorable
+		newGame(0xbb, types.GameStatusInProgress, true, 1),  // Projected favorable
+		newGame(0xcc, types.GameStatusInProgress, false, 2), // Projected favorable
+		newGame(0xdd, types.GameStatusInProgress, true, 2),  // Projected unfavorable
+		newGame(0xee, types.GameStatusInProgress, false, 1), // Projected unfavorable
+	}, 0, 0)
+	// No games were projected before the first forecast
+	require.Zero(t, m.projectionAccuracy[metrics.ProjectedOutcomeFavorable][metrics.ProjectedOutcomeFavorable])
+	require.Zero(t, m.projectionAccuracy[metrics.ProjectedOutcomeUnfavorable][metrics.ProjectedOutcomeUnfavorable])
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		newGame(0xaa, types.GameStatusDefenderWon, true, 1),    // Resolved as projected
+		newGame(0xbb, types.GameStatusChallengerWon, true, 1),  // Resolved against projection
+		newGame(0xcc, types.GameStatusChallengerWon, false, 2), // Resolved as projected
+		newGame(0xdd, types.GameStatusChallengerWon, true, 2),  // Resolved as projected
+		newGame(0xee, types.GameStatusInProgress, false, 1),    // Still in progress
+		newGame(0xff, types.GameStatusDefenderWon, true, 1),    // Not previously projected
+	}, 0, 0)
+	require.Equal(t, 2, m.projectionAccuracy[metrics.ProjectedOutcomeFavorable][metrics.ProjectedOutcomeFavorable])
+	require.Equal(t, 1, m.projectionAccuracy[metrics.ProjectedOutcomeFavorable][metrics.ProjectedOutcomeUnfavorable])
+	require.Equal(t, 1, m.projectionAccuracy[metrics.ProjectedOutcomeUnfavorable][metrics.ProjectedOutcomeUnfavorable])
+	require.Zero(t, m.projectionAccuracy[metrics.ProjectedOutcomeUnfavorable][metrics.ProjectedOutcomeFavorable])
+
+	// Resolved games are only compared against the projection from the previous forecast
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		newGame(0xaa, types.GameStatusDefenderWon, true, 1),
+		newGame(0xee, types.GameStatusDefenderWon, false, 1),
+	}, 0, 0)
+	require.Zero(t, m.projectionAccuracy[metrics.ProjectedOutcomeFavorable][metrics.ProjectedOutcomeFavorable])
+	require.Zero(t, m.projectionAccuracy[metrics.ProjectedOutcomeFavorable][metrics.ProjectedOutcomeUnfavorable])
+	require.Equal(t, 1, m.projectionAccuracy[metrics.ProjectedOutcomeUnfavorable][metrics.ProjectedOutcomeUnfavorable])
+}
+
+func TestForecast_AtRiskGames(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	games := []*monTypes.EnrichedGameData{
+		// Resolved games we agree with or that the challenger won are not at risk
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusChallengerWon, AgreeWithClaim: false, Claims: createDeepClaimList()[:2]},
+		{Status: types.GameStatusChallengerWon, AgreeWithClaim: true, Claims: createDeepClaimList()[:2]},
+		// Invalid root claims that won are at risk
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusDefenderWon, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		// In progress games we disagree with are at risk, whichever side is ahead
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:2]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Claims: createDeepClaimList()[:3]},
+		// In progress games we agree with are not at risk
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, Claims: createDeepClaimList()[:1]},
+		{Status: types.GameStatusInProgress, AgreeWithClaim: true, Claims: createDeepClaimList()[:2]},
+		// Pending games are not classified
+		{Status: types.GameStatusInProgress, AgreeWithClaim: false, Pending: true},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 2+3, m.atRiskGames)
+	expected := m.gameAgreement[metrics.DisagreeDefenderWins] + m.gameAgreement[metrics.DisagreeDefenderAhead] + m.gameAgreement[metrics.DisagreeChallengerAhead]
+	require.Equal(t, expected, m.atRiskGames, "should be consistent with the agreement counts")
+
+	forecast.Forecast(nil, 0, 0)
+	require.Zero(t, m.atRiskGames)
+}
+
+func TestForecast_HonestActorStanding(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	newGame := func(status types.GameStatus, agree bool) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{Status: status, AgreeWithClaim: agree, Claims: createDeepClaimList()[:1]}
+	}
+	games := []*monTypes.EnrichedGameData{
+		newGame(types.GameStatusDefenderWon, true),
+		newGame(types.GameStatusDefenderWon, true),
+		newGame(types.GameStatusDefenderWon, true),
+		newGame(types.GameStatusChallengerWon, false),
+		newGame(types.GameStatusChallengerWon, false),
+		newGame(types.GameStatusChallengerWon, true),
+		newGame(types.GameStatusDefenderWon, false),
+		newGame(types.GameStatusDefenderWon, false),
+		newGame(types.GameStatusDefenderWon, false),
+		newGame(types.GameStatusDefenderWon, false),
+		// In progress games are excluded
+		newGame(types.GameStatusInProgress, true),
+		newGame(types.GameStatusInProgress, false),
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Equal(t, 3+2, m.favorableGames)
+	require.Equal(t, 1+4, m.unfavorableGames)
+}
+
+func TestForecast_CyclesSinceLastDisagreement(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	agree := &monTypes.EnrichedGameData{Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: true}
+	disagree := &monTypes.EnrichedGameData{Status: types.GameStatusChallengerWon, RootClaim: common.Hash{0xbb}, AgreeWithClaim: false}
+
+	forecast.Forecast(nil, 0, 0)
+	require.Equal(t, 1, m.cyclesSinceDisagreement)
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{agree}, 0, 0)
+	require.Equal(t, 2, m.cyclesSinceDisagreement)
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{agree, disagree}, 0, 0)
+	require.Equal(t, 0, m.cyclesSinceDisagreement)
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{agree}, 0, 0)
+	require.Equal(t, 1, m.cyclesSinceDisagreement)
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{disagree}, 0, 0)
+	require.Equal(t, 0, m.cyclesSinceDisagreement)
+}
+
+func TestForecast_DisagreementRateEWMA(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	forecast := NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, nil, nil, 0.5, nil)
+	agree := &monTypes.EnrichedGameData{Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: true}
+	disagree := &monTypes.EnrichedGameData{Status: types.GameStatusChallengerWon, RootClaim: common.Hash{0xbb}, AgreeWithClaim: false}
+	inProgress := &monTypes.EnrichedGameData{Status: types.GameStatusInProgress, RootClaim: common.Hash{0xbb}, AgreeWithClaim: false}
+
+	// The first cycle with resolved games initialises the rate
+	forecast.Forecast([]*monTypes.EnrichedGameData{agree, agree, agree, disagree}, 0, 0)
+	require.Equal(t, 0.25, m.disagreementRateEWMA)
+
+	// In progress games are not included and cycles without resolved games leave the rate unchanged
+	forecast.Forecast([]*monTypes.EnrichedGameData{inProgress}, 0, 0)
+	require.Equal(t, 0.25, m.disagreementRateEWMA)
+
+	// Converges towards a sustained rate, halving the remaining distance each cycle
+	expected := []float64{0.625, 0.8125, 0.90625, 0.953125}
+	for _, rate := range expected {
+		forecast.Forecast([]*monTypes.EnrichedGameData{disagree, inProgress}, 0, 0)
+		require.Equal(t, rate, m.disagreementRateEWMA)
+	}
+	for i := 0; i < 50; i++ {
+		forecast.Forecast([]*monTypes.EnrichedGameData{agree}, 0, 0)
+	}
+	require.InDelta(t, 0, m.disagreementRateEWMA, 1e-9)
+}
+
+func TestForecast_TrustedProposers(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlDebug)
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	// The root claim of the deep claim list is proposed by 0x111111
+	forecast := NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, []common.Address{common.HexToAddress("0x111111")}, nil, config.DefaultDisagreementSmoothing, nil)
+	trustedDisagree := &monTypes.EnrichedGameData{
+		GameMetadata:   types.GameMetadata{Proxy: common.Address{0xaa}},
+		Status:         types.GameStatusInProgress,
+		AgreeWithClaim: false,
+		Claims:         createDeepClaimList()[:1],
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{trustedDisagree}, 0, 0)
+	require.Equal(t, 1, m.gameAgreement[metrics.DisagreeDefenderAhead])
+	require.Equal(t, 1, m.cyclesSinceDisagreement)
+	require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter(unexpectedResultLog)))
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelInfo), testlog.NewMessageFilter(unexpectedResultLog))
+	require.NotNil(t, l)
+	require.Equal(t, true, l.AttrValue("trustedProposer"))
+
+	// Disagreements from other proposers are still alerted on
+	untrustedDisagree := &monTypes.EnrichedGameData{
+		Status:         types.GameStatusInProgress,
+		AgreeWithClaim: false,
+		Claims: []monTypes.EnrichedClaim{{Claim: faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{Position: faultTypes.RootPosition},
+			Claimant:  common.Address{0xbb},
+		}}},
+	}
+	forecast.Forecast([]*monTypes.EnrichedGameData{trustedDisagree, untrustedDisagree}, 0, 0)
+	require.Equal(t, 2, m.gameAgreement[metrics.DisagreeDefenderAhead])
+	require.Equal(t, 0, m.cyclesSinceDisagreement)
+	require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter(unexpectedResultLog)))
+}
+
+func TestForecast_BenignGames(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlDebug)
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	benign := common.Address{0xaa}
+	forecast := NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, nil, []common.Address{benign}, config.DefaultDisagreementSmoothing, nil)
+	benignDisagree := &monTypes.EnrichedGameData{
+		GameMetadata:   types.GameMetadata{Proxy: benign},
+		Status:         types.GameStatusInProgress,
+		AgreeWithClaim: false,
+		Claims:         createDeepClaimList()[:1],
+	}
+	benignResolved := &monTypes.EnrichedGameData{
+		GameMetadata:   types.GameMetadata{Proxy: benign},
+		Status:         types.GameStatusDefenderWon,
+		AgreeWithClaim: false,
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{benignDisagree}, 2, 0)
+	require.Equal(t, 3, m.ignoredGames)
+	require.Equal(t, zeroGameAgreement(), m.gameAgreement)
+	require.Equal(t, 1, m.cyclesSinceDisagreement)
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{benignResolved}, 0, 0)
+	require.Equal(t, 1, m.ignoredGames)
+	require.Equal(t, zeroGameAgreement(), m.gameAgreement)
+	require.Equal(t, 2, m.cyclesSinceDisagreement)
+	require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter(unexpectedResultLog)))
+	require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter(lostGameLog)))
+
+	// Benign games that agree are classified as usual
+	benignAgree := &monTypes.EnrichedGameData{
+		GameMetadata:   types.GameMetadata{Proxy: benign},
+		Status:         types.GameStatusDefenderWon,
+		AgreeWithClaim: true,
+	}
+	forecast.Forecast([]*monTypes.EnrichedGameData{benignAgree}, 0, 0)
+	require.Zero(t, m.ignoredGames)
+	require.Equal(t, 1, m.gameAgreement[metrics.AgreeDefenderWins])
+}
+
+func TestForecast_TimeSinceLastFavorableResolution(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	forecast := NewForecast(logger, m, cl, false, nil, nil, nil, config.DefaultDisagreementSmoothing, nil)
+	newGame := func(addr byte, status types.GameStatus, agree bool) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{GameMetadata: types.GameMetadata{Proxy: common.Address{addr}}, Status: status, RootClaim: mockRootClaim, AgreeWithClaim: agree}
+	}
+	agreeWin := newGame(0xaa, types.GameStatusDefenderWon, true)
+	disagreeWin := newGame(0xbb, types.GameStatusChallengerWon, false)
+	unfavorable := newGame(0xcc, types.GameStatusChallengerWon, true)
+
+	forecast.Forecast(nil, 0, 0)
+	require.Zero(t, m.sinceFavorableResolution)
+
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{unfavorable}, 0, 0)
+	require.Equal(t, time.Minute, m.sinceFavorableResolution, "should grow from startup")
+
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{unfavorable, agreeWin}, 0, 0)
+	require.Zero(t, m.sinceFavorableResolution)
+
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{unfavorable, agreeWin}, 0, 0)
+	require.Equal(t, time.Minute, m.sinceFavorableResolution, "should not reset for an already resolved game")
+
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{agreeWin, disagreeWin}, 0, 0)
+	require.Zero(t, m.sinceFavorableResolution)
+
+	// Games leaving the game window are not a favourable resolution
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{disagreeWin}, 0, 0)
+	require.Equal(t, time.Minute, m.sinceFavorableResolution)
+
+	// Even if another game resolves in our favour in the same cycle as one leaves the window
+	cl.AdvanceTime(time.Minute)
+	forecast.Forecast([]*monTypes.EnrichedGameData{newGame(0xdd, types.GameStatusDefenderWon, true)}, 0, 0)
+	require.Zero(t, m.sinceFavorableResolution, "should reset when the favourable count is unchanged")
+}
+
+func TestForecast_History(t *testing.T) {
+	forecast, _, _ := setupForecastTest(t)
+	recorder := &stubHistoryRecorder{}
+	forecast.history = recorder
+	games := []*monTypes.EnrichedGameData{
+		{GameMetadata: types.GameMetadata{Proxy: common.Address{0xaa}}, Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: true},
+		{GameMetadata: types.GameMetadata{Proxy: common.Address{0xbb}}, Status: types.GameStatusInProgress, RootClaim: mockRootClaim, AgreeWithClaim: false},
+	}
+	forecast.Forecast(games, 0, 0)
+	require.Len(t, recorder.batches, 1)
+	require.Equal(t, history.GamesHash([]common.Address{{0xaa}, {0xbb}}), recorder.hashes[0])
+	batch := recorder.batches[0].(forecastBatch)
+	require.Equal(t, 1, batch.AgreeDefenderWins)
+	require.Equal(t, 1, batch.DisagreeDefenderAhead)
+}
+
+func TestForecast_GameAgreementByFactory(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	factory1 := common.Address{0xf1}
+	factory2 := common.Address{0xf2}
+	disagree := func(factory common.Address) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{Factory: factory, Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: false}
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{disagree(factory1), disagree(factory1), disagree(factory2)}, 0, 0)
+	require.Equal(t, 3, m.gameAgreement[metrics.DisagreeDefenderWins])
+	require.Equal(t, 2, m.gameAgreementByFactory[factory1][metrics.DisagreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByFactory[factory2][metrics.DisagreeDefenderWins])
+	require.Zero(t, m.gameAgreementByFactory[factory2][metrics.AgreeDefenderWins])
+
+	// Counts are reset once a factory has no games
+	forecast.Forecast([]*monTypes.EnrichedGameData{disagree(factory1)}, 0, 0)
+	require.Equal(t, 1, m.gameAgreement[metrics.DisagreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByFactory[factory1][metrics.DisagreeDefenderWins])
+	require.Zero(t, m.gameAgreementByFactory[factory2][metrics.DisagreeDefenderWins])
+}
+
+func TestForecast_GameAgreementByL1Chain(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	mainnet := uint64(1)
+	sepolia := uint64(11155111)
+	game := func(factory common.Address, l1ChainID uint64, agree bool) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{Factory: factory, L1ChainID: l1ChainID, Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: agree}
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		game(common.Address{0xf1}, mainnet, true),
+		game(common.Address{0xf1}, mainnet, false),
+		game(common.Address{0xf2}, mainnet, false),
+		game(common.Address{0xf3}, sepolia, true),
+	}, 0, 0)
+	require.Equal(t, 1, m.gameAgreementByL1Chain[mainnet][metrics.AgreeDefenderWins])
+	require.Equal(t, 2, m.gameAgreementByL1Chain[mainnet][metrics.DisagreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByL1Chain[sepolia][metrics.AgreeDefenderWins])
+	require.Zero(t, m.gameAgreementByL1Chain[sepolia][metrics.DisagreeDefenderWins])
+
+	// Counts are reset once an L1 chain has no games
+	forecast.Forecast([]*monTypes.EnrichedGameData{game(common.Address{0xf3}, sepolia, false)}, 0, 0)
+	require.Zero(t, m.gameAgreementByL1Chain[mainnet][metrics.AgreeDefenderWins])
+	require.Zero(t, m.gameAgreementByL1Chain[mainnet][metrics.DisagreeDefenderWins])
+	require.Zero(t, m.gameAgreementByL1Chain[sepolia][metrics.AgreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByL1Chain[sepolia][metrics.DisagreeDefenderWins])
+}
+
+func TestForecast_GameAgreementByGroup(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	forecast := NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, nil, nil, config.DefaultDisagreementSmoothing, GroupByGameType)
+	game := func(factory common.Address, gameType uint32, agree bool) *monTypes.EnrichedGameData {
+		return &monTypes.EnrichedGameData{
+			GameMetadata:   types.GameMetadata{GameType: gameType},
+			Factory:        factory,
+			Status:         types.GameStatusDefenderWon,
+			RootClaim:      mockRootClaim,
+			AgreeWithClaim: agree,
+		}
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		game(common.Address{0xf1}, 0, true),
+		game(common.Address{0xf1}, 1, false),
+		game(common.Address{0xf2}, 0, true),
+		game(common.Address{0xf2}, 0, false),
+	}, 0, 0)
+	require.Equal(t, 2, m.gameAgreementByGroup["0"][metrics.AgreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByGroup["0"][metrics.DisagreeDefenderWins])
+	require.Zero(t, m.gameAgreementByGroup["1"][metrics.AgreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByGroup["1"][metrics.DisagreeDefenderWins])
+	// Grouping doesn't change the other aggregate counts
+	require.Equal(t, 2, m.gameAgreement[metrics.AgreeDefenderWins])
+	require.Equal(t, 2, m.gameAgreement[metrics.DisagreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByFactory[common.Address{0xf1}][metrics.AgreeDefenderWins])
+	require.Equal(t, 1, m.gameAgreementByFactory[common.Address{0xf2}][metrics.DisagreeDefenderWins])
+
+	// Counts are reset once a group has no games
+	forecast.Forecast([]*monTypes.EnrichedGameData{game(common.Address{0xf1}, 0, true)}, 0, 0)
+	require.Equal(t, 1, m.gameAgreementByGroup["0"][metrics.AgreeDefenderWins])
+	require.Zero(t, m.gameAgreementByGroup["0"][metrics.DisagreeDefenderWins])
+	require.Zero(t, m.gameAgreementByGroup["1"][metrics.DisagreeDefenderWins])
+}
+
+func TestForecast_ValueAtRisk(t *testing.T) {
+	forecast, m, _ := setupForecastTest(t)
+	bonded := func(status types.GameStatus, agree bool, bonds ...int64) *monTypes.EnrichedGameData {
+		game := &monTypes.EnrichedGameData{Status: status, RootClaim: mockRootClaim, AgreeWithClaim: agree}
+		for _, bond := range bonds {
+			game.Claims = append(game.Claims, monTypes.EnrichedClaim{Claim: faultTypes.Claim{ClaimData: faultTypes.ClaimData{Bond: big.NewInt(bond)}}})
+		}
+		return game
+	}
+
+	forecast.Forecast([]*monTypes.EnrichedGameData{
+		bonded(types.GameStatusDefenderWon, false, 100, 20),
+		bonded(types.GameStatusDefenderWon, false, 5),
+		bonded(types.GameStatusChallengerWon, false, 7, 3),
+		// Agreeing games are not at risk
+		bonded(types.GameStatusDefenderWon, true, 1000),
+	}, 0, 0)
+	require.Equal(t, big.NewInt(125), m.valueAtRisk[metrics.DisagreeDefenderWins])
+	require.Equal(t, big.NewInt(10), m.valueAtRisk[metrics.DisagreeChallengerWins])
+	require.Zero(t, m.valueAtRisk[metrics.DisagreeDefenderAhead].Sign())
+	require.Zero(t, m.valueAtRisk[metrics.DisagreeChallengerAhead].Sign())
+	require.NotContains(t, m.valueAtRisk, metrics.AgreeDefenderWins)
+
+	// Values are reset once the games are no longer disagreeing
+	forecast.Forecast([]*monTypes.EnrichedGameData{bonded(types.GameStatusDefenderWon, true, 1000)}, 0, 0)
+	require.Zero(t, m.valueAtRisk[metrics.DisagreeDefenderWins].Sign())
+	require.Zero(t, m.valueAtRisk[metrics.DisagreeChallengerWins].Sign())
+}
+
+type stubHistoryRecorder struct {
+	hashes  []common.Hash
+	batches []any
+}
+
+func (s *stubHistoryRecorder) Append(gamesHash common.Hash, batch any) {
+	s.hashes = append(s.hashes, gamesHash)
+	s.batches = append(s.batches, batch)
+}
+
+func TestForecastBatch_Merge(t *testing.T) {
+	// populated returns a batch with every field set, scaled by n so merged values are distinguishable.
+	populated := func(n int, game common.Address) forecastBatch {
+		return forecastBatch{
+			AgreeDefenderAhead:         1 * n,
+			DisagreeDefenderAhead:      2 * n,
+			AgreeChallengerAhead:       3 * n,
+			DisagreeChallengerAhead:    4 * n,
+			AgreeDefenderWins:          5 * n,
+			DisagreeDefenderWins:       6 * n,
+			AgreeChallengerWins:        7 * n,
+			DisagreeChallengerWins:     8 * n,
+			LatestValidProposalL2Block: uint64(100 * n),
+			LatestInvalidProposal:      uint64(200 * n),
+			LatestValidProposal:        uint64(300 * n),
+			Pending:                    9 * n,
+			Indeterminate:              10 * n,
+			RollupInconsistent:         13 * n,
+			SuppressedDisagreements:    11 * n,
+			Benign:                     12 * n,
+			UnknownStatuses:            map[uint8]int{5: n},
+			ValueAtRisk:                map[metrics.GameAgreementStatus]*big.Int{metrics.DisagreeDefenderWins: big.NewInt(int64(1000 * n))},
+			Projections:                map[common.Address]metrics.ProjectedOutcome{game: metrics.ProjectedOutcomeFavorable},
+			FavorableResolutions:       map[common.Address]bool{game: true},
+		}
+	}
+	empty := *newForecastBatch()
+	gameA := common.Address{0xaa}
+	gameB := common.Address{0xbb}
+
+	tests := []struct {
+		name     string
+		a        forecastBatch
+		b        forecastBatch
+		expected forecastBatch
+	}{
+		{
+			name:     "Empty",
+			a:        empty,
+			b:        empty,
+			expected: empty,
+		},
+		{
+			name:     "IntoEmpty",
+			a:        empty,
+			b:        populated(1, gameA),
+			expected: populated(1, gameA),
+		},
+		{
+			name:     "FromEmpty",
+			a:        populated(1, gameA),
+			b:        empty,
+			expected: populated(1, gameA),
+		},
+		{
+			name: "SumsCountsAndTakesLatest",
+			a:    populated(1, gameA),
+			b:    populated(2, gameB),
+			expected: forecastBatch{
+				AgreeDefenderAhead:         3,
+				DisagreeDefenderAhead:      6,
+				AgreeChallengerAhead:       9,
+				DisagreeChallengerAhead:    12,
+				AgreeDefenderWins:          15,
+				DisagreeDefenderWins:       18,
+				AgreeChallengerWins:        21,
+				DisagreeChallengerWins:     24,
+				LatestValidProposalL2Block: 200,
+				LatestInvalidProposal:      400,
+				LatestValidProposal:        600,
+				Pending:                    27,
+				Indeterminate:              30,
+				RollupInconsistent:         39,
+				SuppressedDisagreements:    33,
+				Benign:                     36,
+				UnknownStatuses:            map[uint8]int{5: 3},
+				ValueAtRisk:                map[metrics.GameAgreementStatus]*big.Int{metrics.DisagreeDefenderWins: big.NewInt(3000)},
+				Projections: map[common.Address]metrics.ProjectedOutcome{
+					gameA: metrics.ProjectedOutcomeFavorable,
+					gameB: metrics.ProjectedOutcomeFavorable,
+				},
+				FavorableResolutions: map[common.Address]bool{gameA: true, gameB: true},
+			},
+		},
+		{
+			name: "DistinctMapKeys",
+			a: forecastBatch{
+				UnknownStatuses:      map[uint8]int{5: 1},
+				ValueAtRisk:          map[metrics.GameAgreementStatus]*big.Int{metrics.DisagreeDefenderWins: big.NewInt(10)},
+				Projections:          map[common.Address]metrics.ProjectedOutcome{gameA: metrics.ProjectedOutcomeFavorable},
+				FavorableResolutions: map[common.Address]bool{gameA: true},
+			},
+			b: forecastBatch{
+				UnknownStatuses:      map[uint8]int{6: 2},
+				ValueAtRisk:          map[metrics.GameAgreementStatus]*big.Int{metrics.DisagreeChallengerAhead: big.NewInt(20)},
+				Projections:          map[common.Address]metrics.ProjectedOutcome{gameB: metrics.ProjectedOutcomeUnfavorable},
+				FavorableResolutions: map[common.Address]bool{gameB: true},
+			},
+			expected: forecastBatch{
+				UnknownStatuses: map[uint8]int{5: 1, 6: 2},
+				ValueAtRisk: map[metrics.GameAgreementStatus]*big.Int{
+					metrics.DisagreeDefenderWins:    big.NewInt(10),
+					metrics.DisagreeChallengerAhead: big.NewInt(20),
+				},
+				Projections: map[common.Address]metrics.ProjectedOutcome{
+					gameA: metrics.ProjectedOutcomeFavorable,
+					gameB: metrics.ProjectedOutcomeUnfavorable,
+				},
+				FavorableResolutions: map[common.Address]bool{gameA: true, gameB: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			merged := test.a.merge(test.b)
+			// Empty maps are equivalent to nil maps
+			if len(test.expected.UnknownStatuses) == 0 {
+				test.expected.UnknownStatuses = map[uint8]int{}
+			}
+			if len(test.expected.Projections) == 0 {
+				test.expected.Projections = map[common.Address]metrics.ProjectedOutcome{}
+			}
+			if len(test.expected.FavorableResolutions) == 0 {
+				test.expected.FavorableResolutions = map[common.Address]bool{}
+			}
+			require.Equal(t, test.expected, merged)
+		})
+	}
+
+	t.Run("DoesNotModifyInputs", func(t *testing.T) {
+		a := populated(1, gameA)
+		b := populated(2, gameB)
+		merged := a.merge(b)
+		merged.UnknownStatuses[5] = 100
+		merged.ValueAtRisk[metrics.DisagreeDefenderWins].SetInt64(0)
+		merged.Projections[common.Address{0xcc}] = metrics.ProjectedOutcomeUnfavorable
+		merged.FavorableResolutions[common.Address{0xcc}] = true
+		require.Equal(t, populated(1, gameA), a)
+		require.Equal(t, populated(2, gameB), b)
+	})
+
+	t.Run("CoversEveryField", func(t *testing.T) {
+		// Fails if a field is added to forecastBatch without being included in the populated batch above
+		value := reflect.ValueOf(populated(1, gameA))
+		for i := 0; i < value.NumField(); i++ {
+			require.False(t, value.Field(i).IsZero(), "field %v not populated", value.Type().Field(i).Name)
+		}
+	})
 }
 
 func setupForecastTest(t *testing.T) (*Forecast, *mockForecastMetrics, *testlog.CapturingHandler) {
@@ -303,7 +967,7 @@ func setupForecastTest(t *testing.T) (*Forecast, *mockForecastMetrics, *testlog.
 	m := &mockForecastMetrics{
 		gameAgreement: zeroGameAgreement(),
 	}
-	return NewForecast(logger, m), m, capturedLogs
+	return NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, nil, nil, config.DefaultDisagreementSmoothing, nil), m, capturedLogs
 }
 
 func zeroGameAgreement() map[metrics.GameAgreementStatus]int {
@@ -321,11 +985,84 @@ func zeroGameAgreement() map[metrics.GameAgreementStatus]int {
 
 type mockForecastMetrics struct {
 	gameAgreement              map[metrics.GameAgreementStatus]int
+	gameAgreementByFactory     map[common.Address]map[metrics.GameAgreementStatus]int
+	gameAgreementByL1Chain     map[uint64]map[metrics.GameAgreementStatus]int
+	gameAgreementByGroup       map[string]map[metrics.GameAgreementStatus]int
+	valueAtRisk                map[metrics.GameAgreementStatus]*big.Int
 	ignoredGames               int
 	latestValidProposalL2Block uint64
 	latestInvalidProposal      uint64
 	latestValidProposal        uint64
 	contractCreationFails      int
+	cyclesSinceDisagreement    int
+	projectedOutcomes          map[metrics.ProjectedOutcome]int
+	unknownStatuses            map[uint8]int
+	favorableGames             int
+	unfavorableGames           int
+	pendingGames               int
+	indeterminateGames         int
+	rollupInconsistentGames    int
+	sinceFavorableResolution   time.Duration
+	disagreementRateEWMA       float64
+	projectionAccuracy         map[metrics.ProjectedOutcome]map[metrics.ProjectedOutcome]int
+	atRiskGames                int
+}
+
+func (m *mockForecastMetrics) RecordAtRiskGames(count int) {
+	m.atRiskGames = count
+}
+
+func (m *mockForecastMetrics) RecordProjectionAccuracy(projected, actual metrics.ProjectedOutcome, count int) {
+	if m.projectionAccuracy == nil {
+		m.projectionAccuracy = make(map[metrics.ProjectedOutcome]map[metrics.ProjectedOutcome]int)
+	}
+	if m.projectionAccuracy[projected] == nil {
+		m.projectionAccuracy[projected] = make(map[metrics.ProjectedOutcome]int)
+	}
+	m.projectionAccuracy[projected][actual] = count
+}
+
+func (m *mockForecastMetrics) RecordDisagreementRateEWMA(rate float64) {
+	m.disagreementRateEWMA = rate
+}
+
+func (m *mockForecastMetrics) RecordTimeSinceLastFavorableResolution(dur time.Duration) {
+	m.sinceFavorableResolution = dur
+}
+
+func (m *mockForecastMetrics) RecordPendingGames(count int) {
+	m.pendingGames = count
+}
+
+func (m *mockForecastMetrics) RecordIndeterminateGames(count int) {
+	m.indeterminateGames = count
+}
+
+func (m *mockForecastMetrics) RecordRollupInconsistentGames(count int) {
+	m.rollupInconsistentGames = count
+}
+
+func (m *mockForecastMetrics) RecordHonestActorStanding(favorable, unfavorable int) {
+	m.favorableGames = favorable
+	m.unfavorableGames = unfavorable
+}
+
+func (m *mockForecastMetrics) RecordUnknownStatusGames(raw uint8, count int) {
+	if m.unknownStatuses == nil {
+		m.unknownStatuses = make(map[uint8]int)
+	}
+	m.unknownStatuses[raw] = count
+}
+
+func (m *mockForecastMetrics) RecordProjectedOutcome(outcome metrics.ProjectedOutcome, count int) {
+	if m.projectedOutcomes == nil {
+		m.projectedOutcomes = make(map[metrics.ProjectedOutcome]int)
+	}
+	m.projectedOutcomes[outcome] = count
+}
+
+func (m *mockForecastMetrics) RecordCyclesSinceLastDisagreement(count int) {
+	m.cyclesSinceDisagreement = count
 }
 
 func (m *mockForecastMetrics) RecordFailedGames(count int) {
@@ -336,6 +1073,43 @@ func (m *mockForecastMetrics) RecordGameAgreement(status metrics.GameAgreementSt
 	m.gameAgreement[status] = count
 }
 
+func (m *mockForecastMetrics) RecordGameAgreementByFactory(factory common.Address, status metrics.GameAgreementStatus, count int) {
+	if m.gameAgreementByFactory == nil {
+		m.gameAgreementByFactory = make(map[common.Address]map[metrics.GameAgreementStatus]int)
+	}
+	if m.gameAgreementByFactory[factory] == nil {
+		m.gameAgreementByFactory[factory] = make(map[metrics.GameAgreementStatus]int)
+	}
+	m.gameAgreementByFactory[factory][status] = count
+}
+
+func (m *mockForecastMetrics) RecordGameAgreementByL1Chain(chainID uint64, status metrics.GameAgreementStatus, count int) {
+	if m.gameAgreementByL1Chain == nil {
+		m.gameAgreementByL1Chain = make(map[uint64]map[metrics.GameAgreementStatus]int)
+	}
+	if m.gameAgreementByL1Chain[chainID] == nil {
+		m.gameAgreementByL1Chain[chainID] = make(map[metrics.GameAgreementStatus]int)
+	}
+	m.gameAgreementByL1Chain[chainID][status] = count
+}
+
+func (m *mockForecastMetrics) RecordGameAgreementByGroup(group string, status metrics.GameAgreementStatus, count int) {
+	if m.gameAgreementByGroup == nil {
+		m.gameAgreementByGroup = make(map[string]map[metrics.GameAgreementStatus]int)
+	}
+	if m.gameAgreementByGroup[group] == nil {
+		m.gameAgreementByGroup[group] = make(map[metrics.GameAgreementStatus]int)
+	}
+	m.gameAgreementByGroup[group][status] = count
+}
+
+func (m *mockForecastMetrics) RecordValueAtRisk(status metrics.GameAgreementStatus, wei *big.Int) {
+	if m.valueAtRisk == nil {
+		m.valueAtRisk = make(map[metrics.GameAgreementStatus]*big.Int)
+	}
+	m.valueAtRisk[status] = wei
+}
+
 func (m *mockForecastMetrics) RecordLatestValidProposalL2Block(valid uint64) {
 	m.latestValidProposalL2Block = valid
 }
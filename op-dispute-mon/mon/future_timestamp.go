@@ -0,0 +1,43 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type FutureTimestampMetrics interface {
+	RecordFutureTimestampGames(count int)
+}
+
+// FutureTimestampMonitor reports games created further in the future than the allowed clock skew.
+// Such games indicate clock skew or manipulation and their age based results are unreliable.
+type FutureTimestampMonitor struct {
+	logger        log.Logger
+	clock         RClock
+	metrics       FutureTimestampMetrics
+	skewTolerance time.Duration
+}
+
+func NewFutureTimestampMonitor(logger log.Logger, metrics FutureTimestampMetrics, clock RClock, skewTolerance time.Duration) *FutureTimestampMonitor {
+	return &FutureTimestampMonitor{
+		logger:        logger,
+		clock:         clock,
+		metrics:       metrics,
+		skewTolerance: skewTolerance,
+	}
+}
+
+func (f *FutureTimestampMonitor) CheckFutureTimestamps(games []*types.EnrichedGameData) {
+	maxTimestamp := uint64(f.clock.Now().Add(f.skewTolerance).Unix())
+	count := 0
+	for _, game := range games {
+		if game.Timestamp > maxTimestamp {
+			f.logger.Error("Found game with future timestamp",
+				"game", game.Proxy, "timestamp", game.Timestamp, "maxTimestamp", maxTimestamp)
+			count++
+		}
+	}
+	f.metrics.RecordFutureTimestampGames(count)
+}
@@ -0,0 +1,43 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorFutureTimestamps(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	games := []*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x11}, Timestamp: 5_000}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x22}, Timestamp: 10_000}},
+		// Within clock skew tolerance
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x33}, Timestamp: 10_030}},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x44}, Timestamp: 10_031}},
+	}
+	metrics := &stubFutureTimestampMetrics{}
+	logger, capturedLogs := testlog.CaptureLogger(t, log.LvlDebug)
+	monitor := NewFutureTimestampMonitor(logger, metrics, clock.NewDeterministicClock(now), 30*time.Second)
+	monitor.CheckFutureTimestamps(games)
+	require.Equal(t, 1, metrics.count)
+
+	l := capturedLogs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Found game with future timestamp"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0x44}, l.AttrValue("game"))
+	require.EqualValues(t, 10_031, l.AttrValue("timestamp"))
+}
+
+type stubFutureTimestampMetrics struct {
+	count int
+}
+
+func (s *stubFutureTimestampMetrics) RecordFutureTimestampGames(count int) {
+	s.count = count
+}
@@ -0,0 +1,47 @@
+package mon
+
+import (
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+// gameStatusLabels are the metric labels of each known game status.
+// Games with any other status are counted as unknownGameStatusLabel.
+var gameStatusLabels = map[gameTypes.GameStatus]string{
+	gameTypes.GameStatusInProgress:    "in_progress",
+	gameTypes.GameStatusChallengerWon: "challenger_won",
+	gameTypes.GameStatusDefenderWon:   "defender_won",
+}
+
+const unknownGameStatusLabel = "unknown"
+
+type GameStatusMetrics interface {
+	RecordGamesByStatus(status string, count int)
+}
+
+// GameStatusMonitor reports the number of games with each status.
+type GameStatusMonitor struct {
+	metrics GameStatusMetrics
+}
+
+func NewGameStatusMonitor(metrics GameStatusMetrics) *GameStatusMonitor {
+	return &GameStatusMonitor{
+		metrics: metrics,
+	}
+}
+
+func (m *GameStatusMonitor) CheckGameStatuses(games []*types.EnrichedGameData) {
+	counts := make(map[string]int)
+	for _, game := range games {
+		label, ok := gameStatusLabels[game.Status]
+		if !ok {
+			label = unknownGameStatusLabel
+		}
+		counts[label]++
+	}
+	// Always record every status so counts are reset once no games have that status
+	for _, label := range gameStatusLabels {
+		m.metrics.RecordGamesByStatus(label, counts[label])
+	}
+	m.metrics.RecordGamesByStatus(unknownGameStatusLabel, counts[unknownGameStatusLabel])
+}
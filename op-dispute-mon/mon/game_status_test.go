@@ -0,0 +1,42 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckGameStatuses(t *testing.T) {
+	metrics := &stubGameStatusMetrics{counts: make(map[string]int)}
+	monitor := NewGameStatusMonitor(metrics)
+	monitor.CheckGameStatuses([]*types.EnrichedGameData{
+		{Status: gameTypes.GameStatusInProgress},
+		{Status: gameTypes.GameStatusInProgress},
+		{Status: gameTypes.GameStatusDefenderWon},
+		{Status: gameTypes.GameStatus(7)},
+	})
+	require.Equal(t, map[string]int{
+		"in_progress":    2,
+		"challenger_won": 0,
+		"defender_won":   1,
+		"unknown":        1,
+	}, metrics.counts)
+
+	monitor.CheckGameStatuses(nil)
+	require.Equal(t, map[string]int{
+		"in_progress":    0,
+		"challenger_won": 0,
+		"defender_won":   0,
+		"unknown":        0,
+	}, metrics.counts)
+}
+
+type stubGameStatusMetrics struct {
+	counts map[string]int
+}
+
+func (s *stubGameStatusMetrics) RecordGamesByStatus(status string, count int) {
+	s.counts[status] = count
+}
@@ -0,0 +1,46 @@
+package mon
+
+import (
+	"strconv"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+// GroupKey returns the group a game's agreement is counted in when reporting grouped agreement counts.
+type GroupKey func(game *types.EnrichedGameData) string
+
+// GroupKeyFor returns the GroupKey for the specified grouping, or nil if games are not grouped.
+func GroupKeyFor(grouping types.GameGrouping) GroupKey {
+	switch grouping {
+	case types.GameGroupingFactory:
+		return GroupByFactory
+	case types.GameGroupingGameType:
+		return GroupByGameType
+	case types.GameGroupingProposer:
+		return GroupByProposer
+	case types.GameGroupingL2Chain:
+		return GroupByL2Chain
+	default:
+		return nil
+	}
+}
+
+func GroupByFactory(game *types.EnrichedGameData) string {
+	return game.Factory.Hex()
+}
+
+func GroupByGameType(game *types.EnrichedGameData) string {
+	return strconv.FormatUint(uint64(game.GameType), 10)
+}
+
+// GroupByProposer groups games by the claimant of the root claim. Games without claims are grouped together.
+func GroupByProposer(game *types.EnrichedGameData) string {
+	if len(game.Claims) == 0 {
+		return ""
+	}
+	return game.Claims[0].Claimant.Hex()
+}
+
+func GroupByL2Chain(game *types.EnrichedGameData) string {
+	return strconv.FormatUint(game.L2ChainID, 10)
+}
@@ -0,0 +1,37 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type HighActivityMetrics interface {
+	RecordHighActivityGames(count int)
+}
+
+// HighActivityMonitor reports in-progress games where more moves and steps have been made than the threshold.
+// An unusually active game may indicate a griefing attempt against the honest actors.
+type HighActivityMonitor struct {
+	logger    log.Logger
+	metrics   HighActivityMetrics
+	threshold uint
+}
+
+func NewHighActivityMonitor(logger log.Logger, metrics HighActivityMetrics, threshold uint) *HighActivityMonitor {
+	return &HighActivityMonitor{
+		logger:    logger,
+		metrics:   metrics,
+		threshold: threshold,
+	}
+}
+
+func (m *HighActivityMonitor) CheckHighActivity(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if uint(game.Moves+game.Steps) > m.threshold {
+			m.logger.Warn("Unusually high activity in game", "game", game.Proxy, "moves", game.Moves, "steps", game.Steps, "threshold", m.threshold)
+			count++
+		}
+	}
+	m.metrics.RecordHighActivityGames(count)
+}
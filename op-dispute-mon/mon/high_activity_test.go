@@ -0,0 +1,38 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHighActivity(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubHighActivityMetrics{}
+	monitor := NewHighActivityMonitor(logger, metrics, 10)
+	monitor.CheckHighActivity([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, Moves: 8, Steps: 2},  // At the threshold
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}, Moves: 10, Steps: 1}, // Just over the threshold
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}, Moves: 3},
+	})
+	require.Equal(t, 1, metrics.count)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Unusually high activity in game"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xbb}, l.AttrValue("game"))
+
+	monitor.CheckHighActivity(nil)
+	require.Zero(t, metrics.count)
+}
+
+type stubHighActivityMetrics struct {
+	count int
+}
+
+func (s *stubHighActivityMetrics) RecordHighActivityGames(count int) {
+	s.count = count
+}
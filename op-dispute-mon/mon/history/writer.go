@@ -0,0 +1,242 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// bufferSize is the number of records that may be queued before new records are dropped.
+const bufferSize = 100
+
+type Metrics interface {
+	RecordHistoryWriteErrors()
+}
+
+type Clock interface {
+	Now() time.Time
+}
+
+// Rotation configures when the history file is rotated.
+type Rotation struct {
+	MaxSize uint64 // Rotate before the file would exceed this many bytes. 0 to disable.
+	Daily   bool   // Rotate when the UTC day changes.
+}
+
+// Record is a single monitoring cycle written to the history file.
+type Record struct {
+	Timestamp uint64          `json:"timestamp"`
+	GamesHash common.Hash     `json:"gamesHash"`
+	Batch     json.RawMessage `json:"batch"`
+}
+
+// entry is an encoded line queued to be written, along with the time it was appended.
+type entry struct {
+	timestamp uint64
+	line      []byte
+}
+
+// Writer appends a record for each monitoring cycle to a file as JSON lines.
+// Writes are best-effort and performed in the background so they never block monitoring.
+// Rotated files are renamed with the time of rotation appended.
+type Writer struct {
+	logger   log.Logger
+	metrics  Metrics
+	clock    Clock
+	path     string
+	rotation Rotation
+
+	records chan entry
+	done    chan struct{}
+
+	// Only accessed from the write loop.
+	file *os.File
+	size uint64
+	day  string
+}
+
+func NewWriter(logger log.Logger, metrics Metrics, clock Clock, path string, rotation Rotation) *Writer {
+	w := &Writer{
+		logger:   logger,
+		metrics:  metrics,
+		clock:    clock,
+		path:     path,
+		rotation: rotation,
+		records:  make(chan entry, bufferSize),
+		done:     make(chan struct{}),
+	}
+	go w.loop()
+	return w
+}
+
+// GamesHash returns a hash identifying the set of games, independent of their order.
+func GamesHash(games []common.Address) common.Hash {
+	sorted := make([]common.Address, len(games))
+	copy(sorted, games)
+	slices.SortFunc(sorted, func(a, b common.Address) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	data := make([]byte, 0, len(sorted)*common.AddressLength)
+	for _, game := range sorted {
+		data = append(data, game.Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// Append queues a record of the batch to be written.
+// If the queue is full the record is dropped and counted as a write error.
+func (w *Writer) Append(gamesHash common.Hash, batch any) {
+	encoded, err := json.Marshal(batch)
+	if err != nil {
+		w.logger.Warn("Failed to encode history record", "err", err)
+		w.metrics.RecordHistoryWriteErrors()
+		return
+	}
+	timestamp := uint64(w.clock.Now().Unix())
+	w.enqueue(timestamp, Record{
+		Timestamp: timestamp,
+		GamesHash: gamesHash,
+		Batch:     encoded,
+	})
+}
+
+// AppendEvent queues event to be written as its own line, without wrapping it in a Record.
+// If the queue is full the event is dropped and counted as a write error.
+func (w *Writer) AppendEvent(event any) {
+	w.enqueue(uint64(w.clock.Now().Unix()), event)
+}
+
+func (w *Writer) enqueue(timestamp uint64, value any) {
+	line, err := json.Marshal(value)
+	if err != nil {
+		w.logger.Warn("Failed to encode history record", "err", err)
+		w.metrics.RecordHistoryWriteErrors()
+		return
+	}
+	select {
+	case w.records <- entry{timestamp: timestamp, line: append(line, '\n')}:
+	default:
+		w.logger.Warn("History queue full, dropping record", "timestamp", timestamp)
+		w.metrics.RecordHistoryWriteErrors()
+	}
+}
+
+// Close writes any queued records and closes the history file.
+// Append must not be called after Close.
+func (w *Writer) Close() error {
+	close(w.records)
+	<-w.done
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Close()
+}
+
+func (w *Writer) loop() {
+	defer close(w.done)
+	for entry := range w.records {
+		if err := w.write(entry); err != nil {
+			w.logger.Warn("Failed to write history record", "path", w.path, "err", err)
+			w.metrics.RecordHistoryWriteErrors()
+		}
+	}
+}
+
+func (w *Writer) write(entry entry) error {
+	line := entry.line
+	now := time.Unix(int64(entry.timestamp), 0).UTC()
+	if w.file == nil {
+		if err := w.open(now); err != nil {
+			return err
+		}
+	}
+	if w.needsRotation(now, len(line)) {
+		if err := w.rotate(now); err != nil {
+			return err
+		}
+		if err := w.open(now); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(line)
+	w.size += uint64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write record: %w", err)
+	}
+	return nil
+}
+
+func (w *Writer) open(now time.Time) error {
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat history file: %w", err)
+	}
+	w.file = file
+	w.size = uint64(info.Size())
+	w.day = dayOf(info.ModTime())
+	if info.Size() == 0 {
+		w.day = dayOf(now)
+	}
+	return nil
+}
+
+func (w *Writer) needsRotation(now time.Time, lineLen int) bool {
+	if w.size == 0 {
+		return false
+	}
+	if w.rotation.MaxSize > 0 && w.size+uint64(lineLen) > w.rotation.MaxSize {
+		return true
+	}
+	return w.rotation.Daily && w.day != dayOf(now)
+}
+
+func (w *Writer) rotate(now time.Time) error {
+	if err := w.file.Close(); err != nil {
+		w.logger.Warn("Failed to close history file", "err", err)
+	}
+	w.file = nil
+	w.size = 0
+	rotated, err := w.rotatedPath(now)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(w.path, rotated); err != nil {
+		return fmt.Errorf("failed to rotate history file: %w", err)
+	}
+	w.logger.Info("Rotated history file", "path", rotated)
+	return nil
+}
+
+// rotatedPath returns the path to rename the history file to when it is rotated at now.
+// Record timestamps only have second precision, so a counter is appended if the file has already been rotated in the
+// same second rather than overwriting the earlier rotated file.
+func (w *Writer) rotatedPath(now time.Time) (string, error) {
+	base := w.path + "." + now.Format("20060102T150405")
+	rotated := base
+	for i := 1; ; i++ {
+		if _, err := os.Lstat(rotated); errors.Is(err, fs.ErrNotExist) {
+			return rotated, nil
+		} else if err != nil {
+			return "", fmt.Errorf("failed to check rotated history file: %w", err)
+		}
+		rotated = fmt.Sprintf("%v.%d", base, i)
+	}
+}
+
+func dayOf(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
@@ -0,0 +1,202 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type testBatch struct {
+	Agree    int
+	Disagree int
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("Append", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, metrics := newTestWriter(t, cl, path, Rotation{})
+		hash := common.Hash{0xaa}
+		writer.Append(hash, testBatch{Agree: 2, Disagree: 1})
+		cl.AdvanceTime(30 * time.Second)
+		writer.Append(hash, testBatch{Agree: 3})
+		require.NoError(t, writer.Close())
+
+		records := readRecords(t, path)
+		require.Len(t, records, 2)
+		require.Equal(t, uint64(5000), records[0].Timestamp)
+		require.Equal(t, hash, records[0].GamesHash)
+		require.JSONEq(t, `{"Agree":2,"Disagree":1}`, string(records[0].Batch))
+		require.Equal(t, uint64(5030), records[1].Timestamp)
+		require.JSONEq(t, `{"Agree":3,"Disagree":0}`, string(records[1].Batch))
+		require.Zero(t, metrics.errors.Load())
+	})
+
+	t.Run("AppendEvent", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, metrics := newTestWriter(t, cl, path, Rotation{})
+		writer.AppendEvent(testBatch{Agree: 2, Disagree: 1})
+		require.NoError(t, writer.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, `{"Agree":2,"Disagree":1}`+"\n", string(data))
+		require.Zero(t, metrics.errors.Load())
+	})
+
+	t.Run("AppendsToExistingFile", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, _ := newTestWriter(t, cl, path, Rotation{})
+		writer.Append(common.Hash{}, testBatch{Agree: 1})
+		require.NoError(t, writer.Close())
+
+		writer, _ = newTestWriter(t, cl, path, Rotation{})
+		writer.Append(common.Hash{}, testBatch{Agree: 2})
+		require.NoError(t, writer.Close())
+		require.Len(t, readRecords(t, path), 2)
+	})
+
+	t.Run("RotateBySize", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, _ := newTestWriter(t, cl, path, Rotation{MaxSize: 150})
+		for i := 0; i < 3; i++ {
+			writer.Append(common.Hash{}, testBatch{Agree: i})
+			cl.AdvanceTime(time.Second)
+		}
+		require.NoError(t, writer.Close())
+
+		rotated, err := filepath.Glob(path + ".*")
+		require.NoError(t, err)
+		require.Len(t, rotated, 2)
+		require.Len(t, readRecords(t, path), 1)
+		for _, file := range rotated {
+			require.Len(t, readRecords(t, file), 1)
+		}
+	})
+
+	t.Run("RotateTwiceInSameSecond", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, metrics := newTestWriter(t, cl, path, Rotation{MaxSize: 30})
+		for i := 0; i < 3; i++ {
+			writer.AppendEvent(testBatch{Agree: i})
+		}
+		require.NoError(t, writer.Close())
+
+		rotated, err := filepath.Glob(path + ".*")
+		require.NoError(t, err)
+		require.Len(t, rotated, 2)
+		lines := []string{readLine(t, path)}
+		for _, file := range rotated {
+			lines = append(lines, readLine(t, file))
+		}
+		require.ElementsMatch(t, []string{
+			`{"Agree":0,"Disagree":0}`,
+			`{"Agree":1,"Disagree":0}`,
+			`{"Agree":2,"Disagree":0}`,
+		}, lines)
+		require.Zero(t, metrics.errors.Load())
+	})
+
+	t.Run("RotateDaily", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Date(2024, 6, 1, 23, 59, 0, 0, time.UTC))
+		writer, _ := newTestWriter(t, cl, path, Rotation{Daily: true})
+		writer.Append(common.Hash{}, testBatch{Agree: 1})
+		cl.AdvanceTime(30 * time.Second)
+		writer.Append(common.Hash{}, testBatch{Agree: 2})
+		cl.AdvanceTime(time.Minute)
+		writer.Append(common.Hash{}, testBatch{Agree: 3})
+		require.NoError(t, writer.Close())
+
+		rotated := path + ".20240602T000030"
+		require.Len(t, readRecords(t, rotated), 2)
+		require.Len(t, readRecords(t, path), 1)
+	})
+
+	t.Run("UnwritablePath", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing", "history.jsonl")
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		writer, metrics := newTestWriter(t, cl, path, Rotation{})
+		writer.Append(common.Hash{}, testBatch{Agree: 1})
+		writer.Append(common.Hash{}, testBatch{Agree: 2})
+		require.NoError(t, writer.Close())
+		require.EqualValues(t, 2, metrics.errors.Load())
+		require.NoFileExists(t, path)
+	})
+
+	t.Run("DropsRecordsWhenQueueFull", func(t *testing.T) {
+		cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+		metrics := &stubMetrics{}
+		// Construct without starting the write loop so the queue is never drained
+		writer := &Writer{
+			logger:  testlog.Logger(t, log.LvlInfo),
+			metrics: metrics,
+			clock:   cl,
+			records: make(chan entry, bufferSize),
+		}
+		for i := 0; i < bufferSize+5; i++ {
+			writer.Append(common.Hash{}, testBatch{Agree: i})
+		}
+		require.EqualValues(t, 5, metrics.errors.Load())
+	})
+}
+
+func TestGamesHash(t *testing.T) {
+	a := common.Address{0xaa}
+	b := common.Address{0xbb}
+	require.Equal(t, GamesHash([]common.Address{a, b}), GamesHash([]common.Address{b, a}))
+	require.NotEqual(t, GamesHash([]common.Address{a}), GamesHash([]common.Address{a, b}))
+}
+
+func newTestWriter(t *testing.T, cl Clock, path string, rotation Rotation) (*Writer, *stubMetrics) {
+	metrics := &stubMetrics{}
+	return NewWriter(testlog.Logger(t, log.LvlInfo), metrics, cl, path, rotation), metrics
+}
+
+func readRecords(t *testing.T, path string) []Record {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+// readLine returns the single line in the file at path.
+func readLine(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+type stubMetrics struct {
+	errors atomic.Int64
+}
+
+func (s *stubMetrics) RecordHistoryWriteErrors() {
+	s.errors.Add(1)
+}
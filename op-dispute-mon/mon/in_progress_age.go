@@ -0,0 +1,41 @@
+package mon
+
+import (
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+type InProgressAgeMetrics interface {
+	RecordInProgressGameAge(d time.Duration)
+}
+
+// InProgressAgeMonitor records the time since each in progress game was created so operators can estimate how many
+// games will need resolving soon.
+type InProgressAgeMonitor struct {
+	clock   RClock
+	metrics InProgressAgeMetrics
+}
+
+func NewInProgressAgeMonitor(metrics InProgressAgeMetrics, clock RClock) *InProgressAgeMonitor {
+	return &InProgressAgeMonitor{
+		clock:   clock,
+		metrics: metrics,
+	}
+}
+
+func (m *InProgressAgeMonitor) CheckInProgressAges(games []*types.EnrichedGameData) {
+	now := m.clock.Now()
+	for _, game := range games {
+		if game.Status != gameTypes.GameStatusInProgress {
+			continue
+		}
+		age := now.Sub(time.Unix(int64(game.Timestamp), 0))
+		if age < 0 {
+			// Games created in the future are reported by the FutureTimestampMonitor
+			continue
+		}
+		m.metrics.RecordInProgressGameAge(age)
+	}
+}
@@ -0,0 +1,40 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInProgressAges(t *testing.T) {
+	now := time.Unix(10_000, 0)
+	newGame := func(status gameTypes.GameStatus, timestamp uint64) *types.EnrichedGameData {
+		return &types.EnrichedGameData{
+			GameMetadata: gameTypes.GameMetadata{Timestamp: timestamp},
+			Status:       status,
+		}
+	}
+	metrics := &stubInProgressAgeMetrics{}
+	monitor := NewInProgressAgeMonitor(metrics, clock.NewDeterministicClock(now))
+	monitor.CheckInProgressAges([]*types.EnrichedGameData{
+		newGame(gameTypes.GameStatusInProgress, 10_000),
+		newGame(gameTypes.GameStatusInProgress, 9_000),
+		newGame(gameTypes.GameStatusDefenderWon, 5_000),
+		newGame(gameTypes.GameStatusInProgress, 4_000),
+		newGame(gameTypes.GameStatusChallengerWon, 3_000),
+		newGame(gameTypes.GameStatusInProgress, 11_000), // Created in the future
+	})
+	require.Equal(t, []time.Duration{0, 1000 * time.Second, 6000 * time.Second}, metrics.ages)
+}
+
+type stubInProgressAgeMetrics struct {
+	ages []time.Duration
+}
+
+func (s *stubInProgressAgeMetrics) RecordInProgressGameAge(d time.Duration) {
+	s.ages = append(s.ages, d)
+}
@@ -0,0 +1,71 @@
+package mon
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// LastBatchPath is the path the most recent forecast batch is served from on the metrics server.
+const LastBatchPath = "/debug/lastbatch"
+
+var (
+	_ HistoryRecorder = (*LastBatch)(nil)
+	_ http.Handler    = (*LastBatch)(nil)
+)
+
+// LastBatch retains the result of the most recent forecast and serves it as JSON for quick inspection.
+// Only the latest cycle is retained.
+type LastBatch struct {
+	logger log.Logger
+
+	lock sync.Mutex
+	data []byte
+}
+
+func NewLastBatch(logger log.Logger) *LastBatch {
+	return &LastBatch{logger: logger}
+}
+
+type lastBatchRecord struct {
+	GamesHash common.Hash `json:"gamesHash"`
+	Batch     any         `json:"batch"`
+}
+
+func (l *LastBatch) Append(gamesHash common.Hash, batch any) {
+	// Encode immediately so the served batch can't be modified by later cycles.
+	data, err := json.Marshal(lastBatchRecord{GamesHash: gamesHash, Batch: batch})
+	if err != nil {
+		l.logger.Warn("Failed to encode last batch", "err", err)
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.data = data
+}
+
+func (l *LastBatch) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	l.lock.Lock()
+	data := l.data
+	l.lock.Unlock()
+	if data == nil {
+		http.Error(w, "no monitoring cycle has completed", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		l.logger.Debug("Failed to write last batch", "err", err)
+	}
+}
+
+// historyRecorders appends each forecast to every recorder.
+type historyRecorders []HistoryRecorder
+
+func (r historyRecorders) Append(gamesHash common.Hash, batch any) {
+	for _, recorder := range r {
+		recorder.Append(gamesHash, batch)
+	}
+}
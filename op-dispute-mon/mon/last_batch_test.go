@@ -0,0 +1,56 @@
+package mon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/history"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastBatch(t *testing.T) {
+	get := func(t *testing.T, handler http.Handler) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, LastBatchPath, nil))
+		return rec
+	}
+
+	t.Run("NoCycle", func(t *testing.T) {
+		lastBatch := NewLastBatch(testlog.Logger(t, log.LvlInfo))
+		require.Equal(t, http.StatusNotFound, get(t, lastBatch).Code)
+	})
+
+	t.Run("ServesLatestCycle", func(t *testing.T) {
+		forecast, _, _ := setupForecastTest(t)
+		lastBatch := NewLastBatch(testlog.Logger(t, log.LvlInfo))
+		forecast.history = lastBatch
+
+		forecast.Forecast([]*monTypes.EnrichedGameData{
+			{GameMetadata: types.GameMetadata{Proxy: common.Address{0xcc}}, Status: types.GameStatusChallengerWon, RootClaim: mockRootClaim, AgreeWithClaim: true},
+		}, 0, 0)
+		forecast.Forecast([]*monTypes.EnrichedGameData{
+			{GameMetadata: types.GameMetadata{Proxy: common.Address{0xaa}}, Status: types.GameStatusDefenderWon, RootClaim: mockRootClaim, AgreeWithClaim: true},
+			{GameMetadata: types.GameMetadata{Proxy: common.Address{0xbb}}, Status: types.GameStatusInProgress, RootClaim: mockRootClaim, Pending: true},
+		}, 0, 0)
+
+		rec := get(t, lastBatch)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		var result struct {
+			GamesHash common.Hash   `json:"gamesHash"`
+			Batch     forecastBatch `json:"batch"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		require.Equal(t, history.GamesHash([]common.Address{{0xaa}, {0xbb}}), result.GamesHash)
+		require.Equal(t, 1, result.Batch.AgreeDefenderWins)
+		require.Equal(t, 1, result.Batch.Pending)
+		require.Zero(t, result.Batch.AgreeChallengerWins, "should only include the latest cycle")
+	})
+}
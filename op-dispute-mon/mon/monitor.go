@@ -2,8 +2,10 @@ package mon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
@@ -14,15 +16,106 @@ import (
 )
 
 type ForecastResolution func(games []*types.EnrichedGameData, ignoredCount, failedCount int)
-type Bonds func(games []*types.EnrichedGameData)
-type Resolutions func(games []*types.EnrichedGameData)
 type Monitor func(games []*types.EnrichedGameData)
+
+// ContextMonitor is a Monitor that is given the monitoring cycle's context, so it can be cancelled along with the cycle.
+type ContextMonitor func(ctx context.Context, games []*types.EnrichedGameData)
 type BlockHashFetcher func(ctx context.Context, number *big.Int) (common.Hash, error)
 type BlockNumberFetcher func(ctx context.Context) (uint64, error)
 type Extract func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error)
 
+var (
+	ErrRollupUnhealthy     = errors.New("rollup node unhealthy")
+	ErrPinnedBlockNotFinal = errors.New("pinned l1 block not finalized")
+	ErrCycleTimeout        = errors.New("monitoring cycle exceeded deadline")
+)
+
+// outsideGameWindow returns true if a game created at timestamp is older than the game window, so will no longer be
+// monitored. Every game is within a zero game window.
+func outsideGameWindow(cl RClock, gameWindow time.Duration, timestamp uint64) bool {
+	return gameWindow != 0 && int64(timestamp) < cl.Now().Add(-gameWindow).Unix()
+}
+
+// pinnedBlockNumber returns a BlockNumberFetcher that always returns the pinned L1 block so every read in each cycle
+// reflects the same L1 state. The pinned block must be finalized so the state it reflects can't be reorged out.
+func pinnedBlockNumber(pinned uint64, fetchFinalized BlockNumberFetcher) BlockNumberFetcher {
+	return func(ctx context.Context) (uint64, error) {
+		finalized, err := fetchFinalized(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch finalized block number: %w", err)
+		}
+		if pinned > finalized {
+			return 0, fmt.Errorf("%w: pinned %v, finalized %v", ErrPinnedBlockNotFinal, pinned, finalized)
+		}
+		return pinned, nil
+	}
+}
+
+type RollupHealthMetrics interface {
+	RecordRollupUnhealthy()
+}
+
+// RollupHealthCheck returns an error if the rollup node can't currently serve requests.
+type RollupHealthCheck func(ctx context.Context) error
+
+// checkRollupHealth returns an Extract that probes the rollup node before loading any games.
+// If the probe fails the whole cycle is skipped with a single error, rather than every game failing individually.
+func checkRollupHealth(extract Extract, probe RollupHealthCheck, metrics RollupHealthMetrics) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		if err := probe(ctx); err != nil {
+			metrics.RecordRollupUnhealthy()
+			return nil, 0, 0, fmt.Errorf("%w: %w", ErrRollupUnhealthy, err)
+		}
+		return extract(ctx, blockHash, minTimestamp)
+	}
+}
+
+type CycleTimeoutMetrics interface {
+	RecordCycleTimeouts()
+}
+
+// withCycleDeadline returns an Extract that cancels loading games if it takes longer than deadline, so a pathological
+// cycle can't overrun the monitoring interval. The partially loaded games are discarded rather than reported and
+// reset, if not nil, is called so the next cycle starts fresh.
+func withCycleDeadline(extract Extract, deadline time.Duration, metrics CycleTimeoutMetrics, reset func()) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		ctx, cancel := context.WithTimeout(ctx, deadline)
+		defer cancel()
+		games, ignored, failed, err := extract(ctx, blockHash, minTimestamp)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			metrics.RecordCycleTimeouts()
+			if reset != nil {
+				reset()
+			}
+			return nil, 0, 0, fmt.Errorf("%w of %v: %v games loaded, %v failed", ErrCycleTimeout, deadline, len(games), failed)
+		}
+		return games, ignored, failed, err
+	}
+}
+
+type GameOverrides interface {
+	Apply(games []*types.EnrichedGameData) ([]*types.EnrichedGameData, int)
+}
+
+// applyOverrides returns an Extract that applies the overrides to the extracted games.
+// Suppressed games are counted as ignored.
+func applyOverrides(extract Extract, overrides GameOverrides) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		games, ignored, failed, err := extract(ctx, blockHash, minTimestamp)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		games, suppressed := overrides.Apply(games)
+		return games, ignored + suppressed, failed, nil
+	}
+}
+
 type MonitorMetrics interface {
 	RecordMonitorDuration(dur time.Duration)
+	RecordGamesPerCycle(n int)
+	RecordFailedGamesPerCycle(n int)
+	RecordCycleErrorRate(rate float64)
+	RecordNextPollDelay(d time.Duration)
 }
 
 type gameMonitor struct {
@@ -30,19 +123,34 @@ type gameMonitor struct {
 	clock   clock.Clock
 	metrics MonitorMetrics
 
-	done   chan struct{}
-	ctx    context.Context
-	cancel context.CancelFunc
+	done     chan struct{}
+	loopDone chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
 
 	gameWindow      time.Duration
 	monitorInterval time.Duration
+	shutdownGrace   time.Duration
+
+	// startupGraceCycles is the number of cycles after startup in which games are loaded but not reported,
+	// since cold connections and caches produce transient results that shouldn't alert.
+	startupGraceCycles uint
+	completedCycles    uint
+
+	// errorRateThreshold is the fraction of games failing to load in a cycle above which a warning is logged.
+	errorRateThreshold float64
+
+	// intervalJitter is the maximum fraction the monitor interval is randomly varied by, using random
+	// which returns a value in [0, 1).
+	intervalJitter float64
+	random         func() float64
 
 	forecast         ForecastResolution
-	bonds            Bonds
-	resolutions      Resolutions
-	claims           Monitor
-	withdrawals      Monitor
-	l2Challenges     Monitor
+	resolutions      Monitor
+	monitors         []Monitor
+	events           CycleEventRecorder
+	breaker          *circuitBreaker
+	customRules      ContextMonitor
 	extract          Extract
 	fetchBlockHash   BlockHashFetcher
 	fetchBlockNumber BlockNumberFetcher
@@ -55,37 +163,52 @@ func newGameMonitor(
 	metrics MonitorMetrics,
 	monitorInterval time.Duration,
 	gameWindow time.Duration,
+	shutdownGrace time.Duration,
+	startupGraceCycles uint,
+	errorRateThreshold float64,
+	intervalJitter float64,
 	forecast ForecastResolution,
-	bonds Bonds,
-	resolutions Resolutions,
-	claims Monitor,
-	withdrawals Monitor,
-	l2Challenges Monitor,
+	resolutions Monitor,
 	extract Extract,
 	fetchBlockNumber BlockNumberFetcher,
 	fetchBlockHash BlockHashFetcher,
+	events CycleEventRecorder,
+	breaker *circuitBreaker,
+	customRules ContextMonitor,
+	monitors ...Monitor,
 ) *gameMonitor {
 	return &gameMonitor{
 		logger:           logger,
 		clock:            cl,
 		ctx:              ctx,
 		done:             make(chan struct{}),
+		loopDone:         make(chan struct{}),
 		metrics:          metrics,
 		monitorInterval:  monitorInterval,
 		gameWindow:       gameWindow,
+		shutdownGrace:    shutdownGrace,
 		forecast:         forecast,
-		bonds:            bonds,
 		resolutions:      resolutions,
-		claims:           claims,
-		withdrawals:      withdrawals,
-		l2Challenges:     l2Challenges,
+		monitors:         monitors,
+		events:           events,
+		breaker:          breaker,
+		customRules:      customRules,
 		extract:          extract,
 		fetchBlockNumber: fetchBlockNumber,
 		fetchBlockHash:   fetchBlockHash,
+
+		startupGraceCycles: startupGraceCycles,
+		errorRateThreshold: errorRateThreshold,
+		intervalJitter:     intervalJitter,
+		random:             rand.Float64,
 	}
 }
 
 func (m *gameMonitor) monitorGames() error {
+	if m.breaker != nil && !m.breaker.Allow() {
+		m.logger.Debug("Circuit breaker open, skipping monitoring cycle")
+		return nil
+	}
 	start := m.clock.Now()
 	blockNumber, err := m.fetchBlockNumber(m.ctx)
 	if err != nil {
@@ -98,23 +221,75 @@ func (m *gameMonitor) monitorGames() error {
 	}
 	minGameTimestamp := clock.MinCheckedTimestamp(m.clock, m.gameWindow)
 	enrichedGames, ignored, failed, err := m.extract(m.ctx, blockHash, minGameTimestamp)
+	if m.breaker != nil {
+		// A cycle fails if every game errored, or the games couldn't be loaded at all
+		m.breaker.Record(err != nil || (failed > 0 && len(enrichedGames) == 0))
+	}
 	if err != nil {
 		return fmt.Errorf("failed to load games: %w", err)
 	}
-	m.resolutions(enrichedGames)
-	m.forecast(enrichedGames, ignored, failed)
-	m.bonds(enrichedGames)
-	m.claims(enrichedGames)
-	m.withdrawals(enrichedGames)
-	m.l2Challenges(enrichedGames)
+	m.completedCycles++
+	if m.completedCycles > m.startupGraceCycles {
+		// Resolutions are checked before the forecast so the resolution metrics for the cycle are recorded first
+		m.resolutions(enrichedGames)
+		m.forecast(enrichedGames, ignored, failed)
+		for _, monitor := range m.monitors {
+			monitor(enrichedGames)
+		}
+		if m.customRules != nil {
+			// Custom rules run last so they can't delay the standard monitors
+			m.customRules(m.ctx, enrichedGames)
+		}
+		m.recordErrorRate(len(enrichedGames), failed)
+	} else {
+		m.logger.Info("Not reporting games during startup grace period", "cycle", m.completedCycles, "graceCycles", m.startupGraceCycles)
+	}
 	timeTaken := m.clock.Since(start)
 	m.metrics.RecordMonitorDuration(timeTaken)
+	m.metrics.RecordGamesPerCycle(len(enrichedGames))
+	m.metrics.RecordFailedGamesPerCycle(failed)
+	if m.events != nil {
+		m.events.Record(CycleResult{
+			BlockNumber: blockNumber,
+			BlockHash:   blockHash,
+			Duration:    timeTaken,
+			Games:       enrichedGames,
+			Ignored:     ignored,
+			Failed:      failed,
+		})
+	}
 	m.logger.Info("Completed monitoring update", "blockNumber", blockNumber, "blockHash", blockHash, "duration", timeTaken, "games", len(enrichedGames), "ignored", ignored, "failed", failed)
 	return nil
 }
 
+// recordErrorRate records the fraction of games that failed to load in the cycle.
+// Ignored games are excluded since they were intentionally not loaded.
+func (m *gameMonitor) recordErrorRate(loaded, failed int) {
+	rate := 0.0
+	if total := loaded + failed; total > 0 {
+		rate = float64(failed) / float64(total)
+	}
+	m.metrics.RecordCycleErrorRate(rate)
+	if m.errorRateThreshold != 0 && rate > m.errorRateThreshold {
+		m.logger.Warn("High rate of games failing to load", "rate", rate, "threshold", m.errorRateThreshold, "failed", failed, "total", loaded+failed)
+	}
+}
+
+// nextPollDelay returns the delay until the next monitoring cycle.
+// The monitor interval is randomly increased or decreased by up to the jitter fraction, so instances sharing an
+// RPC provider don't poll in lockstep.
+func (m *gameMonitor) nextPollDelay() time.Duration {
+	delay := m.monitorInterval
+	if m.intervalJitter != 0 {
+		delay = time.Duration(float64(m.monitorInterval) * (1 + m.intervalJitter*(2*m.random()-1)))
+	}
+	m.metrics.RecordNextPollDelay(delay)
+	return delay
+}
+
 func (m *gameMonitor) loop() {
-	ticker := m.clock.NewTicker(m.monitorInterval)
+	defer close(m.loopDone)
+	ticker := m.clock.NewTicker(m.nextPollDelay())
 	defer ticker.Stop()
 	for {
 		select {
@@ -122,6 +297,9 @@ func (m *gameMonitor) loop() {
 			if err := m.monitorGames(); err != nil {
 				m.logger.Error("Failed to monitor games", "err", err)
 			}
+			if m.intervalJitter != 0 {
+				ticker.Reset(m.nextPollDelay())
+			}
 		case <-m.done:
 			m.logger.Info("Stopping game monitor")
 			return
@@ -142,11 +320,21 @@ func (m *gameMonitor) StartMonitoring() {
 	go m.loop()
 }
 
+// StopMonitoring stops the monitoring loop. Any in-flight monitoring cycle is given up to the shutdown grace period
+// to complete before it is cancelled. A cancelled cycle still records metrics for the games it had already processed.
 func (m *gameMonitor) StopMonitoring() {
 	m.logger.Info("Stopping game monitor")
-	if m.cancel != nil {
-		m.cancel()
-		m.cancel = nil
-	}
 	close(m.done)
+	if m.cancel == nil {
+		// Monitoring was never started
+		return
+	}
+	select {
+	case <-m.loopDone:
+	case <-m.clock.After(m.shutdownGrace):
+		m.logger.Warn("Monitoring cycle did not complete within shutdown grace period, cancelling", "grace", m.shutdownGrace)
+	}
+	m.cancel()
+	m.cancel = nil
+	<-m.loopDone
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"math/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,7 +27,7 @@ func TestMonitor_MonitorGames(t *testing.T) {
 	t.Parallel()
 
 	t.Run("FailedFetchBlocknumber", func(t *testing.T) {
-		monitor, _, _, _, _, _, _, _ := setupMonitorTest(t)
+		monitor, _, _, _ := setupMonitorTest(t)
 		boom := errors.New("boom")
 		monitor.fetchBlockNumber = func(ctx context.Context) (uint64, error) {
 			return 0, boom
@@ -35,7 +37,7 @@ func TestMonitor_MonitorGames(t *testing.T) {
 	})
 
 	t.Run("FailedFetchBlockHash", func(t *testing.T) {
-		monitor, _, _, _, _, _, _, _ := setupMonitorTest(t)
+		monitor, _, _, _ := setupMonitorTest(t)
 		boom := errors.New("boom")
 		monitor.fetchBlockHash = func(ctx context.Context, number *big.Int) (common.Hash, error) {
 			return common.Hash{}, boom
@@ -45,37 +47,221 @@ func TestMonitor_MonitorGames(t *testing.T) {
 	})
 
 	t.Run("MonitorsWithNoGames", func(t *testing.T) {
-		monitor, factory, forecast, bonds, withdrawals, resolutions, claims, l2Challenges := setupMonitorTest(t)
+		monitor, factory, forecast, monitors := setupMonitorTest(t)
 		factory.games = []*monTypes.EnrichedGameData{}
 		err := monitor.monitorGames()
 		require.NoError(t, err)
 		require.Equal(t, 1, forecast.calls)
-		require.Equal(t, 1, bonds.calls)
-		require.Equal(t, 1, resolutions.calls)
-		require.Equal(t, 1, claims.calls)
-		require.Equal(t, 1, withdrawals.calls)
-		require.Equal(t, 1, l2Challenges.calls)
+		for _, m := range monitors {
+			require.Equal(t, 1, m.calls)
+		}
 	})
 
 	t.Run("MonitorsMultipleGames", func(t *testing.T) {
-		monitor, factory, forecast, bonds, withdrawals, resolutions, claims, l2Challenges := setupMonitorTest(t)
+		monitor, factory, forecast, monitors := setupMonitorTest(t)
 		factory.games = []*monTypes.EnrichedGameData{{}, {}, {}}
 		err := monitor.monitorGames()
 		require.NoError(t, err)
 		require.Equal(t, 1, forecast.calls)
-		require.Equal(t, 1, bonds.calls)
-		require.Equal(t, 1, resolutions.calls)
-		require.Equal(t, 1, claims.calls)
-		require.Equal(t, 1, withdrawals.calls)
-		require.Equal(t, 1, l2Challenges.calls)
+		for _, m := range monitors {
+			require.Equal(t, 1, m.calls)
+		}
+	})
+}
+
+func TestMonitor_StartupGraceCycles(t *testing.T) {
+	monitor, extractor, forecast, monitors := setupMonitorTest(t)
+	monitor.startupGraceCycles = 1
+	extractor.games = []*monTypes.EnrichedGameData{{}}
+
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 1, extractor.calls, "should still load games during the grace period")
+	require.Zero(t, forecast.calls, "should not report games on the first cycle")
+	for _, m := range monitors {
+		require.Zero(t, m.calls)
+	}
+
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 2, extractor.calls)
+	require.Equal(t, 1, forecast.calls, "should report games once the grace period ends")
+	for _, m := range monitors {
+		require.Equal(t, 1, m.calls)
+	}
+}
+
+func TestMonitor_PinnedL1Block(t *testing.T) {
+	setup := func(t *testing.T, finalized uint64) (*gameMonitor, *mockExtractor, *[]uint64) {
+		monitor, extractor, _, _ := setupMonitorTest(t)
+		monitor.fetchBlockNumber = pinnedBlockNumber(100, func(_ context.Context) (uint64, error) {
+			return finalized, nil
+		})
+		var requested []uint64
+		monitor.fetchBlockHash = func(_ context.Context, number *big.Int) (common.Hash, error) {
+			requested = append(requested, number.Uint64())
+			return common.BigToHash(number), nil
+		}
+		return monitor, extractor, &requested
+	}
+
+	t.Run("ReadsAtPinnedBlock", func(t *testing.T) {
+		monitor, extractor, requested := setup(t, 150)
+		require.NoError(t, monitor.monitorGames())
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, []uint64{100, 100}, *requested)
+		require.Equal(t, common.BigToHash(big.NewInt(100)), extractor.blockHash)
 	})
+
+	t.Run("PinnedBlockNotFinalized", func(t *testing.T) {
+		monitor, extractor, requested := setup(t, 99)
+		require.ErrorIs(t, monitor.monitorGames(), ErrPinnedBlockNotFinal)
+		require.Empty(t, *requested)
+		require.Zero(t, extractor.calls)
+	})
+}
+
+func TestMonitor_GamesPerCycle(t *testing.T) {
+	monitor, extractor, _, _ := setupMonitorTest(t)
+	m := &stubMonitorMetrics{}
+	monitor.metrics = m
+	for _, count := range []int{0, 1, 100} {
+		extractor.games = make([]*monTypes.EnrichedGameData, count)
+		extractor.failedCount = count / 10
+		require.NoError(t, monitor.monitorGames())
+	}
+	require.Equal(t, []int{0, 1, 100}, m.gamesPerCycle)
+	require.Equal(t, []int{0, 0, 10}, m.failedGamesPerCycle)
+}
+
+func TestMonitor_ResolutionsBeforeForecast(t *testing.T) {
+	monitor, _, forecast, _ := setupMonitorTest(t)
+	var forecastCalls []int
+	monitor.resolutions = func(_ []*monTypes.EnrichedGameData) {
+		forecastCalls = append(forecastCalls, forecast.calls)
+	}
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, []int{0}, forecastCalls)
+	require.Equal(t, 1, forecast.calls)
+}
+
+func TestMonitor_CustomRules(t *testing.T) {
+	monitor, extractor, _, mockMonitors := setupMonitorTest(t)
+	type ctxKey struct{}
+	monitor.ctx = context.WithValue(context.Background(), ctxKey{}, "cycle")
+	extractor.games = []*monTypes.EnrichedGameData{{}, {}}
+	var calls int
+	monitor.customRules = func(ctx context.Context, games []*monTypes.EnrichedGameData) {
+		calls++
+		require.Equal(t, "cycle", ctx.Value(ctxKey{}))
+		require.Len(t, games, 2)
+		for _, m := range mockMonitors {
+			require.Equal(t, calls, m.calls, "should run after the standard monitors")
+		}
+	}
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 1, calls)
+}
+
+func TestMonitor_NextPollDelay(t *testing.T) {
+	monitor, _, _, _ := setupMonitorTest(t)
+	m := &stubMonitorMetrics{}
+	monitor.metrics = m
+	monitor.monitorInterval = 100 * time.Second
+
+	require.Equal(t, 100*time.Second, monitor.nextPollDelay(), "should not vary the interval without jitter")
+
+	monitor.intervalJitter = 0.1
+	for _, test := range []struct {
+		random   float64
+		expected time.Duration
+	}{
+		{random: 0, expected: 90 * time.Second},
+		{random: 0.5, expected: 100 * time.Second},
+		{random: 0.75, expected: 105 * time.Second},
+	} {
+		monitor.random = func() float64 { return test.random }
+		require.Equal(t, test.expected, monitor.nextPollDelay())
+	}
+
+	monitor.random = rand.Float64
+	for i := 0; i < 100; i++ {
+		delay := monitor.nextPollDelay()
+		require.GreaterOrEqual(t, delay, 90*time.Second)
+		require.Less(t, delay, 110*time.Second)
+	}
+	require.Len(t, m.pollDelays, 104)
+	require.Equal(t, 90*time.Second, m.pollDelays[1], "should record the next poll delay")
+}
+
+func TestMonitor_ErrorRate(t *testing.T) {
+	setup := func(t *testing.T, threshold float64) (*gameMonitor, *mockExtractor, *stubMonitorMetrics, *testlog.CapturingHandler) {
+		monitor, extractor, _, _ := setupMonitorTest(t)
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		m := &stubMonitorMetrics{}
+		monitor.logger = logger
+		monitor.metrics = m
+		monitor.errorRateThreshold = threshold
+		return monitor, extractor, m, logs
+	}
+	warning := testlog.NewMessageFilter("High rate of games failing to load")
+
+	t.Run("PartialErrors", func(t *testing.T) {
+		monitor, extractor, m, logs := setup(t, 0.2)
+		extractor.games = make([]*monTypes.EnrichedGameData, 6)
+		extractor.failedCount = 2
+		extractor.ignoredCount = 12 // Ignored games shouldn't dilute the error rate
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, []float64{0.25}, m.errorRates)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), warning)
+		require.NotNil(t, l)
+		require.Equal(t, 0.25, l.AttrValue("rate"))
+	})
+
+	t.Run("BelowThreshold", func(t *testing.T) {
+		monitor, extractor, m, logs := setup(t, 0.5)
+		extractor.games = make([]*monTypes.EnrichedGameData, 6)
+		extractor.failedCount = 2
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, []float64{0.25}, m.errorRates)
+		require.Nil(t, logs.FindLog(warning))
+	})
+
+	t.Run("NoGames", func(t *testing.T) {
+		monitor, extractor, m, logs := setup(t, 0.2)
+		extractor.ignoredCount = 5
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, []float64{0}, m.errorRates)
+		require.Nil(t, logs.FindLog(warning))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		monitor, extractor, m, logs := setup(t, 0)
+		extractor.failedCount = 3
+		require.NoError(t, monitor.monitorGames())
+		require.Equal(t, []float64{1}, m.errorRates)
+		require.Nil(t, logs.FindLog(warning))
+	})
+}
+
+func TestMonitor_CycleEvents(t *testing.T) {
+	monitor, extractor, _, _ := setupMonitorTest(t)
+	events := &stubCycleEvents{}
+	monitor.events = events
+	extractor.games = []*monTypes.EnrichedGameData{{}, {}}
+	extractor.ignoredCount = 3
+	extractor.failedCount = 1
+	require.NoError(t, monitor.monitorGames())
+	require.Len(t, events.results, 1)
+	require.Equal(t, uint64(1), events.results[0].BlockNumber)
+	require.Equal(t, extractor.games, events.results[0].Games)
+	require.Equal(t, 3, events.results[0].Ignored)
+	require.Equal(t, 1, events.results[0].Failed)
 }
 
 func TestMonitor_StartMonitoring(t *testing.T) {
 	t.Run("MonitorsGames", func(t *testing.T) {
 		addr1 := common.Address{0xaa}
 		addr2 := common.Address{0xbb}
-		monitor, factory, forecaster, _, _, _, _, _ := setupMonitorTest(t)
+		monitor, factory, forecaster, _ := setupMonitorTest(t)
 		factory.games = []*monTypes.EnrichedGameData{newEnrichedGameData(addr1, 9999), newEnrichedGameData(addr2, 9999)}
 		factory.maxSuccess = len(factory.games) // Only allow two successful fetches
 
@@ -88,7 +274,7 @@ func TestMonitor_StartMonitoring(t *testing.T) {
 	})
 
 	t.Run("FailsToFetchGames", func(t *testing.T) {
-		monitor, factory, forecaster, _, _, _, _, _ := setupMonitorTest(t)
+		monitor, factory, forecaster, _ := setupMonitorTest(t)
 		factory.fetchErr = errors.New("boom")
 
 		monitor.StartMonitoring()
@@ -100,6 +286,53 @@ func TestMonitor_StartMonitoring(t *testing.T) {
 	})
 }
 
+func TestMonitor_StopMonitoring(t *testing.T) {
+	t.Run("NotStarted", func(t *testing.T) {
+		monitor, _, _, _ := setupMonitorTest(t)
+		monitor.StopMonitoring()
+	})
+
+	t.Run("CancelsCycleAfterGracePeriod", func(t *testing.T) {
+		monitor, extractor, forecaster, monitors := setupMonitorTest(t)
+		monitor.shutdownGrace = 0
+		extractor.games = []*monTypes.EnrichedGameData{{}, {}}
+		extractor.started = make(chan struct{})
+		extractor.waitForCancel = true
+
+		monitor.StartMonitoring()
+		<-extractor.started
+		monitor.StopMonitoring()
+		require.True(t, extractor.cancelled.Load())
+		// The partial batch is still recorded
+		require.NotZero(t, forecaster.calls)
+		require.Equal(t, 2, forecaster.games)
+		for _, m := range monitors {
+			require.NotZero(t, m.calls)
+		}
+	})
+
+	t.Run("DrainsCycleWithinGracePeriod", func(t *testing.T) {
+		monitor, extractor, forecaster, _ := setupMonitorTest(t)
+		monitor.shutdownGrace = time.Hour
+		extractor.games = []*monTypes.EnrichedGameData{{}}
+		extractor.started = make(chan struct{})
+		extractor.release = make(chan struct{})
+
+		monitor.StartMonitoring()
+		<-extractor.started
+		stopped := make(chan struct{})
+		go func() {
+			monitor.StopMonitoring()
+			close(stopped)
+		}()
+		close(extractor.release)
+		<-stopped
+		require.False(t, extractor.cancelled.Load())
+		require.NotZero(t, forecaster.calls)
+		require.Equal(t, 1, forecaster.games)
+	})
+}
+
 func newEnrichedGameData(proxy common.Address, timestamp uint64) *monTypes.EnrichedGameData {
 	return &monTypes.EnrichedGameData{
 		GameMetadata: types.GameMetadata{
@@ -110,7 +343,7 @@ func newEnrichedGameData(proxy common.Address, timestamp uint64) *monTypes.Enric
 	}
 }
 
-func setupMonitorTest(t *testing.T) (*gameMonitor, *mockExtractor, *mockForecast, *mockBonds, *mockMonitor, *mockResolutionMonitor, *mockMonitor, *mockMonitor) {
+func setupMonitorTest(t *testing.T) (*gameMonitor, *mockExtractor, *mockForecast, []*mockMonitor) {
 	logger := testlog.Logger(t, log.LvlDebug)
 	fetchBlockNum := func(ctx context.Context) (uint64, error) {
 		return 1, nil
@@ -123,11 +356,9 @@ func setupMonitorTest(t *testing.T) (*gameMonitor, *mockExtractor, *mockForecast
 	cl.Start()
 	extractor := &mockExtractor{}
 	forecast := &mockForecast{}
-	bonds := &mockBonds{}
-	resolutions := &mockResolutionMonitor{}
-	claims := &mockMonitor{}
-	withdrawals := &mockMonitor{}
-	l2Challenges := &mockMonitor{}
+	resolutions := &mockMonitor{}
+	monitor1 := &mockMonitor{}
+	monitor2 := &mockMonitor{}
 	monitor := newGameMonitor(
 		context.Background(),
 		logger,
@@ -135,25 +366,55 @@ func setupMonitorTest(t *testing.T) (*gameMonitor, *mockExtractor, *mockForecast
 		metrics.NoopMetrics,
 		monitorInterval,
 		10*time.Second,
+		0,
+		0,
+		0,
+		0,
 		forecast.Forecast,
-		bonds.CheckBonds,
-		resolutions.CheckResolutions,
-		claims.Check,
-		withdrawals.Check,
-		l2Challenges.Check,
+		resolutions.Check,
 		extractor.Extract,
 		fetchBlockNum,
 		fetchBlockHash,
+		nil,
+		nil,
+		nil,
+		monitor1.Check,
+		monitor2.Check,
 	)
-	return monitor, extractor, forecast, bonds, withdrawals, resolutions, claims, l2Challenges
+	return monitor, extractor, forecast, []*mockMonitor{resolutions, monitor1, monitor2}
 }
 
-type mockResolutionMonitor struct {
-	calls int
+type stubCycleEvents struct {
+	results []CycleResult
 }
 
-func (m *mockResolutionMonitor) CheckResolutions(games []*monTypes.EnrichedGameData) {
-	m.calls++
+func (s *stubCycleEvents) Record(result CycleResult) {
+	s.results = append(s.results, result)
+}
+
+type stubMonitorMetrics struct {
+	gamesPerCycle       []int
+	failedGamesPerCycle []int
+	errorRates          []float64
+	pollDelays          []time.Duration
+}
+
+func (s *stubMonitorMetrics) RecordMonitorDuration(_ time.Duration) {}
+
+func (s *stubMonitorMetrics) RecordGamesPerCycle(n int) {
+	s.gamesPerCycle = append(s.gamesPerCycle, n)
+}
+
+func (s *stubMonitorMetrics) RecordFailedGamesPerCycle(n int) {
+	s.failedGamesPerCycle = append(s.failedGamesPerCycle, n)
+}
+
+func (s *stubMonitorMetrics) RecordCycleErrorRate(rate float64) {
+	s.errorRates = append(s.errorRates, rate)
+}
+
+func (s *stubMonitorMetrics) RecordNextPollDelay(d time.Duration) {
+	s.pollDelays = append(s.pollDelays, d)
 }
 
 type mockMonitor struct {
@@ -164,20 +425,125 @@ func (m *mockMonitor) Check(games []*monTypes.EnrichedGameData) {
 	m.calls++
 }
 
-type mockForecast struct {
-	calls int
+func TestMonitor_CheckRollupHealth(t *testing.T) {
+	t.Run("Unhealthy", func(t *testing.T) {
+		monitor, extractor, forecast, monitors := setupMonitorTest(t)
+		metrics := &stubRollupHealthMetrics{}
+		probeErr := errors.New("connection refused")
+		monitor.extract = checkRollupHealth(extractor.Extract, func(_ context.Context) error {
+			return probeErr
+		}, metrics)
+		err := monitor.monitorGames()
+		require.ErrorIs(t, err, ErrRollupUnhealthy)
+		require.ErrorIs(t, err, probeErr)
+		require.Equal(t, 1, metrics.unhealthy)
+		require.Zero(t, extractor.calls, "should not load any games")
+		require.Zero(t, forecast.calls)
+		for _, m := range monitors {
+			require.Zero(t, m.calls)
+		}
+	})
+
+	t.Run("Healthy", func(t *testing.T) {
+		monitor, extractor, forecast, _ := setupMonitorTest(t)
+		metrics := &stubRollupHealthMetrics{}
+		monitor.extract = checkRollupHealth(extractor.Extract, func(_ context.Context) error {
+			return nil
+		}, metrics)
+		require.NoError(t, monitor.monitorGames())
+		require.Zero(t, metrics.unhealthy)
+		require.Equal(t, 1, extractor.calls)
+		require.Equal(t, 1, forecast.calls)
+	})
 }
 
-func (m *mockForecast) Forecast(_ []*monTypes.EnrichedGameData, _, _ int) {
-	m.calls++
+type stubRollupHealthMetrics struct {
+	unhealthy int
 }
 
-type mockBonds struct {
+func (s *stubRollupHealthMetrics) RecordRollupUnhealthy() {
+	s.unhealthy++
+}
+
+func TestMonitor_CycleDeadline(t *testing.T) {
+	monitor, extractor, forecast, monitors := setupMonitorTest(t)
+	metrics := &stubCycleTimeoutMetrics{}
+	resets := 0
+	monitor.extract = withCycleDeadline(extractor.Extract, 10*time.Millisecond, metrics, func() { resets++ })
+
+	// A deliberately slow cycle is cancelled by the watchdog
+	extractor.games = []*monTypes.EnrichedGameData{{}}
+	extractor.waitForCancel = true
+	err := monitor.monitorGames()
+	require.ErrorIs(t, err, ErrCycleTimeout)
+	require.True(t, extractor.cancelled.Load())
+	require.Equal(t, 1, metrics.timeouts)
+	require.Equal(t, 1, resets)
+	require.Zero(t, forecast.calls, "should not report partial results")
+	for _, m := range monitors {
+		require.Zero(t, m.calls)
+	}
+
+	// The next cycle starts fresh
+	extractor.waitForCancel = false
+	require.NoError(t, monitor.monitorGames())
+	require.Equal(t, 1, metrics.timeouts)
+	require.Equal(t, 1, resets)
+	require.Equal(t, 1, forecast.calls)
+	require.Equal(t, 1, forecast.games)
+}
+
+type stubCycleTimeoutMetrics struct {
+	timeouts int
+}
+
+func (s *stubCycleTimeoutMetrics) RecordCycleTimeouts() {
+	s.timeouts++
+}
+
+func TestMonitor_ApplyOverrides(t *testing.T) {
+	t.Run("CountsSuppressedAsIgnored", func(t *testing.T) {
+		extractor := &mockExtractor{
+			games:        []*monTypes.EnrichedGameData{{}, {}, {}},
+			ignoredCount: 2,
+			failedCount:  1,
+		}
+		extract := applyOverrides(extractor.Extract, &stubOverrides{suppress: 2})
+		games, ignored, failed, err := extract(context.Background(), common.Hash{}, 0)
+		require.NoError(t, err)
+		require.Len(t, games, 1)
+		require.Equal(t, 4, ignored)
+		require.Equal(t, 1, failed)
+	})
+
+	t.Run("ExtractError", func(t *testing.T) {
+		extractor := &mockExtractor{fetchErr: mockErr}
+		overrides := &stubOverrides{}
+		extract := applyOverrides(extractor.Extract, overrides)
+		_, _, _, err := extract(context.Background(), common.Hash{}, 0)
+		require.ErrorIs(t, err, mockErr)
+		require.Zero(t, overrides.calls)
+	})
+}
+
+type stubOverrides struct {
+	calls    int
+	suppress int
+}
+
+func (s *stubOverrides) Apply(games []*monTypes.EnrichedGameData) ([]*monTypes.EnrichedGameData, int) {
+	s.calls++
+	return games[s.suppress:], s.suppress
+}
+
+type mockForecast struct {
 	calls int
+	games int
 }
 
-func (m *mockBonds) CheckBonds(_ []*monTypes.EnrichedGameData) {
+func (m *mockForecast) Forecast(games []*monTypes.EnrichedGameData, _, _ int) {
 	m.calls++
+	m.games = len(games)
 }
 
 type mockExtractor struct {
@@ -187,14 +553,34 @@ type mockExtractor struct {
 	games        []*monTypes.EnrichedGameData
 	ignoredCount int
 	failedCount  int
+	blockHash    common.Hash
+
+	// started is closed when the first extraction starts
+	started chan struct{}
+	// release, if set, blocks extraction until it is closed or the context is cancelled
+	release chan struct{}
+	// waitForCancel blocks extraction until the context is cancelled
+	waitForCancel bool
+	cancelled     atomic.Bool
 }
 
 func (m *mockExtractor) Extract(
-	_ context.Context,
-	_ common.Hash,
+	ctx context.Context,
+	blockHash common.Hash,
 	_ uint64,
 ) ([]*monTypes.EnrichedGameData, int, int, error) {
 	m.calls++
+	m.blockHash = blockHash
+	if m.started != nil && m.calls == 1 {
+		close(m.started)
+	}
+	if m.waitForCancel || m.release != nil {
+		select {
+		case <-ctx.Done():
+			m.cancelled.Store(true)
+		case <-m.release:
+		}
+	}
 	if m.fetchErr != nil {
 		return nil, 0, 0, m.fetchErr
 	}
@@ -0,0 +1,35 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type OurTurnMetrics interface {
+	RecordOurTurnPending(count int)
+}
+
+// OurTurnMonitor reports the number of in-progress games where an opponent made the most recent move
+// against the honest actors, indicating the challenger has moves queued.
+type OurTurnMonitor struct {
+	logger  log.Logger
+	metrics OurTurnMetrics
+}
+
+func NewOurTurnMonitor(logger log.Logger, metrics OurTurnMetrics) *OurTurnMonitor {
+	return &OurTurnMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *OurTurnMonitor) CheckOurTurn(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if game.OurTurn {
+			m.logger.Debug("Awaiting honest actor move", "game", game.Proxy, "claims", len(game.Claims))
+			count++
+		}
+	}
+	m.metrics.RecordOurTurnPending(count)
+}
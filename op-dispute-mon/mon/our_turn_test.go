@@ -0,0 +1,32 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOurTurn(t *testing.T) {
+	metrics := &stubOurTurnMetrics{}
+	monitor := NewOurTurnMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+	monitor.CheckOurTurn([]*types.EnrichedGameData{
+		{OurTurn: true},
+		{OurTurn: false},
+		{OurTurn: true},
+	})
+	require.Equal(t, 2, metrics.count)
+
+	monitor.CheckOurTurn([]*types.EnrichedGameData{{OurTurn: false}})
+	require.Zero(t, metrics.count)
+}
+
+type stubOurTurnMetrics struct {
+	count int
+}
+
+func (s *stubOurTurnMetrics) RecordOurTurnPending(count int) {
+	s.count = count
+}
@@ -0,0 +1,74 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type OutputRangeMetrics interface {
+	RecordBeyondOutputRangeGames(count int)
+	RecordStuckDeferredGames(count int)
+}
+
+type deferral struct {
+	cycles    uint
+	timestamp uint64
+}
+
+// OutputRangeMonitor reports games disputing blocks beyond the rollup node's output range.
+// Such games are deferred until the rollup node catches up, but games that remain beyond the
+// output range for more than maxDeferredCycles consecutive cycles are escalated as stuck.
+type OutputRangeMonitor struct {
+	logger            log.Logger
+	metrics           OutputRangeMetrics
+	clock             RClock
+	gameWindow        time.Duration
+	maxDeferredCycles uint
+	deferrals         map[common.Address]deferral
+}
+
+func NewOutputRangeMonitor(logger log.Logger, metrics OutputRangeMetrics, clock RClock, gameWindow time.Duration, maxDeferredCycles uint) *OutputRangeMonitor {
+	return &OutputRangeMonitor{
+		logger:            logger,
+		metrics:           metrics,
+		clock:             clock,
+		gameWindow:        gameWindow,
+		maxDeferredCycles: maxDeferredCycles,
+		deferrals:         make(map[common.Address]deferral),
+	}
+}
+
+func (m *OutputRangeMonitor) CheckOutputRange(games []*types.EnrichedGameData) {
+	beyondRange := 0
+	stuck := 0
+	for _, game := range games {
+		if !game.BeyondOutputRange {
+			// Deferral count resets once the rollup node catches up
+			delete(m.deferrals, game.Proxy)
+			continue
+		}
+		beyondRange++
+		cycles := m.deferrals[game.Proxy].cycles + 1
+		m.deferrals[game.Proxy] = deferral{cycles: cycles, timestamp: game.Timestamp}
+		if cycles > m.maxDeferredCycles {
+			m.logger.Error("Game has been beyond output range for too long",
+				"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim, "cycles", cycles)
+			stuck++
+		} else {
+			m.logger.Warn("Found game disputing block beyond output range",
+				"game", game.Proxy, "blockNum", game.L2BlockNumber, "rootClaim", game.RootClaim, "cycles", cycles)
+		}
+	}
+	// Games missing from a cycle, such as because they failed to load, keep their deferral count so a stuck game
+	// isn't hidden by intermittent failures. Games are pruned once they leave the game window.
+	for addr, deferred := range m.deferrals {
+		if outsideGameWindow(m.clock, m.gameWindow, deferred.timestamp) {
+			delete(m.deferrals, addr)
+		}
+	}
+	m.metrics.RecordBeyondOutputRangeGames(beyondRange)
+	m.metrics.RecordStuckDeferredGames(stuck)
+}
@@ -0,0 +1,93 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorOutputRange(t *testing.T) {
+	games := []*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x44}}, BeyondOutputRange: true, L2BlockNumber: 1_000_000_000},
+		{BeyondOutputRange: false, AgreeWithClaim: true},
+		{BeyondOutputRange: false, AgreeWithClaim: false},
+	}
+	metrics := &stubOutputRangeMetrics{}
+	logger, capturedLogs := testlog.CaptureLogger(t, log.LvlDebug)
+	monitor := NewOutputRangeMonitor(logger, metrics, clock.NewDeterministicClock(time.Unix(0, 0)), time.Hour, 10)
+	monitor.CheckOutputRange(games)
+	require.Equal(t, 1, metrics.beyondRange)
+
+	levelFilter := testlog.NewLevelFilter(log.LevelWarn)
+	messageFilter := testlog.NewMessageFilter("Found game disputing block beyond output range")
+	l := capturedLogs.FindLog(levelFilter, messageFilter)
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0x44}, l.AttrValue("game"))
+	require.Equal(t, uint64(1_000_000_000), l.AttrValue("blockNum"))
+}
+
+func TestMonitorOutputRange_StuckDeferred(t *testing.T) {
+	deferred := &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x44}, Timestamp: 1000}, BeyondOutputRange: true}
+	recovered := &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0x55}, Timestamp: 1000}, BeyondOutputRange: true}
+	metrics := &stubOutputRangeMetrics{}
+	logger, capturedLogs := testlog.CaptureLogger(t, log.LvlDebug)
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	monitor := NewOutputRangeMonitor(logger, metrics, cl, time.Hour, 3)
+	stuckFilter := testlog.NewMessageFilter("Game has been beyond output range for too long")
+
+	for i := 0; i < 3; i++ {
+		monitor.CheckOutputRange([]*types.EnrichedGameData{deferred, recovered})
+		require.Equal(t, 2, metrics.beyondRange)
+		require.Zero(t, metrics.stuck)
+	}
+	require.Nil(t, capturedLogs.FindLog(stuckFilter))
+
+	// One game catches up with the rollup node while the other remains deferred beyond the limit
+	recovered.BeyondOutputRange = false
+	monitor.CheckOutputRange([]*types.EnrichedGameData{deferred, recovered})
+	require.Equal(t, 1, metrics.beyondRange)
+	require.Equal(t, 1, metrics.stuck)
+	l := capturedLogs.FindLog(testlog.NewLevelFilter(log.LevelError), stuckFilter)
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0x44}, l.AttrValue("game"))
+	require.EqualValues(t, 4, l.AttrValue("cycles"))
+
+	// Deferral count resets once the game is no longer beyond the output range
+	recovered.BeyondOutputRange = true
+	monitor.CheckOutputRange([]*types.EnrichedGameData{deferred, recovered})
+	require.Equal(t, 2, metrics.beyondRange)
+	require.Equal(t, 1, metrics.stuck)
+	require.Equal(t, uint(1), monitor.deferrals[recovered.Proxy].cycles)
+
+	// Games missing from a cycle keep their deferral count
+	monitor.CheckOutputRange(nil)
+	require.Zero(t, metrics.stuck)
+	monitor.CheckOutputRange([]*types.EnrichedGameData{deferred})
+	require.Equal(t, 1, metrics.stuck)
+	require.Equal(t, uint(6), monitor.deferrals[deferred.Proxy].cycles)
+
+	// Games outside the game window are not retained
+	cl.AdvanceTime(time.Hour + time.Second)
+	monitor.CheckOutputRange(nil)
+	require.Empty(t, monitor.deferrals)
+}
+
+type stubOutputRangeMetrics struct {
+	beyondRange int
+	stuck       int
+}
+
+func (s *stubOutputRangeMetrics) RecordStuckDeferredGames(count int) {
+	s.stuck = count
+}
+
+func (s *stubOutputRangeMetrics) RecordBeyondOutputRangeGames(count int) {
+	s.beyondRange = count
+}
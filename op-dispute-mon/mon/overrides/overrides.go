@@ -0,0 +1,199 @@
+// Package overrides allows operators to reclassify or suppress specific games without restarting.
+// Overrides are loaded from a JSON file mapping game addresses to a classification, for example:
+//
+//	{"0x1234...": "suppress", "0x5678...": "agree"}
+//
+// The file is watched for changes and reloaded before the next monitoring cycle. Changes made by replacing a symlink
+// to the file, as when Kubernetes updates a mounted ConfigMap, are also detected.
+package overrides
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+type Classification string
+
+const (
+	// Suppress excludes the game from monitoring, counting it as ignored.
+	Suppress Classification = "suppress"
+	// Agree classifies the game as agreeing with its root claim.
+	Agree Classification = "agree"
+	// Disagree classifies the game as disagreeing with its root claim.
+	Disagree Classification = "disagree"
+)
+
+func (c Classification) valid() bool {
+	switch c {
+	case Suppress, Agree, Disagree:
+		return true
+	default:
+		return false
+	}
+}
+
+// Overrides applies operator supplied classifications to games.
+type Overrides struct {
+	logger  log.Logger
+	path    string
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+
+	// changed is set by the watcher when the file may have been modified.
+	changed atomic.Bool
+
+	// target is the file path resolves to after following symlinks. Only accessed by the watcher.
+	target string
+
+	// games is only accessed by Apply so doesn't require locking.
+	games map[common.Address]Classification
+}
+
+// NewOverrides loads the overrides from path and starts watching it for changes.
+// An error is returned if the file can't be loaded so misconfiguration is detected at startup.
+func NewOverrides(logger log.Logger, path string) (*Overrides, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid overrides path: %w", err)
+	}
+	games, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve overrides path: %w", err)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create overrides watcher: %w", err)
+	}
+	// Watch the directory rather than the file so changes that replace the file are detected.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+		return nil, fmt.Errorf("failed to watch overrides: %w", err)
+	}
+	o := &Overrides{
+		logger:  logger,
+		path:    path,
+		watcher: watcher,
+		done:    make(chan struct{}),
+		target:  target,
+		games:   games,
+	}
+	go o.watch()
+	return o, nil
+}
+
+// Apply reloads the overrides if the file has changed, then applies them to games.
+// Suppressed games are removed from the returned list and their count returned.
+// If the file can't be reloaded, the previously loaded overrides continue to be used.
+func (o *Overrides) Apply(games []*types.EnrichedGameData) ([]*types.EnrichedGameData, int) {
+	if o.changed.Swap(false) {
+		o.reload()
+	}
+	if len(o.games) == 0 {
+		return games, 0
+	}
+	result := make([]*types.EnrichedGameData, 0, len(games))
+	suppressed := 0
+	for _, game := range games {
+		switch o.games[game.Proxy] {
+		case Suppress:
+			o.logger.Debug("Suppressing game due to override", "game", game.Proxy)
+			suppressed++
+			continue
+		case Agree:
+			game.AgreeWithClaim = true
+			clearUnclassified(game)
+		case Disagree:
+			game.AgreeWithClaim = false
+			clearUnclassified(game)
+		}
+		result = append(result, game)
+	}
+	return result, suppressed
+}
+
+// clearUnclassified clears the flags that prevent game being classified by its agreement with the root claim,
+// so the overridden classification is used.
+func clearUnclassified(game *types.EnrichedGameData) {
+	game.Pending = false
+	game.Indeterminate = false
+	game.ImplausibleBlock = false
+	game.RollupInternalInconsistency = false
+}
+
+// Close stops watching the overrides file.
+func (o *Overrides) Close() error {
+	err := o.watcher.Close()
+	<-o.done
+	return err
+}
+
+func (o *Overrides) reload() {
+	games, err := load(o.path)
+	if err != nil {
+		o.logger.Error("Failed to reload overrides, continuing to use previous overrides", "path", o.path, "err", err)
+		return
+	}
+	o.games = games
+	o.logger.Info("Reloaded overrides", "path", o.path, "games", len(games))
+}
+
+func (o *Overrides) watch() {
+	defer close(o.done)
+	for {
+		select {
+		case event, ok := <-o.watcher.Events:
+			if !ok {
+				return
+			}
+			// Symlink swaps change a different entry in the directory so also check where the path now resolves to.
+			if event.Name == o.path || o.targetChanged() {
+				o.changed.Store(true)
+			}
+		case err, ok := <-o.watcher.Errors:
+			if !ok {
+				return
+			}
+			o.logger.Error("Error watching overrides", "err", err)
+		}
+	}
+}
+
+// targetChanged returns true if the path now resolves to a different file than when last checked.
+// Errors are ignored as the path may briefly not resolve while a symlink is being replaced.
+func (o *Overrides) targetChanged() bool {
+	target, err := filepath.EvalSymlinks(o.path)
+	if err != nil || target == o.target {
+		return false
+	}
+	o.target = target
+	return true
+}
+
+func load(path string) (map[common.Address]Classification, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overrides: %w", err)
+	}
+	var games map[common.Address]Classification
+	if err := json.Unmarshal(data, &games); err != nil {
+		return nil, fmt.Errorf("failed to parse overrides: %w", err)
+	}
+	for game, classification := range games {
+		if !classification.valid() {
+			return nil, fmt.Errorf("invalid override %q for game %v", classification, game)
+		}
+	}
+	return games, nil
+}
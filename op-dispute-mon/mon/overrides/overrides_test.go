@@ -0,0 +1,146 @@
+package overrides
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+var (
+	gameA = common.Address{0xaa}
+	gameB = common.Address{0xbb}
+	gameC = common.Address{0xcc}
+)
+
+func TestOverrides(t *testing.T) {
+	t.Run("Apply", func(t *testing.T) {
+		path := writeOverrides(t, filepath.Join(t.TempDir(), "overrides.json"),
+			`{"`+gameA.Hex()+`": "suppress", "`+gameB.Hex()+`": "agree", "`+gameC.Hex()+`": "disagree"}`)
+		overrides := newTestOverrides(t, path)
+		games := testGames()
+		games[1].Pending = true
+		games[1].Indeterminate = true
+		games[2].ImplausibleBlock = true
+		games[2].RollupInternalInconsistency = true
+		result, suppressed := overrides.Apply(games)
+		require.Equal(t, 1, suppressed)
+		require.Len(t, result, 2)
+		require.Equal(t, gameB, result[0].Proxy)
+		require.True(t, result[0].AgreeWithClaim)
+		require.False(t, result[0].Pending)
+		require.False(t, result[0].Indeterminate)
+		require.Equal(t, gameC, result[1].Proxy)
+		require.False(t, result[1].AgreeWithClaim)
+		require.False(t, result[1].ImplausibleBlock)
+		require.False(t, result[1].RollupInternalInconsistency)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		path := writeOverrides(t, filepath.Join(t.TempDir(), "overrides.json"), `{}`)
+		overrides := newTestOverrides(t, path)
+		games := testGames()
+		result, suppressed := overrides.Apply(games)
+		require.Zero(t, suppressed)
+		require.Equal(t, games, result)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := NewOverrides(testlog.Logger(t, log.LvlInfo), filepath.Join(t.TempDir(), "overrides.json"))
+		require.ErrorContains(t, err, "failed to read overrides")
+	})
+
+	t.Run("InvalidClassification", func(t *testing.T) {
+		path := writeOverrides(t, filepath.Join(t.TempDir(), "overrides.json"), `{"`+gameA.Hex()+`": "ignore"}`)
+		_, err := NewOverrides(testlog.Logger(t, log.LvlInfo), path)
+		require.ErrorContains(t, err, "invalid override \"ignore\"")
+	})
+
+	t.Run("ReloadBetweenCycles", func(t *testing.T) {
+		path := writeOverrides(t, filepath.Join(t.TempDir(), "overrides.json"), `{"`+gameA.Hex()+`": "suppress"}`)
+		overrides := newTestOverrides(t, path)
+		result, suppressed := overrides.Apply(testGames())
+		require.Equal(t, 1, suppressed)
+		require.Len(t, result, 2)
+
+		writeOverrides(t, path, `{"`+gameB.Hex()+`": "suppress", "`+gameC.Hex()+`": "suppress"}`)
+		require.Eventually(t, func() bool {
+			result, suppressed = overrides.Apply(testGames())
+			return suppressed == 2
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Len(t, result, 1)
+		require.Equal(t, gameA, result[0].Proxy)
+	})
+
+	t.Run("ReloadOnSymlinkSwap", func(t *testing.T) {
+		// Mirrors how Kubernetes mounts a ConfigMap: the file is a symlink through ..data, which is atomically
+		// replaced with a symlink to a new directory on update.
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "..v1"), 0o755))
+		writeOverrides(t, filepath.Join(dir, "..v1", "overrides.json"), `{"`+gameA.Hex()+`": "suppress"}`)
+		require.NoError(t, os.Symlink("..v1", filepath.Join(dir, "..data")))
+		path := filepath.Join(dir, "overrides.json")
+		require.NoError(t, os.Symlink(filepath.Join("..data", "overrides.json"), path))
+		overrides := newTestOverrides(t, path)
+		_, suppressed := overrides.Apply(testGames())
+		require.Equal(t, 1, suppressed)
+
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "..v2"), 0o755))
+		writeOverrides(t, filepath.Join(dir, "..v2", "overrides.json"), `{"`+gameB.Hex()+`": "suppress", "`+gameC.Hex()+`": "suppress"}`)
+		require.NoError(t, os.Symlink("..v2", filepath.Join(dir, "..data_tmp")))
+		require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+		require.Eventually(t, func() bool {
+			_, suppressed = overrides.Apply(testGames())
+			return suppressed == 2
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("InvalidReloadKeepsPrevious", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		path := writeOverrides(t, filepath.Join(t.TempDir(), "overrides.json"), `{"`+gameA.Hex()+`": "suppress"}`)
+		overrides, err := NewOverrides(logger, path)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, overrides.Close())
+		})
+
+		writeOverrides(t, path, `not json`)
+		require.Eventually(t, func() bool {
+			overrides.Apply(testGames())
+			return logs.FindLog(testlog.NewMessageFilter("Failed to reload overrides, continuing to use previous overrides")) != nil
+		}, 10*time.Second, 10*time.Millisecond)
+		result, suppressed := overrides.Apply(testGames())
+		require.Equal(t, 1, suppressed)
+		require.Len(t, result, 2)
+	})
+}
+
+func testGames() []*types.EnrichedGameData {
+	return []*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: gameA}, AgreeWithClaim: true},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: gameB}, AgreeWithClaim: false},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: gameC}, AgreeWithClaim: true},
+	}
+}
+
+func newTestOverrides(t *testing.T, path string) *Overrides {
+	overrides, err := NewOverrides(testlog.Logger(t, log.LvlInfo), path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, overrides.Close())
+	})
+	return overrides
+}
+
+func writeOverrides(t *testing.T, path string, content string) string {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
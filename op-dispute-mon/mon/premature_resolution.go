@@ -0,0 +1,43 @@
+package mon
+
+import (
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type PrematureResolutionMetrics interface {
+	RecordPrematureResolutions(count int)
+}
+
+// PrematureResolutionMonitor reports games that resolved before the max clock duration had elapsed since they were
+// created. The root claim's clock must expire before a game can resolve so this indicates a contract or chain bug.
+type PrematureResolutionMonitor struct {
+	logger  log.Logger
+	metrics PrematureResolutionMetrics
+}
+
+func NewPrematureResolutionMonitor(logger log.Logger, metrics PrematureResolutionMetrics) *PrematureResolutionMonitor {
+	return &PrematureResolutionMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *PrematureResolutionMonitor) CheckPrematureResolutions(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if game.Status == gameTypes.GameStatusInProgress || game.ResolvedAt.IsZero() {
+			continue
+		}
+		earliest := time.Unix(int64(game.Timestamp+game.MaxClockDuration), 0)
+		if game.ResolvedAt.Before(earliest) {
+			m.logger.Error("Game resolved before its clock could expire", "game", game.Proxy, "status", game.Status,
+				"createdAt", game.Timestamp, "resolvedAt", game.ResolvedAt.Unix(), "earliest", earliest.Unix(), "maxClockDuration", game.MaxClockDuration)
+			count++
+		}
+	}
+	m.metrics.RecordPrematureResolutions(count)
+}
@@ -0,0 +1,51 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPrematureResolutions(t *testing.T) {
+	newGame := func(addr byte, status gameTypes.GameStatus, resolvedAt int64) *types.EnrichedGameData {
+		game := &types.EnrichedGameData{
+			GameMetadata:     gameTypes.GameMetadata{Proxy: common.Address{addr}, Timestamp: 1000},
+			Status:           status,
+			MaxClockDuration: 500,
+		}
+		if resolvedAt != 0 {
+			game.ResolvedAt = time.Unix(resolvedAt, 0)
+		}
+		return game
+	}
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubPrematureResolutionMetrics{}
+	monitor := NewPrematureResolutionMonitor(logger, metrics)
+	monitor.CheckPrematureResolutions([]*types.EnrichedGameData{
+		newGame(0xaa, gameTypes.GameStatusDefenderWon, 1499),   // Resolved too early
+		newGame(0xbb, gameTypes.GameStatusDefenderWon, 1500),   // Resolved as soon as the clock expired
+		newGame(0xcc, gameTypes.GameStatusChallengerWon, 2000), // Resolved normally
+		newGame(0xdd, gameTypes.GameStatusInProgress, 0),
+	})
+	require.Equal(t, 1, metrics.count)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Game resolved before its clock could expire"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xaa}, l.AttrValue("game"))
+
+	monitor.CheckPrematureResolutions(nil)
+	require.Zero(t, metrics.count)
+}
+
+type stubPrematureResolutionMetrics struct {
+	count int
+}
+
+func (s *stubPrematureResolutionMetrics) RecordPrematureResolutions(count int) {
+	s.count = count
+}
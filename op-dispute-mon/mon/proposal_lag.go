@@ -0,0 +1,41 @@
+package mon
+
+import (
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ProposalLagMetrics interface {
+	RecordProposalLag(blocks float64)
+}
+
+// ProposalLagMonitor records how many blocks behind the rollup node's safe head each in progress game proposes a
+// root for. Proposers that are systematically behind produce consistently high lag.
+type ProposalLagMonitor struct {
+	logger  log.Logger
+	metrics ProposalLagMetrics
+}
+
+func NewProposalLagMonitor(logger log.Logger, metrics ProposalLagMetrics) *ProposalLagMonitor {
+	return &ProposalLagMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *ProposalLagMonitor) CheckProposalLag(games []*types.EnrichedGameData) {
+	for _, game := range games {
+		// Only valid proposals are compared as an invalid root isn't a stale version of the latest output.
+		// The safe head is unknown if the game's output root wasn't fetched from the rollup node.
+		if game.Status != gameTypes.GameStatusInProgress || !game.AgreeWithClaim || game.RollupSafeHead.Number == 0 {
+			continue
+		}
+		var lag uint64
+		if game.RollupSafeHead.Number > game.L2BlockNumber {
+			lag = game.RollupSafeHead.Number - game.L2BlockNumber
+		}
+		m.logger.Debug("Proposal lag", "game", game.Proxy, "l2BlockNum", game.L2BlockNumber, "safeHead", game.RollupSafeHead.Number, "lag", lag)
+		m.metrics.RecordProposalLag(float64(lag))
+	}
+}
@@ -0,0 +1,43 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckProposalLag(t *testing.T) {
+	newGame := func(status gameTypes.GameStatus, agree bool, l2BlockNum uint64, safeHead uint64) *types.EnrichedGameData {
+		return &types.EnrichedGameData{
+			Status:         status,
+			AgreeWithClaim: agree,
+			L2BlockNumber:  l2BlockNum,
+			RollupSafeHead: eth.L2BlockRef{Number: safeHead},
+		}
+	}
+	metrics := &stubProposalLagMetrics{}
+	monitor := NewProposalLagMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+	monitor.CheckProposalLag([]*types.EnrichedGameData{
+		newGame(gameTypes.GameStatusInProgress, true, 1000, 1000),    // Current
+		newGame(gameTypes.GameStatusInProgress, true, 800, 1000),     // Lagging
+		newGame(gameTypes.GameStatusInProgress, true, 1200, 1000),    // Ahead of the safe head
+		newGame(gameTypes.GameStatusInProgress, false, 500, 1000),    // Invalid proposal
+		newGame(gameTypes.GameStatusInProgress, true, 500, 0),        // Safe head unknown
+		newGame(gameTypes.GameStatusDefenderWon, true, 500, 1000),    // Resolved
+		newGame(gameTypes.GameStatusChallengerWon, false, 500, 1000), // Resolved
+	})
+	require.Equal(t, []float64{0, 200, 0}, metrics.lags)
+}
+
+type stubProposalLagMetrics struct {
+	lags []float64
+}
+
+func (s *stubProposalLagMetrics) RecordProposalLag(blocks float64) {
+	s.lags = append(s.lags, blocks)
+}
@@ -0,0 +1,58 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ProposerSilenceMetrics interface {
+	RecordProposerSilence(silent bool)
+}
+
+// ProposerSilenceMonitor reports when no previously unseen game has appeared for longer than the silence threshold.
+// On an active chain a prolonged absence of new games may mean the proposer has stopped.
+type ProposerSilenceMonitor struct {
+	logger    log.Logger
+	metrics   ProposerSilenceMetrics
+	clock     RClock
+	threshold time.Duration
+
+	seen      map[common.Address]bool
+	lastNewAt time.Time
+}
+
+func NewProposerSilenceMonitor(logger log.Logger, metrics ProposerSilenceMetrics, clock RClock, threshold time.Duration) *ProposerSilenceMonitor {
+	return &ProposerSilenceMonitor{
+		logger:    logger,
+		metrics:   metrics,
+		clock:     clock,
+		threshold: threshold,
+		seen:      make(map[common.Address]bool),
+		// Measure the silence from startup until the first game is seen
+		lastNewAt: clock.Now(),
+	}
+}
+
+func (m *ProposerSilenceMonitor) CheckProposerSilence(games []*types.EnrichedGameData) {
+	now := m.clock.Now()
+	seen := make(map[common.Address]bool, len(games))
+	for _, game := range games {
+		seen[game.Proxy] = true
+		if !m.seen[game.Proxy] {
+			m.lastNewAt = now
+		}
+	}
+	// Only games still returned by the factory are retained so memory use remains bounded by the game window.
+	m.seen = seen
+	silence := now.Sub(m.lastNewAt)
+	if silence < m.threshold {
+		m.metrics.RecordProposerSilence(false)
+		return
+	}
+	m.logger.Warn("No new games have been created recently, proposer may have stopped",
+		"silence", silence, "threshold", m.threshold, "lastNewGame", m.lastNewAt)
+	m.metrics.RecordProposerSilence(true)
+}
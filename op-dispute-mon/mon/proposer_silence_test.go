@@ -0,0 +1,72 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckProposerSilence(t *testing.T) {
+	game := func(proxy common.Address) *types.EnrichedGameData {
+		return &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: proxy}}
+	}
+	setup := func(t *testing.T) (*ProposerSilenceMonitor, *clock.DeterministicClock, *stubProposerSilenceMetrics, *testlog.CapturingHandler) {
+		cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubProposerSilenceMetrics{}
+		return NewProposerSilenceMonitor(logger, metrics, cl, time.Hour), cl, metrics, logs
+	}
+
+	t.Run("SilentAfterThreshold", func(t *testing.T) {
+		monitor, cl, metrics, logs := setup(t)
+		games := []*types.EnrichedGameData{game(common.Address{0xaa}), game(common.Address{0xbb})}
+		monitor.CheckProposerSilence(games)
+		require.False(t, metrics.silent)
+
+		cl.AdvanceTime(59 * time.Minute)
+		monitor.CheckProposerSilence(games)
+		require.False(t, metrics.silent)
+
+		cl.AdvanceTime(time.Minute)
+		monitor.CheckProposerSilence(games)
+		require.True(t, metrics.silent)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("No new games have been created recently, proposer may have stopped"))
+		require.NotNil(t, l)
+		require.Equal(t, time.Hour, l.AttrValue("silence"))
+	})
+
+	t.Run("NewGameResetsSilence", func(t *testing.T) {
+		monitor, cl, metrics, _ := setup(t)
+		monitor.CheckProposerSilence([]*types.EnrichedGameData{game(common.Address{0xaa})})
+		cl.AdvanceTime(2 * time.Hour)
+		monitor.CheckProposerSilence([]*types.EnrichedGameData{game(common.Address{0xaa})})
+		require.True(t, metrics.silent)
+
+		monitor.CheckProposerSilence([]*types.EnrichedGameData{game(common.Address{0xaa}), game(common.Address{0xbb})})
+		require.False(t, metrics.silent)
+	})
+
+	t.Run("SilentWithNoGamesSinceStartup", func(t *testing.T) {
+		monitor, cl, metrics, _ := setup(t)
+		monitor.CheckProposerSilence(nil)
+		require.False(t, metrics.silent)
+		cl.AdvanceTime(time.Hour)
+		monitor.CheckProposerSilence(nil)
+		require.True(t, metrics.silent)
+	})
+}
+
+type stubProposerSilenceMetrics struct {
+	silent bool
+}
+
+func (s *stubProposerSilenceMetrics) RecordProposerSilence(silent bool) {
+	s.silent = silent
+}
@@ -0,0 +1,233 @@
+package mon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching/rpcblock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+const (
+	replayFixture = "testdata/replay/games.json"
+	replayGolden  = "testdata/replay/golden.json"
+)
+
+// TestReplay runs the games and outputs in the replay fixture through extraction, root claim agreement
+// and forecasting, then asserts the resulting classification distribution matches the golden file.
+// The fixture covers each classification and can be extended with games recorded from a live network.
+// Run with -update to regenerate the golden file after an intentional change to classification logic.
+func TestReplay(t *testing.T) {
+	fixture := loadReplayFixture(t)
+	logger := testlog.Logger(t, log.LvlInfo)
+	rollup := &replayRollupClient{fixture: fixture}
+	extractor := extract.NewExtractor(
+		logger,
+		metrics.NoopMetrics,
+		clock.NewDeterministicClock(time.Unix(0, 0)),
+		func(_ context.Context, game gameTypes.GameMetadata) (extract.GameCaller, error) {
+			return fixture.caller(game.Proxy)
+		},
+		[]extract.GameSource{{
+			FetchGames: func(_ context.Context, _ common.Hash, _ uint64) ([]gameTypes.GameMetadata, error) {
+				return fixture.gameMetadata(), nil
+			},
+		}},
+		nil,
+		extract.GameFilter{},
+		1,
+		0,
+		0,
+		extract.NewAgreementEnricher(logger, metrics.NoopMetrics, clock.NewDeterministicClock(time.Unix(0, 0)), rollup, 0, monTypes.AgreementHeadSafe, nil, nil, nil),
+	)
+	games, ignored, failed, err := extractor.Extract(context.Background(), common.Hash{}, 0)
+	require.NoError(t, err)
+
+	m := &mockForecastMetrics{gameAgreement: zeroGameAgreement()}
+	NewForecast(logger, m, clock.NewDeterministicClock(time.Unix(0, 0)), false, nil, nil, nil, config.DefaultDisagreementSmoothing, nil).Forecast(games, ignored, failed)
+
+	actual := replayDistribution{
+		Ignored: ignored,
+		Failed:  failed,
+		Games:   make(map[string]int),
+	}
+	for status, count := range m.gameAgreement {
+		actual.Games[replayStatusNames[status]] = count
+	}
+
+	if *updateGolden {
+		data, err := json.MarshalIndent(actual, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(replayGolden, append(data, '\n'), 0o644))
+	}
+	data, err := os.ReadFile(replayGolden)
+	require.NoError(t, err)
+	var expected replayDistribution
+	require.NoError(t, json.Unmarshal(data, &expected))
+	require.Equal(t, expected, actual)
+}
+
+var replayStatusNames = map[metrics.GameAgreementStatus]string{
+	metrics.AgreeChallengerAhead:    "agree_challenger_ahead",
+	metrics.DisagreeChallengerAhead: "disagree_challenger_ahead",
+	metrics.AgreeDefenderAhead:      "agree_defender_ahead",
+	metrics.DisagreeDefenderAhead:   "disagree_defender_ahead",
+	metrics.AgreeDefenderWins:       "agree_defender_wins",
+	metrics.DisagreeDefenderWins:    "disagree_defender_wins",
+	metrics.AgreeChallengerWins:     "agree_challenger_wins",
+	metrics.DisagreeChallengerWins:  "disagree_challenger_wins",
+}
+
+var replayGameStatuses = map[string]gameTypes.GameStatus{
+	"in_progress":    gameTypes.GameStatusInProgress,
+	"challenger_won": gameTypes.GameStatusChallengerWon,
+	"defender_won":   gameTypes.GameStatusDefenderWon,
+}
+
+type replayDistribution struct {
+	Ignored int            `json:"ignored"`
+	Failed  int            `json:"failed"`
+	Games   map[string]int `json:"games"`
+}
+
+type replayFixtureData struct {
+	SafeHead      uint64                 `json:"safeHead"`
+	FinalizedHead uint64                 `json:"finalizedHead"`
+	UnsafeHead    uint64                 `json:"unsafeHead"`
+	Outputs       map[uint64]common.Hash `json:"outputs"`
+	Games         []replayGame           `json:"games"`
+}
+
+type replayGame struct {
+	Proxy                 common.Address `json:"proxy"`
+	Timestamp             uint64         `json:"timestamp"`
+	L2BlockNumber         uint64         `json:"l2BlockNumber"`
+	RootClaim             common.Hash    `json:"rootClaim"`
+	Status                string         `json:"status"`
+	BlockNumberChallenged bool           `json:"blockNumberChallenged"`
+	Claims                []replayClaim  `json:"claims"`
+}
+
+type replayClaim struct {
+	Parent   int              `json:"parent"`
+	Depth    faultTypes.Depth `json:"depth"`
+	Index    int64            `json:"index"`
+	Claimant common.Address   `json:"claimant"`
+}
+
+func loadReplayFixture(t *testing.T) *replayFixtureData {
+	data, err := os.ReadFile(filepath.Clean(replayFixture))
+	require.NoError(t, err)
+	var fixture replayFixtureData
+	require.NoError(t, json.Unmarshal(data, &fixture))
+	return &fixture
+}
+
+func (f *replayFixtureData) gameMetadata() []gameTypes.GameMetadata {
+	games := make([]gameTypes.GameMetadata, len(f.Games))
+	for i, game := range f.Games {
+		games[i] = gameTypes.GameMetadata{
+			Index:     uint64(i),
+			Timestamp: game.Timestamp,
+			Proxy:     game.Proxy,
+		}
+	}
+	return games
+}
+
+func (f *replayFixtureData) caller(addr common.Address) (extract.GameCaller, error) {
+	for _, game := range f.Games {
+		if game.Proxy == addr {
+			status, ok := replayGameStatuses[game.Status]
+			if !ok {
+				return nil, fmt.Errorf("unknown status %q for game %v", game.Status, addr)
+			}
+			return &replayGameCaller{game: game, status: status}, nil
+		}
+	}
+	return nil, fmt.Errorf("game %v not in fixture", addr)
+}
+
+// replayGameCaller serves a game's metadata and claims from the fixture.
+// Only the calls required by the enrichers used in the replay are supported.
+type replayGameCaller struct {
+	extract.GameCaller
+	game   replayGame
+	status gameTypes.GameStatus
+}
+
+func (r *replayGameCaller) GetGameMetadata(_ context.Context, _ rpcblock.Block) (contracts.GameMetadata, error) {
+	return contracts.GameMetadata{
+		L2BlockNum:              r.game.L2BlockNumber,
+		RootClaim:               r.game.RootClaim,
+		Status:                  r.status,
+		L2BlockNumberChallenged: r.game.BlockNumberChallenged,
+	}, nil
+}
+
+func (r *replayGameCaller) GetAllClaims(_ context.Context, _ rpcblock.Block) ([]faultTypes.Claim, error) {
+	claims := make([]faultTypes.Claim, len(r.game.Claims))
+	for i, claim := range r.game.Claims {
+		claims[i] = faultTypes.Claim{
+			ClaimData: faultTypes.ClaimData{
+				Position: faultTypes.NewPosition(claim.Depth, big.NewInt(claim.Index)),
+			},
+			Claimant:            claim.Claimant,
+			ContractIndex:       i,
+			ParentContractIndex: claim.Parent,
+		}
+	}
+	return claims, nil
+}
+
+// replayRollupClient serves outputs from the fixture, returning a not found error for unrecorded blocks.
+type replayRollupClient struct {
+	fixture *replayFixtureData
+}
+
+func (r *replayRollupClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	root, ok := r.fixture.Outputs[blockNum]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	// The fixture only records output roots, so use an unverifiable version to skip the internal consistency check
+	return &eth.OutputResponse{
+		Version:    eth.Bytes32{0x01},
+		OutputRoot: eth.Bytes32(root),
+		BlockRef:   eth.L2BlockRef{Number: blockNum},
+	}, nil
+}
+
+func (r *replayRollupClient) SafeHeadAtL1Block(_ context.Context, _ uint64) (*eth.SafeHeadResponse, error) {
+	return &eth.SafeHeadResponse{SafeHead: eth.BlockID{Number: r.fixture.SafeHead}}, nil
+}
+
+func (r *replayRollupClient) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	return &eth.SyncStatus{
+		UnsafeL2:    eth.L2BlockRef{Number: r.fixture.UnsafeHead},
+		SafeL2:      eth.L2BlockRef{Number: r.fixture.SafeHead},
+		FinalizedL2: eth.L2BlockRef{Number: r.fixture.FinalizedHead},
+	}, nil
+}
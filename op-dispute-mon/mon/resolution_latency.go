@@ -0,0 +1,71 @@
+package mon
+
+import (
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ResolutionLatencyMetrics interface {
+	RecordGameResolutionLatency(d time.Duration)
+}
+
+type trackedGame struct {
+	createdAt time.Time
+	resolved  bool
+}
+
+// ResolutionLatencyMonitor tracks games across monitoring cycles and records the time taken
+// from creation to resolution when a game is observed transitioning to a resolved status.
+type ResolutionLatencyMonitor struct {
+	logger     log.Logger
+	clock      RClock
+	metrics    ResolutionLatencyMetrics
+	gameWindow time.Duration
+	games      map[common.Address]*trackedGame
+}
+
+func NewResolutionLatencyMonitor(logger log.Logger, metrics ResolutionLatencyMetrics, clock RClock, gameWindow time.Duration) *ResolutionLatencyMonitor {
+	return &ResolutionLatencyMonitor{
+		logger:     logger,
+		clock:      clock,
+		metrics:    metrics,
+		gameWindow: gameWindow,
+		games:      make(map[common.Address]*trackedGame),
+	}
+}
+
+func (r *ResolutionLatencyMonitor) CheckResolutionLatency(games []*types.EnrichedGameData) {
+	for _, game := range games {
+		resolved := game.Status != gameTypes.GameStatusInProgress
+		tracked, ok := r.games[game.Proxy]
+		if !ok {
+			// Games that are already resolved when first seen are tracked so they are not reported,
+			// since the time of resolution is unknown.
+			r.games[game.Proxy] = &trackedGame{
+				createdAt: time.Unix(int64(game.Timestamp), 0),
+				resolved:  resolved,
+			}
+			continue
+		}
+		if tracked.resolved || !resolved {
+			continue
+		}
+		tracked.resolved = true
+		latency := r.clock.Now().Sub(tracked.createdAt)
+		r.logger.Debug("Game resolved", "game", game.Proxy, "status", game.Status, "latency", latency)
+		r.metrics.RecordGameResolutionLatency(latency)
+	}
+
+	// Prune games once they leave the game window so memory use remains bounded by the game window.
+	// Games missing from a single cycle, such as because they failed to load, are retained so their resolution is
+	// still reported.
+	for addr, tracked := range r.games {
+		if outsideGameWindow(r.clock, r.gameWindow, uint64(tracked.createdAt.Unix())) {
+			delete(r.games, addr)
+		}
+	}
+}
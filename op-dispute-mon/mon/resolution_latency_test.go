@@ -0,0 +1,112 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolutionLatencyMonitor(t *testing.T) {
+	createdAt := time.Unix(1000, 0)
+
+	t.Run("RecordsLatencyOnResolution", func(t *testing.T) {
+		monitor, cl, metrics := setupResolutionLatencyTest(t, createdAt)
+		game := newLatencyGame(common.Address{0x01}, createdAt, gameTypes.GameStatusInProgress)
+
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		require.Empty(t, metrics.latencies)
+
+		cl.AdvanceTime(2 * time.Hour)
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		require.Empty(t, metrics.latencies)
+
+		cl.AdvanceTime(time.Hour)
+		game.Status = gameTypes.GameStatusDefenderWon
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		require.Equal(t, []time.Duration{3 * time.Hour}, metrics.latencies)
+
+		// Only recorded once
+		cl.AdvanceTime(time.Hour)
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		require.Equal(t, []time.Duration{3 * time.Hour}, metrics.latencies)
+	})
+
+	t.Run("IgnoreResolvedWhenFirstSeen", func(t *testing.T) {
+		monitor, cl, metrics := setupResolutionLatencyTest(t, createdAt)
+		game := newLatencyGame(common.Address{0x01}, createdAt, gameTypes.GameStatusChallengerWon)
+
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		cl.AdvanceTime(time.Hour)
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game})
+		require.Empty(t, metrics.latencies)
+	})
+
+	t.Run("RetainGamesMissingFromCycle", func(t *testing.T) {
+		monitor, cl, metrics := setupResolutionLatencyTest(t, createdAt)
+		game1 := newLatencyGame(common.Address{0x01}, createdAt, gameTypes.GameStatusInProgress)
+		game2 := newLatencyGame(common.Address{0x02}, createdAt, gameTypes.GameStatusInProgress)
+
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game1, game2})
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game2})
+		require.Len(t, monitor.games, 2)
+
+		// The game resolved while missing from a cycle
+		cl.AdvanceTime(time.Hour)
+		game1.Status = gameTypes.GameStatusDefenderWon
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game1, game2})
+		require.Equal(t, []time.Duration{time.Hour}, metrics.latencies)
+	})
+
+	t.Run("PruneGamesOutsideWindow", func(t *testing.T) {
+		monitor, cl, metrics := setupResolutionLatencyTest(t, createdAt)
+		game1 := newLatencyGame(common.Address{0x01}, createdAt, gameTypes.GameStatusInProgress)
+		game2 := newLatencyGame(common.Address{0x02}, createdAt.Add(time.Hour), gameTypes.GameStatusInProgress)
+
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game1, game2})
+		require.Len(t, monitor.games, 2)
+
+		cl.AdvanceTime(latencyGameWindow + time.Second)
+		monitor.CheckResolutionLatency(nil)
+		require.Len(t, monitor.games, 1)
+		require.Contains(t, monitor.games, game2.Proxy)
+
+		// A pruned game that reappears is treated as newly seen
+		game1.Status = gameTypes.GameStatusDefenderWon
+		monitor.CheckResolutionLatency([]*types.EnrichedGameData{game1})
+		require.Empty(t, metrics.latencies)
+	})
+}
+
+const latencyGameWindow = 24 * time.Hour
+
+func setupResolutionLatencyTest(t *testing.T, now time.Time) (*ResolutionLatencyMonitor, *clock.DeterministicClock, *stubResolutionLatencyMetrics) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cl := clock.NewDeterministicClock(now)
+	metrics := &stubResolutionLatencyMetrics{}
+	return NewResolutionLatencyMonitor(logger, metrics, cl, latencyGameWindow), cl, metrics
+}
+
+func newLatencyGame(addr common.Address, createdAt time.Time, status gameTypes.GameStatus) *types.EnrichedGameData {
+	return &types.EnrichedGameData{
+		GameMetadata: gameTypes.GameMetadata{
+			Proxy:     addr,
+			Timestamp: uint64(createdAt.Unix()),
+		},
+		Status: status,
+	}
+}
+
+type stubResolutionLatencyMetrics struct {
+	latencies []time.Duration
+}
+
+func (s *stubResolutionLatencyMetrics) RecordGameResolutionLatency(d time.Duration) {
+	s.latencies = append(s.latencies, d)
+}
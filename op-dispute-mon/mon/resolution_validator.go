@@ -0,0 +1,44 @@
+package mon
+
+import (
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type ResolutionValidatorMetrics interface {
+	RecordIncorrectResolutions(count int)
+}
+
+// ResolutionValidator checks that resolved games were won by the correct side according to the reference node.
+// A game resolved as defender wins must have a root claim we agree with and a game resolved as challenger wins
+// must have a root claim we disagree with. Games whose agreement could not be classified are skipped.
+type ResolutionValidator struct {
+	logger  log.Logger
+	metrics ResolutionValidatorMetrics
+}
+
+func NewResolutionValidator(logger log.Logger, metrics ResolutionValidatorMetrics) *ResolutionValidator {
+	return &ResolutionValidator{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (v *ResolutionValidator) ValidateResolutions(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if game.Pending || game.Indeterminate || game.ImplausibleBlock || game.RollupInternalInconsistency {
+			continue
+		}
+		switch {
+		case game.Status == gameTypes.GameStatusDefenderWon && !game.AgreeWithClaim:
+			v.logger.Error("Game resolved in favour of an invalid root claim", "game", game.Proxy, "status", game.Status, "rootClaim", game.RootClaim, "l2BlockNum", game.L2BlockNumber)
+			count++
+		case game.Status == gameTypes.GameStatusChallengerWon && game.AgreeWithClaim:
+			v.logger.Warn("Game resolved against a valid root claim", "game", game.Proxy, "status", game.Status, "rootClaim", game.RootClaim, "l2BlockNum", game.L2BlockNumber)
+			count++
+		}
+	}
+	v.metrics.RecordIncorrectResolutions(count)
+}
@@ -0,0 +1,75 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResolutions(t *testing.T) {
+	newGame := func(addr byte, status gameTypes.GameStatus, agree bool) *types.EnrichedGameData {
+		return &types.EnrichedGameData{
+			GameMetadata:   gameTypes.GameMetadata{Proxy: common.Address{addr}},
+			Status:         status,
+			AgreeWithClaim: agree,
+		}
+	}
+
+	t.Run("CorrectResolutions", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubResolutionValidatorMetrics{}
+		validator := NewResolutionValidator(logger, metrics)
+		validator.ValidateResolutions([]*types.EnrichedGameData{
+			newGame(0xaa, gameTypes.GameStatusDefenderWon, true),
+			newGame(0xbb, gameTypes.GameStatusChallengerWon, false),
+			newGame(0xcc, gameTypes.GameStatusInProgress, false),
+		})
+		require.Zero(t, metrics.count)
+		require.Nil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn)))
+	})
+
+	t.Run("IncorrectResolutions", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubResolutionValidatorMetrics{}
+		validator := NewResolutionValidator(logger, metrics)
+		validator.ValidateResolutions([]*types.EnrichedGameData{
+			newGame(0xaa, gameTypes.GameStatusDefenderWon, false),
+			newGame(0xbb, gameTypes.GameStatusChallengerWon, true),
+			newGame(0xcc, gameTypes.GameStatusDefenderWon, true),
+		})
+		require.Equal(t, 2, metrics.count)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Game resolved in favour of an invalid root claim"))
+		require.NotNil(t, l)
+		require.Equal(t, common.Address{0xaa}, l.AttrValue("game"))
+		l = logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Game resolved against a valid root claim"))
+		require.NotNil(t, l)
+		require.Equal(t, common.Address{0xbb}, l.AttrValue("game"))
+	})
+
+	t.Run("SkipUnclassifiedGames", func(t *testing.T) {
+		logger, _ := testlog.CaptureLogger(t, log.LvlInfo)
+		metrics := &stubResolutionValidatorMetrics{count: 1}
+		validator := NewResolutionValidator(logger, metrics)
+		pending := newGame(0xaa, gameTypes.GameStatusDefenderWon, false)
+		pending.Pending = true
+		indeterminate := newGame(0xbb, gameTypes.GameStatusDefenderWon, false)
+		indeterminate.Indeterminate = true
+		implausible := newGame(0xcc, gameTypes.GameStatusDefenderWon, false)
+		implausible.ImplausibleBlock = true
+		validator.ValidateResolutions([]*types.EnrichedGameData{pending, indeterminate, implausible})
+		require.Zero(t, metrics.count)
+	})
+}
+
+type stubResolutionValidatorMetrics struct {
+	count int
+}
+
+func (s *stubResolutionValidatorMetrics) RecordIncorrectResolutions(count int) {
+	s.count = count
+}
@@ -33,7 +33,7 @@ func (r *ResolutionMonitor) CheckResolutions(games []*types.EnrichedGameData) {
 	statusMetrics := make(map[metrics.ResolutionStatus]int)
 	for _, game := range games {
 		complete := game.Status != gameTypes.GameStatusInProgress
-		duration := uint64(r.clock.Now().Unix()) - game.Timestamp
+		duration := game.Age(r.clock.Now())
 		maxDurationReached := duration >= (2 * game.MaxClockDuration)
 		resolvable := true
 		for _, claim := range game.Claims {
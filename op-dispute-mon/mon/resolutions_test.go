@@ -26,6 +26,23 @@ func TestResolutionMonitor_CheckResolutions(t *testing.T) {
 	require.Equal(t, 1, m.calls[metrics.InProgressBeforeMaxDuration])
 }
 
+func TestResolutionMonitor_FutureTimestamp(t *testing.T) {
+	r, cl, m := newTestResolutionMonitor(t)
+	games := []*types.EnrichedGameData{
+		{
+			GameMetadata:     gameTypes.GameMetadata{Timestamp: uint64(cl.Now().Add(time.Hour).Unix())},
+			MaxClockDuration: 10,
+			Status:           gameTypes.GameStatusInProgress,
+			Claims:           []types.EnrichedClaim{{Resolved: false}},
+		},
+	}
+	r.CheckResolutions(games)
+
+	// Must not underflow and be treated as having reached max duration
+	require.Equal(t, 0, m.calls[metrics.InProgressMaxDuration])
+	require.Equal(t, 1, m.calls[metrics.InProgressBeforeMaxDuration])
+}
+
 func newTestResolutionMonitor(t *testing.T) (*ResolutionMonitor, *clock.DeterministicClock, *stubResolutionMetrics) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	cl := clock.NewDeterministicClock(time.Unix(int64(time.Hour.Seconds()), 0))
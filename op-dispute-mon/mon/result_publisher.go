@@ -0,0 +1,23 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+// ResultPublisher publishes the result of each game monitored in a cycle to a result sink.
+type ResultPublisher struct {
+	sink results.ResultSink
+}
+
+func NewResultPublisher(sink results.ResultSink) *ResultPublisher {
+	return &ResultPublisher{sink: sink}
+}
+
+func (p *ResultPublisher) PublishResults(games []*types.EnrichedGameData) {
+	gameResults := make([]results.GameResult, 0, len(games))
+	for _, game := range games {
+		gameResults = append(gameResults, results.NewGameResult(game))
+	}
+	p.sink.Publish(gameResults)
+}
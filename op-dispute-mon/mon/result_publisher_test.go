@@ -0,0 +1,37 @@
+package mon
+
+import (
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishResults(t *testing.T) {
+	critical, info := &stubResultSink{}, &stubResultSink{}
+	router := results.NewSeverityRouter(nil, map[results.Severity]results.ResultSink{
+		results.SeverityCritical: critical,
+		results.SeverityInfo:     info,
+	})
+	publisher := NewResultPublisher(router)
+	publisher.PublishResults([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, AgreeWithClaim: true},
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}},
+	})
+	require.Len(t, info.results, 1)
+	require.Equal(t, common.Address{0xaa}, info.results[0].Game)
+	require.Len(t, critical.results, 1)
+	require.Equal(t, common.Address{0xbb}, critical.results[0].Game)
+	require.Equal(t, results.SeverityCritical, critical.results[0].Severity)
+}
+
+type stubResultSink struct {
+	results []results.GameResult
+}
+
+func (s *stubResultSink) Publish(gameResults []results.GameResult) {
+	s.results = append(s.results, gameResults...)
+}
@@ -0,0 +1,91 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// bufferSize is the number of results that may be queued before new results are dropped.
+const bufferSize = 1000
+
+// produceTimeout is the maximum time allowed to publish a single result.
+const produceTimeout = 10 * time.Second
+
+type Metrics interface {
+	RecordResultPublishErrors()
+}
+
+// Producer publishes messages to a Kafka topic.
+type Producer interface {
+	Produce(ctx context.Context, topic string, key []byte, value []byte) error
+}
+
+// KafkaSink publishes each GameResult to a Kafka topic, keyed by the game address.
+// Results are published in the background so producer errors and latency never block monitoring.
+type KafkaSink struct {
+	logger   log.Logger
+	metrics  Metrics
+	producer Producer
+	topic    string
+
+	results chan GameResult
+	done    chan struct{}
+}
+
+var _ ResultSink = (*KafkaSink)(nil)
+
+func NewKafkaSink(logger log.Logger, metrics Metrics, producer Producer, topic string) *KafkaSink {
+	s := &KafkaSink{
+		logger:   logger,
+		metrics:  metrics,
+		producer: producer,
+		topic:    topic,
+		results:  make(chan GameResult, bufferSize),
+		done:     make(chan struct{}),
+	}
+	go s.loop()
+	return s
+}
+
+// Publish queues the results to be published.
+// If the queue is full the result is dropped and counted as a publish error.
+func (s *KafkaSink) Publish(results []GameResult) {
+	for _, result := range results {
+		select {
+		case s.results <- result:
+		default:
+			s.logger.Warn("Result queue full, dropping result", "game", result.Game)
+			s.metrics.RecordResultPublishErrors()
+		}
+	}
+}
+
+// Close publishes any queued results.
+// Publish must not be called after Close.
+func (s *KafkaSink) Close() {
+	close(s.results)
+	<-s.done
+}
+
+func (s *KafkaSink) loop() {
+	defer close(s.done)
+	for result := range s.results {
+		if err := s.produce(result); err != nil {
+			s.logger.Warn("Failed to publish game result", "game", result.Game, "topic", s.topic, "err", err)
+			s.metrics.RecordResultPublishErrors()
+		}
+	}
+}
+
+func (s *KafkaSink) produce(result GameResult) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), produceTimeout)
+	defer cancel()
+	return s.producer.Produce(ctx, s.topic, []byte(result.Game.Hex()), value)
+}
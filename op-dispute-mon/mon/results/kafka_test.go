@@ -0,0 +1,107 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaSink(t *testing.T) {
+	t.Run("OneMessagePerGame", func(t *testing.T) {
+		producer := &mockProducer{}
+		sink, metrics := newTestSink(t, producer)
+		game1 := &monTypes.EnrichedGameData{
+			GameMetadata:  types.GameMetadata{Proxy: common.Address{0xaa}, GameType: 1},
+			Factory:       common.Address{0xfa},
+			L2BlockNumber: 42,
+			RootClaim:     common.Hash{0x01},
+			Status:        types.GameStatusDefenderWon,
+		}
+		game2 := &monTypes.EnrichedGameData{
+			GameMetadata:   types.GameMetadata{Proxy: common.Address{0xbb}},
+			Status:         types.GameStatusInProgress,
+			AgreeWithClaim: true,
+		}
+		sink.Publish([]GameResult{NewGameResult(game1), NewGameResult(game2)})
+		sink.Close()
+
+		require.Len(t, producer.messages, 2)
+		require.Equal(t, "results", producer.messages[0].topic)
+		require.Equal(t, common.Address{0xaa}.Hex(), string(producer.messages[0].key))
+		require.Equal(t, common.Address{0xbb}.Hex(), string(producer.messages[1].key))
+		var result GameResult
+		require.NoError(t, json.Unmarshal(producer.messages[0].value, &result))
+		require.Equal(t, NewGameResult(game1), result)
+		require.Zero(t, metrics.errors.Load())
+	})
+
+	t.Run("ProducerError", func(t *testing.T) {
+		producer := &mockProducer{err: errors.New("broker unavailable")}
+		sink, metrics := newTestSink(t, producer)
+		sink.Publish([]GameResult{{Game: common.Address{0xaa}}, {Game: common.Address{0xbb}}})
+		sink.Close()
+		require.EqualValues(t, 2, metrics.errors.Load())
+	})
+
+	t.Run("DoesNotBlockWhenProducerBlocked", func(t *testing.T) {
+		release := make(chan struct{})
+		producer := &mockProducer{release: release}
+		sink, metrics := newTestSink(t, producer)
+		results := make([]GameResult, bufferSize+10)
+		sink.Publish(results)
+		// At most one result is held by the producer so the remainder beyond the buffer are dropped
+		require.GreaterOrEqual(t, metrics.errors.Load(), int64(9))
+		close(release)
+		sink.Close()
+	})
+}
+
+func newTestSink(t *testing.T, producer Producer) (*KafkaSink, *stubMetrics) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	metrics := &stubMetrics{}
+	return NewKafkaSink(logger, metrics, producer, "results"), metrics
+}
+
+type stubMetrics struct {
+	errors atomic.Int64
+}
+
+func (s *stubMetrics) RecordResultPublishErrors() {
+	s.errors.Add(1)
+}
+
+type message struct {
+	topic string
+	key   []byte
+	value []byte
+}
+
+type mockProducer struct {
+	m        sync.Mutex
+	messages []message
+	err      error
+	release  chan struct{}
+}
+
+func (p *mockProducer) Produce(_ context.Context, topic string, key []byte, value []byte) error {
+	if p.release != nil {
+		<-p.release
+	}
+	if p.err != nil {
+		return p.err
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.messages = append(p.messages, message{topic: topic, key: key, value: value})
+	return nil
+}
@@ -0,0 +1,41 @@
+package results
+
+import (
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ResultSink receives the result of each game monitored in a cycle.
+type ResultSink interface {
+	Publish(results []GameResult)
+}
+
+// GameResult is the outcome of monitoring a single game.
+type GameResult struct {
+	Game              common.Address `json:"game"`
+	Factory           common.Address `json:"factory"`
+	GameType          uint32         `json:"gameType"`
+	L2BlockNumber     uint64         `json:"l2BlockNumber"`
+	RootClaim         common.Hash    `json:"rootClaim"`
+	ExpectedRootClaim common.Hash    `json:"expectedRootClaim"`
+	Status            string         `json:"status"`
+	AgreeWithClaim    bool           `json:"agreeWithClaim"`
+	Pending           bool           `json:"pending"`
+
+	// Severity is set when the result is routed by a SeverityRouter.
+	Severity Severity `json:"severity,omitempty"`
+}
+
+func NewGameResult(game *monTypes.EnrichedGameData) GameResult {
+	return GameResult{
+		Game:              game.Proxy,
+		Factory:           game.Factory,
+		GameType:          game.GameType,
+		L2BlockNumber:     game.L2BlockNumber,
+		RootClaim:         game.RootClaim,
+		ExpectedRootClaim: game.ExpectedRootClaim,
+		Status:            game.Status.String(),
+		AgreeWithClaim:    game.AgreeWithClaim,
+		Pending:           game.Pending,
+	}
+}
@@ -0,0 +1,32 @@
+package results
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGameResult(t *testing.T) {
+	game := &monTypes.EnrichedGameData{
+		GameMetadata:      types.GameMetadata{Proxy: common.Address{0xaa}, GameType: 1},
+		Factory:           common.Address{0xfa},
+		L2BlockNumber:     42,
+		RootClaim:         common.Hash{0x01},
+		ExpectedRootClaim: common.Hash{0x02},
+		Status:            types.GameStatusDefenderWon,
+		Pending:           true,
+	}
+	require.Equal(t, GameResult{
+		Game:              common.Address{0xaa},
+		Factory:           common.Address{0xfa},
+		GameType:          1,
+		L2BlockNumber:     42,
+		RootClaim:         common.Hash{0x01},
+		ExpectedRootClaim: common.Hash{0x02},
+		Status:            "Defender Won",
+		Pending:           true,
+	}, NewGameResult(game))
+}
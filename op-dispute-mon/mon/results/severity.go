@@ -0,0 +1,75 @@
+package results
+
+import "fmt"
+
+// Severity is the alert severity of a game result.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Severities is every severity, from least to most severe.
+var Severities = []Severity{SeverityInfo, SeverityWarning, SeverityCritical}
+
+// ParseSeverity returns the Severity named by name.
+func ParseSeverity(name string) (Severity, error) {
+	for _, severity := range Severities {
+		if string(severity) == name {
+			return severity, nil
+		}
+	}
+	return "", fmt.Errorf("invalid result severity: %v", name)
+}
+
+// SeverityFunc derives the alert severity of a game result.
+type SeverityFunc func(result GameResult) Severity
+
+// DefaultSeverity reports games disagreeing with their root claim as critical and games whose classification is
+// still pending as a warning. All other games are informational.
+func DefaultSeverity(result GameResult) Severity {
+	switch {
+	case result.Pending:
+		return SeverityWarning
+	case !result.AgreeWithClaim:
+		return SeverityCritical
+	default:
+		return SeverityInfo
+	}
+}
+
+// SeverityRouter is a ResultSink that publishes each result to the sink for its severity.
+// Results with a severity that has no sink are dropped.
+type SeverityRouter struct {
+	severity SeverityFunc
+	sinks    map[Severity]ResultSink
+}
+
+var _ ResultSink = (*SeverityRouter)(nil)
+
+// NewSeverityRouter creates a new SeverityRouter that derives the severity of results with severity.
+// If severity is nil, DefaultSeverity is used.
+func NewSeverityRouter(severity SeverityFunc, sinks map[Severity]ResultSink) *SeverityRouter {
+	if severity == nil {
+		severity = DefaultSeverity
+	}
+	return &SeverityRouter{
+		severity: severity,
+		sinks:    sinks,
+	}
+}
+
+func (r *SeverityRouter) Publish(results []GameResult) {
+	routed := make(map[Severity][]GameResult)
+	for _, result := range results {
+		result.Severity = r.severity(result)
+		routed[result.Severity] = append(routed[result.Severity], result)
+	}
+	for severity, results := range routed {
+		if sink, ok := r.sinks[severity]; ok {
+			sink.Publish(results)
+		}
+	}
+}
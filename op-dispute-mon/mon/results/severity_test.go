@@ -0,0 +1,86 @@
+package results
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSeverity(t *testing.T) {
+	require.Equal(t, SeverityInfo, DefaultSeverity(GameResult{AgreeWithClaim: true}))
+	require.Equal(t, SeverityCritical, DefaultSeverity(GameResult{AgreeWithClaim: false}))
+	require.Equal(t, SeverityWarning, DefaultSeverity(GameResult{Pending: true}))
+}
+
+func TestSeverityRouter(t *testing.T) {
+	agree := GameResult{Game: common.Address{0xaa}, AgreeWithClaim: true}
+	disagree := GameResult{Game: common.Address{0xbb}}
+	pending := GameResult{Game: common.Address{0xcc}, Pending: true}
+
+	t.Run("DefaultSeverity", func(t *testing.T) {
+		info, warning, critical := &stubSink{}, &stubSink{}, &stubSink{}
+		router := NewSeverityRouter(nil, map[Severity]ResultSink{
+			SeverityInfo:     info,
+			SeverityWarning:  warning,
+			SeverityCritical: critical,
+		})
+		router.Publish([]GameResult{agree, disagree, pending})
+		require.Equal(t, []common.Address{{0xaa}}, info.games())
+		require.Equal(t, []common.Address{{0xcc}}, warning.games())
+		require.Equal(t, []common.Address{{0xbb}}, critical.games())
+		require.Equal(t, SeverityCritical, critical.results[0].Severity)
+	})
+
+	t.Run("CustomSeverity", func(t *testing.T) {
+		// Only disagreements with blocks above 1000 are critical, all other results are informational.
+		severity := func(result GameResult) Severity {
+			if !result.AgreeWithClaim && result.L2BlockNumber > 1000 {
+				return SeverityCritical
+			}
+			return SeverityInfo
+		}
+		info, critical := &stubSink{}, &stubSink{}
+		router := NewSeverityRouter(severity, map[Severity]ResultSink{
+			SeverityInfo:     info,
+			SeverityCritical: critical,
+		})
+		highBlock := GameResult{Game: common.Address{0xdd}, L2BlockNumber: 1001}
+		router.Publish([]GameResult{agree, disagree, pending, highBlock})
+		require.Equal(t, []common.Address{{0xaa}, {0xbb}, {0xcc}}, info.games())
+		require.Equal(t, []common.Address{{0xdd}}, critical.games())
+	})
+
+	t.Run("DropResultsWithoutSink", func(t *testing.T) {
+		critical := &stubSink{}
+		router := NewSeverityRouter(nil, map[Severity]ResultSink{SeverityCritical: critical})
+		router.Publish([]GameResult{agree, disagree, pending})
+		require.Equal(t, []common.Address{{0xbb}}, critical.games())
+	})
+}
+
+type stubSink struct {
+	results []GameResult
+}
+
+func (s *stubSink) Publish(results []GameResult) {
+	s.results = append(s.results, results...)
+}
+
+func (s *stubSink) games() []common.Address {
+	var games []common.Address
+	for _, result := range s.results {
+		games = append(games, result.Game)
+	}
+	return games
+}
+
+func TestParseSeverity(t *testing.T) {
+	for _, severity := range Severities {
+		actual, err := ParseSeverity(string(severity))
+		require.NoError(t, err)
+		require.Equal(t, severity, actual)
+	}
+	_, err := ParseSeverity("urgent")
+	require.ErrorContains(t, err, "invalid result severity: urgent")
+}
@@ -0,0 +1,64 @@
+package results
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// MultiSink is a ResultSink that publishes results to each of its sinks.
+type MultiSink []ResultSink
+
+var _ ResultSink = (MultiSink)(nil)
+
+func (m MultiSink) Publish(results []GameResult) {
+	for _, sink := range m {
+		sink.Publish(results)
+	}
+}
+
+// LogSink is a ResultSink that logs each result at the level matching its severity.
+type LogSink struct {
+	logger log.Logger
+}
+
+var _ ResultSink = (*LogSink)(nil)
+
+func NewLogSink(logger log.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Publish(results []GameResult) {
+	for _, result := range results {
+		logFn := s.logger.Info
+		switch result.Severity {
+		case SeverityWarning:
+			logFn = s.logger.Warn
+		case SeverityCritical:
+			logFn = s.logger.Error
+		}
+		logFn("Game result", "game", result.Game, "severity", result.Severity, "status", result.Status,
+			"l2BlockNum", result.L2BlockNumber, "rootClaim", result.RootClaim, "expectedRootClaim", result.ExpectedRootClaim,
+			"agreeWithClaim", result.AgreeWithClaim, "pending", result.Pending)
+	}
+}
+
+// EventRecorder appends events to a log of events, such as a history.Writer.
+type EventRecorder interface {
+	AppendEvent(event any)
+}
+
+// EventSink is a ResultSink that appends each result to an EventRecorder.
+type EventSink struct {
+	recorder EventRecorder
+}
+
+var _ ResultSink = (*EventSink)(nil)
+
+func NewEventSink(recorder EventRecorder) *EventSink {
+	return &EventSink{recorder: recorder}
+}
+
+func (s *EventSink) Publish(results []GameResult) {
+	for _, result := range results {
+		s.recorder.AppendEvent(result)
+	}
+}
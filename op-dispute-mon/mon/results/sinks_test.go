@@ -0,0 +1,52 @@
+package results
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSink(t *testing.T) {
+	a, b := &stubSink{}, &stubSink{}
+	MultiSink{a, b}.Publish([]GameResult{{Game: common.Address{0xaa}}})
+	require.Equal(t, []common.Address{{0xaa}}, a.games())
+	require.Equal(t, []common.Address{{0xaa}}, b.games())
+}
+
+func TestLogSink(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	sink := NewLogSink(logger)
+	sink.Publish([]GameResult{
+		{Game: common.Address{0xaa}, Severity: SeverityInfo},
+		{Game: common.Address{0xbb}, Severity: SeverityWarning},
+		{Game: common.Address{0xcc}, Severity: SeverityCritical},
+	})
+	for level, game := range map[slog.Level]common.Address{
+		log.LevelInfo:  {0xaa},
+		log.LevelWarn:  {0xbb},
+		log.LevelError: {0xcc},
+	} {
+		l := logs.FindLog(testlog.NewLevelFilter(level), testlog.NewMessageFilter("Game result"))
+		require.NotNil(t, l)
+		require.Equal(t, game, l.AttrValue("game"))
+	}
+}
+
+func TestEventSink(t *testing.T) {
+	recorder := &stubRecorder{}
+	results := []GameResult{{Game: common.Address{0xaa}}, {Game: common.Address{0xbb}}}
+	NewEventSink(recorder).Publish(results)
+	require.Equal(t, []any{results[0], results[1]}, recorder.events)
+}
+
+type stubRecorder struct {
+	events []any
+}
+
+func (s *stubRecorder) AppendEvent(event any) {
+	s.events = append(s.events, event)
+}
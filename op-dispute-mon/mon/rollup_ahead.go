@@ -0,0 +1,44 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type RollupAheadMetrics interface {
+	RecordRollupAheadRatio(ratio float64)
+}
+
+// RollupAheadMonitor reports the fraction of games whose disputed block the rollup node's safe head had reached
+// when the game was checked. Games disputing later blocks must be deferred so a falling ratio indicates the rollup
+// node is lagging behind the blocks being disputed.
+type RollupAheadMonitor struct {
+	logger  log.Logger
+	metrics RollupAheadMetrics
+}
+
+func NewRollupAheadMonitor(logger log.Logger, metrics RollupAheadMetrics) *RollupAheadMonitor {
+	return &RollupAheadMonitor{
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+func (m *RollupAheadMonitor) CheckRollupAhead(games []*types.EnrichedGameData) {
+	if len(games) == 0 {
+		// Nothing is waiting on the rollup node
+		m.metrics.RecordRollupAheadRatio(1)
+		return
+	}
+	ahead := 0
+	for _, game := range games {
+		if !game.BeyondOutputRange && game.RollupSafeHead.Number >= game.L2BlockNumber {
+			ahead++
+		}
+	}
+	behind := len(games) - ahead
+	if behind > 0 {
+		m.logger.Debug("Rollup node safe head behind disputed blocks", "ahead", ahead, "behind", behind)
+	}
+	m.metrics.RecordRollupAheadRatio(float64(ahead) / float64(len(games)))
+}
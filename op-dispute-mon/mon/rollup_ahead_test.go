@@ -0,0 +1,40 @@
+package mon
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRollupAhead(t *testing.T) {
+	t.Run("NoGames", func(t *testing.T) {
+		metrics := &stubRollupAheadMetrics{}
+		monitor := NewRollupAheadMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckRollupAhead(nil)
+		require.Equal(t, 1.0, metrics.ratio)
+	})
+
+	t.Run("MixedGames", func(t *testing.T) {
+		metrics := &stubRollupAheadMetrics{}
+		monitor := NewRollupAheadMonitor(testlog.Logger(t, log.LvlInfo), metrics)
+		monitor.CheckRollupAhead([]*types.EnrichedGameData{
+			{L2BlockNumber: 100, RollupSafeHead: eth.L2BlockRef{Number: 150}},                          // Ahead
+			{L2BlockNumber: 150, RollupSafeHead: eth.L2BlockRef{Number: 150}},                          // At the disputed block
+			{L2BlockNumber: 200, RollupSafeHead: eth.L2BlockRef{Number: 150}},                          // Behind
+			{L2BlockNumber: 300, RollupSafeHead: eth.L2BlockRef{Number: 150}, BeyondOutputRange: true}, // Beyond the output range
+		})
+		require.Equal(t, 0.5, metrics.ratio)
+	})
+}
+
+type stubRollupAheadMetrics struct {
+	ratio float64
+}
+
+func (s *stubRollupAheadMetrics) RecordRollupAheadRatio(ratio float64) {
+	s.ratio = ratio
+}
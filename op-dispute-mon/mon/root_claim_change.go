@@ -0,0 +1,60 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type RootClaimChangeMetrics interface {
+	RecordRootClaimChanged(count int)
+}
+
+type trackedRoot struct {
+	rootClaim common.Hash
+	timestamp uint64
+}
+
+// RootClaimChangeMonitor tracks the root claim of each game across monitoring cycles.
+// A game's root claim is immutable so a change indicates either an L1 reorg or a serious bug.
+type RootClaimChangeMonitor struct {
+	logger     log.Logger
+	metrics    RootClaimChangeMetrics
+	clock      RClock
+	gameWindow time.Duration
+	roots      map[common.Address]trackedRoot
+}
+
+func NewRootClaimChangeMonitor(logger log.Logger, metrics RootClaimChangeMetrics, clock RClock, gameWindow time.Duration) *RootClaimChangeMonitor {
+	return &RootClaimChangeMonitor{
+		logger:     logger,
+		metrics:    metrics,
+		clock:      clock,
+		gameWindow: gameWindow,
+		roots:      make(map[common.Address]trackedRoot),
+	}
+}
+
+func (m *RootClaimChangeMonitor) CheckRootClaims(games []*types.EnrichedGameData) {
+	changed := 0
+	for _, game := range games {
+		previous, ok := m.roots[game.Proxy]
+		m.roots[game.Proxy] = trackedRoot{rootClaim: game.RootClaim, timestamp: game.Timestamp}
+		if !ok || previous.rootClaim == game.RootClaim {
+			continue
+		}
+		changed++
+		m.logger.Error("Game root claim changed",
+			"game", game.Proxy, "blockNum", game.L2BlockNumber, "previousRootClaim", previous.rootClaim, "rootClaim", game.RootClaim)
+	}
+	// Games are retained until they leave the game window, rather than when missing from a single cycle, so a change
+	// is still detected if the game failed to load in between. This keeps memory use bounded by the game window.
+	for addr, tracked := range m.roots {
+		if outsideGameWindow(m.clock, m.gameWindow, tracked.timestamp) {
+			delete(m.roots, addr)
+		}
+	}
+	m.metrics.RecordRootClaimChanged(changed)
+}
@@ -0,0 +1,61 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorRootClaims(t *testing.T) {
+	newGame := func(proxy common.Address, root common.Hash) *types.EnrichedGameData {
+		return &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: proxy, Timestamp: 1000}, RootClaim: root}
+	}
+	game1 := common.Address{0xaa}
+	game2 := common.Address{0xbb}
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubRootClaimChangeMetrics{}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	monitor := NewRootClaimChangeMonitor(logger, metrics, cl, time.Hour)
+	changedFilter := testlog.NewMessageFilter("Game root claim changed")
+
+	monitor.CheckRootClaims([]*types.EnrichedGameData{newGame(game1, common.Hash{0x01}), newGame(game2, common.Hash{0x02})})
+	require.Equal(t, []int{0}, metrics.changed)
+
+	monitor.CheckRootClaims([]*types.EnrichedGameData{newGame(game1, common.Hash{0x03}), newGame(game2, common.Hash{0x02})})
+	require.Equal(t, []int{0, 1}, metrics.changed)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), changedFilter)
+	require.NotNil(t, l)
+	require.Equal(t, game1, l.AttrValue("game"))
+	require.Equal(t, common.Hash{0x01}, l.AttrValue("previousRootClaim"))
+	require.Equal(t, common.Hash{0x03}, l.AttrValue("rootClaim"))
+
+	// The new root claim is tracked so the change is only reported once
+	monitor.CheckRootClaims([]*types.EnrichedGameData{newGame(game1, common.Hash{0x03}), newGame(game2, common.Hash{0x02})})
+	require.Equal(t, []int{0, 1, 0}, metrics.changed)
+	require.Len(t, logs.FindLogs(changedFilter), 1)
+
+	// Games missing from a cycle are retained so a change is still reported when they return
+	monitor.CheckRootClaims([]*types.EnrichedGameData{newGame(game2, common.Hash{0x02})})
+	monitor.CheckRootClaims([]*types.EnrichedGameData{newGame(game1, common.Hash{0x04}), newGame(game2, common.Hash{0x02})})
+	require.Equal(t, []int{0, 1, 0, 0, 1}, metrics.changed)
+
+	// Games outside the game window are not retained
+	cl.AdvanceTime(time.Hour + time.Second)
+	monitor.CheckRootClaims(nil)
+	require.Empty(t, monitor.roots)
+}
+
+type stubRootClaimChangeMetrics struct {
+	changed []int
+}
+
+func (s *stubRootClaimChangeMetrics) RecordRootClaimChanged(count int) {
+	s.changed = append(s.changed, count)
+}
@@ -0,0 +1,91 @@
+package mon
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type SelfConsistencyMetrics interface {
+	RecordSelfConsistencyFailure()
+}
+
+type SelfConsistencyClient interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// SelfConsistencyCheck periodically fetches the output for the rollup node's finalized head twice and verifies
+// both responses are identical. Finalized outputs never change so a difference indicates a flaky or corrupt node.
+// Not safe for concurrent use. It is only used from the monitoring loop.
+type SelfConsistencyCheck struct {
+	logger   log.Logger
+	metrics  SelfConsistencyMetrics
+	client   SelfConsistencyClient
+	interval uint
+
+	cycles uint
+}
+
+// NewSelfConsistencyCheck creates a new SelfConsistencyCheck that checks the rollup node once every interval cycles.
+func NewSelfConsistencyCheck(logger log.Logger, metrics SelfConsistencyMetrics, client SelfConsistencyClient, interval uint) *SelfConsistencyCheck {
+	return &SelfConsistencyCheck{
+		logger:   logger,
+		metrics:  metrics,
+		client:   client,
+		interval: interval,
+	}
+}
+
+// Check verifies the rollup node agrees with itself if a check is due this cycle.
+// Failures to fetch the output are logged but not reported as inconsistencies.
+func (c *SelfConsistencyCheck) Check(ctx context.Context) {
+	due := c.cycles%c.interval == 0
+	c.cycles++
+	if !due {
+		return
+	}
+	status, err := c.client.SyncStatus(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to fetch sync status for self-consistency check", "err", err)
+		return
+	}
+	blockNum := status.FinalizedL2.Number
+	first, err := c.client.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		c.logger.Warn("Failed to fetch output for self-consistency check", "l2BlockNum", blockNum, "err", err)
+		return
+	}
+	second, err := c.client.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		c.logger.Warn("Failed to fetch output for self-consistency check", "l2BlockNum", blockNum, "err", err)
+		return
+	}
+	if !sameOutput(first, second) {
+		c.logger.Error("Rollup node returned different outputs for the same finalized block",
+			"l2BlockNum", blockNum, "first", first.OutputRoot, "second", second.OutputRoot,
+			"firstBlockHash", first.BlockRef.Hash, "secondBlockHash", second.BlockRef.Hash)
+		c.metrics.RecordSelfConsistencyFailure()
+	}
+}
+
+// sameOutput returns true if both outputs commit to the same block and state.
+// The sync status reported alongside the output is expected to change between calls so is not compared.
+func sameOutput(a, b *eth.OutputResponse) bool {
+	return a.Version == b.Version &&
+		a.OutputRoot == b.OutputRoot &&
+		a.BlockRef == b.BlockRef &&
+		a.WithdrawalStorageRoot == b.WithdrawalStorageRoot &&
+		a.StateRoot == b.StateRoot
+}
+
+// checkSelfConsistency returns an Extract that runs the self-consistency check before loading any games.
+func checkSelfConsistency(extract Extract, check *SelfConsistencyCheck) Extract {
+	return func(ctx context.Context, blockHash common.Hash, minTimestamp uint64) ([]*types.EnrichedGameData, int, int, error) {
+		check.Check(ctx)
+		return extract(ctx, blockHash, minTimestamp)
+	}
+}
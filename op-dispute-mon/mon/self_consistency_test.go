@@ -0,0 +1,101 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfConsistencyCheck(t *testing.T) {
+	setup := func(t *testing.T, interval uint) (*SelfConsistencyCheck, *stubSelfConsistencyClient, *stubSelfConsistencyMetrics, *testlog.CapturingHandler) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		client := &stubSelfConsistencyClient{finalized: 100, roots: []common.Hash{{0xaa}}}
+		metrics := &stubSelfConsistencyMetrics{}
+		return NewSelfConsistencyCheck(logger, metrics, client, interval), client, metrics, logs
+	}
+
+	t.Run("Consistent", func(t *testing.T) {
+		check, client, metrics, _ := setup(t, 1)
+		check.Check(context.Background())
+		require.Equal(t, []uint64{100, 100}, client.requested)
+		require.Zero(t, metrics.failures)
+	})
+
+	t.Run("DifferentRoots", func(t *testing.T) {
+		check, client, metrics, logs := setup(t, 1)
+		client.roots = []common.Hash{{0xaa}, {0xbb}}
+		check.Check(context.Background())
+		require.Equal(t, 1, metrics.failures)
+		l := logs.FindLog(testlog.NewLevelFilter(log.LevelError), testlog.NewMessageFilter("Rollup node returned different outputs for the same finalized block"))
+		require.NotNil(t, l)
+		require.Equal(t, uint64(100), l.AttrValue("l2BlockNum"))
+	})
+
+	t.Run("OnlyChecksEveryInterval", func(t *testing.T) {
+		check, client, metrics, _ := setup(t, 3)
+		client.roots = []common.Hash{{0xaa}, {0xbb}}
+		for i := 0; i < 6; i++ {
+			check.Check(context.Background())
+		}
+		require.Len(t, client.requested, 4)
+		require.Equal(t, 2, metrics.failures)
+	})
+
+	t.Run("OutputError", func(t *testing.T) {
+		check, client, metrics, logs := setup(t, 1)
+		client.outputErr = errors.New("boom")
+		check.Check(context.Background())
+		require.Zero(t, metrics.failures)
+		require.NotNil(t, logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Failed to fetch output for self-consistency check")))
+	})
+
+	t.Run("SyncStatusError", func(t *testing.T) {
+		check, client, metrics, _ := setup(t, 1)
+		client.syncStatusErr = errors.New("boom")
+		check.Check(context.Background())
+		require.Empty(t, client.requested)
+		require.Zero(t, metrics.failures)
+	})
+}
+
+type stubSelfConsistencyClient struct {
+	finalized     uint64
+	syncStatusErr error
+	outputErr     error
+	// roots are returned by successive calls in turn
+	roots     []common.Hash
+	requested []uint64
+}
+
+func (s *stubSelfConsistencyClient) SyncStatus(_ context.Context) (*eth.SyncStatus, error) {
+	if s.syncStatusErr != nil {
+		return nil, s.syncStatusErr
+	}
+	return &eth.SyncStatus{FinalizedL2: eth.L2BlockRef{Number: s.finalized}}, nil
+}
+
+func (s *stubSelfConsistencyClient) OutputAtBlock(_ context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if s.outputErr != nil {
+		return nil, s.outputErr
+	}
+	root := s.roots[len(s.requested)%len(s.roots)]
+	s.requested = append(s.requested, blockNum)
+	return &eth.OutputResponse{
+		OutputRoot: eth.Bytes32(root),
+		BlockRef:   eth.L2BlockRef{Number: blockNum},
+	}, nil
+}
+
+type stubSelfConsistencyMetrics struct {
+	failures int
+}
+
+func (s *stubSelfConsistencyMetrics) RecordSelfConsistencyFailure() {
+	s.failures++
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/bonds"
@@ -12,13 +16,21 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/time/rate"
 
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/extract"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/history"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/overrides"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/status"
 	"github.com/ethereum-optimism/optimism/op-dispute-mon/version"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
@@ -32,25 +44,46 @@ type Service struct {
 	logger       log.Logger
 	metrics      metrics.Metricer
 	monitor      *gameMonitor
+	backfill     *backfill
 	honestActors types.HonestActors
 
-	factoryContract *contracts.DisputeGameFactoryContract
+	gameSources []extract.GameSource
 
 	cl clock.Clock
 
+	// classifyClock is the clock used for time based classifications.
+	// It is the clock skew guard if enabled, otherwise cl.
+	classifyClock RClock
+	clockSkew     *ClockSkewGuard
+
 	extractor    *extract.Extractor
 	forecast     *Forecast
 	bonds        *bonds.Bonds
 	game         *extract.GameCallerCreator
+	metadata     *extract.EventMetadataLoader
 	resolutions  *ResolutionMonitor
 	claims       *ClaimMonitor
 	withdrawals  *WithdrawalMonitor
 	rollupClient *sources.RollupClient
+	archive      *sources.RollupClient
+
+	// backfillExtractor loads games older than the game window, rate limited. nil if backfill is disabled.
+	backfillExtractor *extract.Extractor
+
+	// chainRollupClients are the rollup clients for further L2 chains, keyed by chain ID.
+	chainRollupClients map[uint64]*sources.RollupClient
 
 	l1Client *ethclient.Client
 
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
+	statusSrv    *status.Server
+	history      *history.Writer
+	transitions  *history.Writer
+	resultsFile  *history.Writer
+	kafkaSink    *results.KafkaSink
+	lastBatch    *LastBatch
+	overrides    *overrides.Overrides
 
 	stopped atomic.Bool
 }
@@ -60,7 +93,7 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	s := &Service{
 		cl:           clock.SystemClock,
 		logger:       logger,
-		metrics:      metrics.NewMetrics(),
+		metrics:      newMetricer(cfg, metrics.NewMetrics()),
 		honestActors: types.NewHonestActors(cfg.HonestActors),
 	}
 
@@ -71,6 +104,15 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	return s, nil
 }
 
+// newMetricer returns the metrics implementation to use for the specified config.
+// In dry-run mode all metrics are discarded so validating a config against a live network doesn't pollute dashboards.
+func newMetricer(cfg *config.Config, m metrics.Metricer) metrics.Metricer {
+	if cfg.DryRun {
+		return metrics.NoopMetrics
+	}
+	return m
+}
+
 func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error {
 	if err := s.initL1Client(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init l1 client: %w", err)
@@ -78,16 +120,30 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	if err := s.initPProf(&cfg.PprofConfig); err != nil {
 		return fmt.Errorf("failed to init profiling: %w", err)
 	}
-	if err := s.initMetricsServer(&cfg.MetricsConfig); err != nil {
+	if cfg.DryRun {
+		s.logger.Warn("Running in dry-run mode, metrics will not be recorded")
+	} else if err := s.initMetricsServer(&cfg.MetricsConfig); err != nil {
 		return fmt.Errorf("failed to init metrics server: %w", err)
 	}
-	if err := s.initFactoryContract(cfg); err != nil {
+	if err := s.initStatusServer(cfg); err != nil {
+		return fmt.Errorf("failed to init status server: %w", err)
+	}
+	s.initHistory(cfg)
+	s.initTransitions(cfg)
+	s.initResultsFile(cfg)
+	s.initKafkaSink(cfg)
+	if err := s.initOverrides(cfg); err != nil {
+		return fmt.Errorf("failed to init overrides: %w", err)
+	}
+	if err := s.initFactoryContract(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to create factory contract bindings: %w", err)
 	}
 	if err := s.initOutputRollupClient(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init rollup client: %w", err)
 	}
 
+	s.initClockSkewGuard(cfg) // Must be called before monitors using classifyClock are initialized
+
 	s.initClaimMonitor(cfg)
 	s.initResolutionMonitor()
 	s.initWithdrawalMonitor()
@@ -99,7 +155,7 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	s.initForecast(cfg)
 	s.initBonds()
 
-	s.initMonitor(ctx, cfg) // Monitor must be initialized last
+	s.initMonitor(cfg) // Monitor must be initialized last
 
 	s.metrics.RecordInfo(version.SimpleWithMeta)
 	s.metrics.RecordUp()
@@ -107,16 +163,32 @@ func (s *Service) initFromConfig(ctx context.Context, cfg *config.Config) error
 	return nil
 }
 
+func (s *Service) initClockSkewGuard(cfg *config.Config) {
+	s.classifyClock = s.cl
+	if cfg.MaxClockSkew == 0 {
+		return
+	}
+	fetchL1Time := func(ctx context.Context) (uint64, error) {
+		header, err := s.l1Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch latest l1 header: %w", err)
+		}
+		return header.Time, nil
+	}
+	s.clockSkew = NewClockSkewGuard(s.logger, s.metrics, s.cl, fetchL1Time, cfg.MaxClockSkew)
+	s.classifyClock = s.clockSkew
+}
+
 func (s *Service) initClaimMonitor(cfg *config.Config) {
-	s.claims = NewClaimMonitor(s.logger, s.cl, s.honestActors, s.metrics)
+	s.claims = NewClaimMonitor(s.logger, s.classifyClock, s.honestActors, s.metrics)
 }
 
 func (s *Service) initResolutionMonitor() {
-	s.resolutions = NewResolutionMonitor(s.logger, s.metrics, s.cl)
+	s.resolutions = NewResolutionMonitor(s.logger, s.metrics, s.classifyClock)
 }
 
 func (s *Service) initWithdrawalMonitor() {
-	s.withdrawals = NewWithdrawalMonitor(s.logger, s.cl, s.metrics, s.honestActors)
+	s.withdrawals = NewWithdrawalMonitor(s.logger, s.classifyClock, s.metrics, s.honestActors)
 }
 
 func (s *Service) initGameCallerCreator() {
@@ -124,24 +196,189 @@ func (s *Service) initGameCallerCreator() {
 }
 
 func (s *Service) initExtractor(cfg *config.Config) {
-	s.extractor = extract.NewExtractor(
-		s.logger,
-		s.game.CreateContract,
-		s.factoryContract.GetGamesAtOrAfter,
-		cfg.IgnoredGames,
-		cfg.MaxConcurrency,
+	// Share the output client for each chain so a single rate limit applies to all of its output requests
+	outputClient := s.outputClient(cfg, s.rollupClient)
+	agreementEnrichers := map[uint64]extract.Enricher{
+		0: extract.NewAgreementEnricher(s.logger, s.metrics, s.cl, outputClient, cfg.ComparisonTimeout, cfg.AgreementHead, cfg.FinalityPredicates[0], outputComparator(cfg), s.archiveClient()),
+	}
+	claimAgreementEnrichers := map[uint64]extract.Enricher{
+		0: extract.NewClaimAgreementEnricher(s.logger, outputClient, outputComparator(cfg)),
+	}
+	chainOutputs := map[uint64]extract.ChainOutputs{
+		0: {Client: outputClient, ComparatorFor: outputComparator(cfg)},
+	}
+	for chainID, client := range s.chainRollupClients {
+		chainOutputClient := s.outputClient(cfg, client)
+		// The archive rollup node only serves the primary chain
+		comparator := chainOutputComparator(cfg, chainID)
+		agreementEnrichers[chainID] = extract.NewAgreementEnricher(s.logger, s.metrics, s.cl, chainOutputClient, cfg.ComparisonTimeout, cfg.AgreementHead, cfg.FinalityPredicates[chainID], comparator, nil)
+		claimAgreementEnrichers[chainID] = extract.NewClaimAgreementEnricher(s.logger, chainOutputClient, comparator)
+		chainOutputs[chainID] = extract.ChainOutputs{Client: chainOutputClient, ComparatorFor: comparator}
+	}
+	var agreementEnricher extract.Enricher = extract.NewChainEnricher(s.metrics, agreementEnrichers)
+	if cfg.ResolvedCacheGrace != 0 {
+		agreementEnricher = extract.NewResolvedCacheEnricher(s.metrics, s.cl, agreementEnricher, cfg.ResolvedCacheGrace)
+	}
+	enrichers := []extract.Enricher{
 		extract.NewClaimEnricher(),
+		extract.NewTurnEnricher(s.honestActors),
 		extract.NewRecipientEnricher(), // Must be called before WithdrawalsEnricher and BondEnricher
 		extract.NewWithdrawalsEnricher(),
 		extract.NewBondEnricher(),
 		extract.NewBalanceEnricher(),
 		extract.NewL1HeadBlockNumEnricher(s.l1Client),
-		extract.NewAgreementEnricher(s.logger, s.metrics, s.rollupClient),
+		extract.NewResolvedAtEnricher(),
+		extract.NewDepthEnricher(),
+		agreementEnricher,
+		extract.NewChainEnricher(s.metrics, claimAgreementEnrichers),
+	}
+	if cfg.HighActivityThreshold != 0 {
+		enrichers = append(enrichers, extract.NewActivityEnricher())
+	}
+	if cfg.MaxAffordableBond != nil {
+		enrichers = append(enrichers, extract.NewRequiredBondEnricher())
+	}
+	if len(s.chainRollupClients) > 0 {
+		// Must be called after the agreement enricher
+		enrichers = append(enrichers, extract.NewCrossChainEnricher(s.logger, chainOutputs))
+	}
+	filter := extract.GameFilter{GameTypes: cfg.GameTypes, MinBond: cfg.MinBond, SampleRate: cfg.SampleRate, Statuses: cfg.GameStatuses}
+	createContract := s.game.CreateContract
+	if cfg.MetadataFromEvents {
+		s.metadata = extract.NewEventMetadataLoader(s.l1Client)
+		createContract = s.metadata.WrapCreator(createContract)
+	}
+	s.extractor = extract.NewExtractor(
+		s.logger,
+		s.metrics,
+		s.cl,
+		createContract,
+		s.gameSources,
+		cfg.IgnoredGames,
+		filter,
+		cfg.MaxConcurrency,
+		cfg.MetadataTimeout,
+		cfg.MaxL2BlockNumber,
+		enrichers...,
 	)
+	// Evict the cached contract bindings and metadata of games that are no longer listed by the factories
+	s.extractor.AddRetainer(s.game.RetainGames)
+	if s.metadata != nil {
+		s.extractor.AddRetainer(s.metadata.RetainGames)
+	}
+	if cfg.BackfillWindow != 0 {
+		s.initBackfillExtractor(cfg, filter, enrichers)
+	}
+}
+
+// initBackfillExtractor creates the extractor for games older than the game window. It has its own contract bindings
+// and metadata cache so the older games don't evict those of the regular monitoring cycles.
+// Games are loaded one at a time, throttled before their metadata is loaded.
+// Extractor and cache metrics are not recorded so they continue to reflect the regular monitoring cycles.
+func (s *Service) initBackfillExtractor(cfg *config.Config, filter extract.GameFilter, enrichers []extract.Enricher) {
+	game := extract.NewGameCallerCreator(metrics.NoopMetrics, batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+	createContract := game.CreateContract
+	var metadata *extract.EventMetadataLoader
+	if cfg.MetadataFromEvents {
+		metadata = extract.NewEventMetadataLoader(s.l1Client)
+		createContract = metadata.WrapCreator(createContract)
+	}
+	createContract = extract.NewThrottle(s.cl, cfg.BackfillInterval).WrapCreator(createContract)
+	s.backfillExtractor = extract.NewExtractor(
+		s.logger,
+		metrics.NoopMetrics,
+		s.cl,
+		createContract,
+		s.gameSources,
+		cfg.IgnoredGames,
+		filter,
+		1,
+		cfg.MetadataTimeout,
+		cfg.MaxL2BlockNumber,
+		enrichers...,
+	)
+	// Each game is only backfilled once so nothing needs to remain cached once it completes
+	s.backfillExtractor.AddRetainer(func([]gameTypes.GameMetadata) {
+		game.RetainGames(nil)
+		if metadata != nil {
+			metadata.RetainGames(nil)
+		}
+	})
+}
+
+// outputClient returns the rollup client used to fetch outputs, applying the rate limit if configured.
+func (s *Service) outputClient(cfg *config.Config, client *sources.RollupClient) extract.OutputRollupClient {
+	if cfg.RollupRpcRateLimit == 0 {
+		return client
+	}
+	return extract.NewRateLimitedRollupClient(client, s.metrics, rate.Limit(cfg.RollupRpcRateLimit), int(cfg.RollupRpcRateBurst))
+}
+
+// archiveClient returns the archive rollup client, or nil if no archive rollup node is configured.
+func (s *Service) archiveClient() extract.OutputRollupClient {
+	if s.archive == nil {
+		return nil
+	}
+	return s.archive
+}
+
+// fetchFinalizedBlockNumber returns the number of the latest finalized L1 block.
+func (s *Service) fetchFinalizedBlockNumber(ctx context.Context) (uint64, error) {
+	header, err := s.l1Client.HeaderByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// fetchBlockNumberByHash returns the number of the L1 block with the specified hash.
+func (s *Service) fetchBlockNumberByHash(ctx context.Context, blockHash common.Hash) (uint64, error) {
+	header, err := s.l1Client.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
+// probeRollup checks the rollup node is reachable and able to report its sync status.
+func (s *Service) probeRollup(ctx context.Context) error {
+	_, err := s.rollupClient.SyncStatus(ctx)
+	return err
+}
+
+// outputComparator returns the comparator selector for the configured output format fork, if any.
+// Games disputing blocks before the fork are compared against the legacy V0 output root computed from the output's
+// components, while later games are compared against the output root reported by the upgraded rollup node.
+func outputComparator(cfg *config.Config) extract.ComparatorSelector {
+	if cfg.OutputForkBlock == nil {
+		return nil
+	}
+	return extract.ForkComparatorSelector(*cfg.OutputForkBlock, extract.ComputedOutputRootV0, extract.ReportedOutputRoot)
+}
+
+// chainOutputComparator returns the comparator selector for the specified L2 chain, applying the chain's output
+// domain if configured.
+func chainOutputComparator(cfg *config.Config, chainID uint64) extract.ComparatorSelector {
+	domain, ok := cfg.ChainOutputDomains[chainID]
+	if !ok {
+		return outputComparator(cfg)
+	}
+	return extract.DomainComparatorSelector(domain, outputComparator(cfg), extract.Keccak256RootHasher)
 }
 
 func (s *Service) initForecast(cfg *config.Config) {
-	s.forecast = NewForecast(s.logger, s.metrics)
+	var recorders historyRecorders
+	if s.history != nil {
+		recorders = append(recorders, s.history)
+	}
+	if s.lastBatch != nil {
+		recorders = append(recorders, s.lastBatch)
+	}
+	var recorder HistoryRecorder
+	if len(recorders) > 0 {
+		recorder = recorders
+	}
+	s.forecast = NewForecast(s.logger, s.metrics, s.cl, cfg.DryRun, recorder, cfg.TrustedProposers, cfg.BenignGames, cfg.DisagreementSmoothing, GroupKeyFor(cfg.GameGrouping))
 }
 
 func (s *Service) initBonds() {
@@ -154,6 +391,24 @@ func (s *Service) initOutputRollupClient(ctx context.Context, cfg *config.Config
 		return fmt.Errorf("failed to dial rollup client: %w", err)
 	}
 	s.rollupClient = outputRollupClient
+	for chainID, rpc := range cfg.ChainRollupRpcs {
+		client, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, s.logger, rpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial rollup client for l2 chain %v: %w", chainID, err)
+		}
+		if s.chainRollupClients == nil {
+			s.chainRollupClients = make(map[uint64]*sources.RollupClient)
+		}
+		s.chainRollupClients[chainID] = client
+	}
+	if cfg.ArchiveRollupRpc == "" {
+		return nil
+	}
+	archive, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, s.logger, cfg.ArchiveRollupRpc)
+	if err != nil {
+		return fmt.Errorf("failed to dial archive rollup client: %w", err)
+	}
+	s.archive = archive
 	return nil
 }
 
@@ -192,7 +447,12 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	if !ok {
 		return fmt.Errorf("metrics were enabled, but metricer %T does not expose registry for metrics-server", s.metrics)
 	}
-	metricsSrv, err := opmetrics.StartServer(m.Registry(), cfg.ListenAddr, cfg.ListenPort)
+	s.lastBatch = NewLastBatch(s.logger)
+	registry := m.Registry()
+	mux := http.NewServeMux()
+	mux.Handle("/", promhttp.InstrumentMetricHandler(registry, promhttp.HandlerFor(registry, promhttp.HandlerOpts{})))
+	mux.Handle(LastBatchPath, s.lastBatch)
+	metricsSrv, err := httputil.StartHTTPServer(net.JoinHostPort(cfg.ListenAddr, strconv.Itoa(cfg.ListenPort)), mux)
 	if err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
@@ -201,14 +461,116 @@ func (s *Service) initMetricsServer(cfg *opmetrics.CLIConfig) error {
 	return nil
 }
 
-func (s *Service) initFactoryContract(cfg *config.Config) error {
-	factoryContract := contracts.NewDisputeGameFactoryContract(s.metrics, cfg.GameFactoryAddress,
-		batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
-	s.factoryContract = factoryContract
+func (s *Service) initStatusServer(cfg *config.Config) error {
+	if cfg.StatusSocket == "" {
+		return nil
+	}
+	statusSrv, err := status.StartServer(s.logger, cfg.StatusSocket, cfg.StatusSigningKey)
+	if err != nil {
+		return err
+	}
+	s.logger.Info("started status server", "path", statusSrv.Addr())
+	s.statusSrv = statusSrv
 	return nil
 }
 
-func (s *Service) initMonitor(ctx context.Context, cfg *config.Config) {
+func (s *Service) initHistory(cfg *config.Config) {
+	if cfg.HistoryPath == "" {
+		return
+	}
+	s.history = history.NewWriter(s.logger, s.metrics, s.cl, cfg.HistoryPath, history.Rotation{
+		MaxSize: cfg.HistoryMaxSize,
+		Daily:   cfg.HistoryRotateDaily,
+	})
+	s.logger.Info("writing monitoring history", "path", cfg.HistoryPath)
+}
+
+func (s *Service) initResultsFile(cfg *config.Config) {
+	if cfg.ResultsPath == "" {
+		return
+	}
+	s.resultsFile = history.NewWriter(s.logger, s.metrics, s.cl, cfg.ResultsPath, history.Rotation{})
+	s.logger.Info("writing game results", "path", cfg.ResultsPath)
+}
+
+func (s *Service) initKafkaSink(cfg *config.Config) {
+	if cfg.ResultProducer == nil {
+		return
+	}
+	s.kafkaSink = results.NewKafkaSink(s.logger, s.metrics, cfg.ResultProducer, cfg.ResultTopic)
+	s.logger.Info("publishing game results to kafka", "topic", cfg.ResultTopic)
+}
+
+// resultSink returns the sink to publish game results to, routing each result by its severity.
+// Returns nil if no results are published.
+func (s *Service) resultSink(cfg *config.Config) results.ResultSink {
+	sinks := make(map[results.Severity]results.ResultSink)
+	route := func(sink results.ResultSink, severities []results.Severity) {
+		for _, severity := range severities {
+			existing, _ := sinks[severity].(results.MultiSink)
+			sinks[severity] = append(existing, sink)
+		}
+	}
+	route(results.NewLogSink(s.logger), cfg.ResultLogSeverities)
+	if s.resultsFile != nil {
+		severities := cfg.ResultsPathSeverities
+		if len(severities) == 0 {
+			severities = results.Severities
+		}
+		route(results.NewEventSink(s.resultsFile), severities)
+	}
+	if s.kafkaSink != nil {
+		route(s.kafkaSink, results.Severities)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	return results.NewSeverityRouter(cfg.ResultSeverity, sinks)
+}
+
+func (s *Service) initTransitions(cfg *config.Config) {
+	if cfg.TransitionsPath == "" {
+		return
+	}
+	s.transitions = history.NewWriter(s.logger, s.metrics, s.cl, cfg.TransitionsPath, history.Rotation{
+		MaxSize: cfg.TransitionsMaxSize,
+	})
+	s.logger.Info("writing classification transitions", "path", cfg.TransitionsPath)
+}
+
+func (s *Service) initOverrides(cfg *config.Config) error {
+	if cfg.OverridesFile == "" {
+		return nil
+	}
+	o, err := overrides.NewOverrides(s.logger, cfg.OverridesFile)
+	if err != nil {
+		return err
+	}
+	s.logger.Info("loaded game overrides", "path", cfg.OverridesFile)
+	s.overrides = o
+	return nil
+}
+
+func (s *Service) initFactoryContract(ctx context.Context, cfg *config.Config) error {
+	l1ChainID, err := s.l1Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load l1 chain id: %w", err)
+	}
+	factories := append([]common.Address{cfg.GameFactoryAddress}, cfg.AdditionalGameFactories...)
+	for _, addr := range factories {
+		factoryContract := contracts.NewDisputeGameFactoryContract(s.metrics, addr,
+			batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+		s.gameSources = append(s.gameSources, extract.GameSource{
+			Factory:    addr,
+			FetchGames: factoryContract.GetGamesAtOrAfter,
+			L2ChainID:  cfg.GameFactoryChainIDs[addr],
+			L1ChainID:  l1ChainID.Uint64(),
+		})
+	}
+	return nil
+}
+
+func (s *Service) initMonitor(cfg *config.Config) {
 	blockHashFetcher := func(ctx context.Context, blockNumber *big.Int) (common.Hash, error) {
 		block, err := s.l1Client.BlockByNumber(ctx, blockNumber)
 		if err != nil {
@@ -217,29 +579,164 @@ func (s *Service) initMonitor(ctx context.Context, cfg *config.Config) {
 		return block.Hash(), nil
 	}
 	l2ChallengesMonitor := NewL2ChallengesMonitor(s.logger, s.metrics)
+	outputRangeMonitor := NewOutputRangeMonitor(s.logger, s.metrics, s.cl, cfg.GameWindow, cfg.MaxDeferredCycles)
+	blockAgeMonitor := NewBlockAgeMonitor(s.logger, s.metrics)
+	blockBucketMonitor := NewBlockBucketMonitor(s.logger, s.metrics)
+	archiveFallbackMonitor := NewArchiveFallbackMonitor(s.logger, s.metrics)
+	claimAgreementMonitor := NewClaimAgreementMonitor(s.logger, s.metrics)
+	rootClaimChangeMonitor := NewRootClaimChangeMonitor(s.logger, s.metrics, s.cl, cfg.GameWindow)
+	resolutionLatencyMonitor := NewResolutionLatencyMonitor(s.logger, s.metrics, s.classifyClock, cfg.GameWindow)
+	futureTimestampMonitor := NewFutureTimestampMonitor(s.logger, s.metrics, s.classifyClock, cfg.ClockSkewTolerance)
+	duplicateClaimsMonitor := NewDuplicateClaimsMonitor(s.logger, s.metrics)
+	distinctClaimsMonitor := NewDistinctClaimsMonitor(s.logger, s.metrics)
+	cycleDiffMonitor := NewCycleDiffMonitor(s.logger, s.metrics, s.cl, cfg.GameWindow)
+	rollupAheadMonitor := NewRollupAheadMonitor(s.logger, s.metrics)
+	ourTurnMonitor := NewOurTurnMonitor(s.logger, s.metrics)
+	resolutionValidator := NewResolutionValidator(s.logger, s.metrics)
+	gameStatusMonitor := NewGameStatusMonitor(s.metrics)
+	prematureResolutionMonitor := NewPrematureResolutionMonitor(s.logger, s.metrics)
+	inProgressAgeMonitor := NewInProgressAgeMonitor(s.metrics, s.classifyClock)
+	proposalLagMonitor := NewProposalLagMonitor(s.logger, s.metrics)
+	depthAnomalyMonitor := NewDepthAnomalyMonitor(s.logger, s.metrics)
+	monitors := []Monitor{
+		s.bonds.CheckBonds,
+		s.claims.CheckClaims,
+		s.withdrawals.CheckWithdrawals,
+		l2ChallengesMonitor.CheckL2Challenges,
+		outputRangeMonitor.CheckOutputRange,
+		blockAgeMonitor.CheckBlockAge,
+		blockBucketMonitor.CheckBlockBuckets,
+		archiveFallbackMonitor.CheckArchiveFallbacks,
+		claimAgreementMonitor.CheckClaimAgreement,
+		rootClaimChangeMonitor.CheckRootClaims,
+		resolutionLatencyMonitor.CheckResolutionLatency,
+		futureTimestampMonitor.CheckFutureTimestamps,
+		duplicateClaimsMonitor.CheckDuplicateClaims,
+		distinctClaimsMonitor.CheckDistinctClaims,
+		cycleDiffMonitor.CheckCycleDiff,
+		rollupAheadMonitor.CheckRollupAhead,
+		ourTurnMonitor.CheckOurTurn,
+		resolutionValidator.ValidateResolutions,
+		gameStatusMonitor.CheckGameStatuses,
+		prematureResolutionMonitor.CheckPrematureResolutions,
+		inProgressAgeMonitor.CheckInProgressAges,
+		proposalLagMonitor.CheckProposalLag,
+		depthAnomalyMonitor.CheckDepthAnomalies,
+	}
+	if s.statusSrv != nil {
+		monitors = append(monitors, NewSummaryMonitor(s.cl, s.statusSrv).CheckSummary)
+	}
+	if cfg.ExpectedBondToken != (common.Address{}) {
+		monitors = append(monitors, NewBondTokenMonitor(s.logger, s.metrics, cfg.ExpectedBondToken).CheckBondTokens)
+	}
+	if cfg.HighActivityThreshold != 0 {
+		monitors = append(monitors, NewHighActivityMonitor(s.logger, s.metrics, cfg.HighActivityThreshold).CheckHighActivity)
+	}
+	if cfg.MaxAffordableBond != nil {
+		monitors = append(monitors, NewUnaffordableMoveMonitor(s.logger, s.metrics, cfg.MaxAffordableBond).CheckUnaffordableMoves)
+	}
+	if len(s.chainRollupClients) > 0 {
+		monitors = append(monitors, NewCrossChainRootMonitor(s.logger, s.metrics).CheckCrossChainRoots)
+	}
+	if cfg.ProposerSilenceThreshold != 0 {
+		monitors = append(monitors, NewProposerSilenceMonitor(s.logger, s.metrics, s.cl, cfg.ProposerSilenceThreshold).CheckProposerSilence)
+	}
+	if s.transitions != nil {
+		monitors = append(monitors, NewTransitionMonitor(s.logger, s.cl, s.transitions).CheckTransitions)
+	}
+	if cfg.CanaryGame != (common.Address{}) {
+		monitors = append(monitors, NewCanaryMonitor(s.logger, s.metrics, cfg.CanaryGame, cfg.CanaryAgreeWithClaim).CheckCanary)
+	}
+	if sink := s.resultSink(cfg); sink != nil {
+		monitors = append(monitors, NewResultPublisher(sink).PublishResults)
+	}
+	var customRules ContextMonitor
+	if len(cfg.DetectionRules) > 0 {
+		customRules = NewCustomRuleMonitor(s.logger, s.metrics, customRuleTimeout, cfg.DetectionRules).CheckCustomRules
+	}
+	var events CycleEventRecorder
+	if cfg.CycleEvents {
+		events = NewCycleEvents(os.Stdout, s.cl)
+	}
+	source := s.extractor.Extract
+	var resetSource func()
+	if cfg.IncrementalDetection {
+		incremental := extract.NewIncrementalExtractor(s.logger, s.metrics, s.extractor, s.fetchBlockNumberByHash, cfg.FullRescanInterval)
+		source = incremental.Extract
+		resetSource = incremental.ForceFullRescan
+	}
+	extract := checkRollupHealth(source, s.probeRollup, s.metrics)
+	if s.overrides != nil {
+		extract = applyOverrides(extract, s.overrides)
+	}
+	if s.clockSkew != nil {
+		extract = checkClockSkew(extract, s.clockSkew)
+	}
+	if cfg.SelfCheckInterval != 0 {
+		extract = checkSelfConsistency(extract, NewSelfConsistencyCheck(s.logger, s.metrics, s.rollupClient, cfg.SelfCheckInterval))
+	}
+	if cfg.AnchorStateRegistry != (common.Address{}) {
+		registry := contracts.NewAnchorStateRegistryContract(s.metrics, cfg.AnchorStateRegistry,
+			batching.NewMultiCaller(s.l1Client.Client(), batching.DefaultBatchSize))
+		check := NewAnchorFreshnessCheck(s.logger, s.metrics, s.classifyClock, registry.GetAnchorRoot, s.rollupClient, cfg.AnchorGameType, cfg.AnchorAgeThreshold)
+		extract = checkAnchorFreshness(extract, check)
+	}
+	if cfg.CycleDeadline != 0 {
+		extract = withCycleDeadline(extract, cfg.CycleDeadline, s.metrics, resetSource)
+	}
+	var breaker *circuitBreaker
+	if cfg.CircuitBreakerThreshold != 0 {
+		breaker = newCircuitBreaker(s.logger, s.cl, s.metrics, cfg.CircuitBreakerThreshold, cfg.CircuitBreakerCooldown)
+	}
+	fetchBlockNumber := s.l1Client.BlockNumber
+	if cfg.PinnedL1Block != nil {
+		fetchBlockNumber = pinnedBlockNumber(*cfg.PinnedL1Block, s.fetchFinalizedBlockNumber)
+	}
 	s.monitor = newGameMonitor(
-		ctx,
+		// The monitor is stopped via Stop rather than by cancelling the service context
+		// so that an in-flight monitoring cycle can complete during a graceful shutdown.
+		context.Background(),
 		s.logger,
 		s.cl,
 		s.metrics,
 		cfg.MonitorInterval,
 		cfg.GameWindow,
+		cfg.ShutdownGracePeriod,
+		cfg.StartupGraceCycles,
+		cfg.ErrorRateThreshold,
+		cfg.MonitorIntervalJitter,
 		s.forecast.Forecast,
-		s.bonds.CheckBonds,
 		s.resolutions.CheckResolutions,
-		s.claims.CheckClaims,
-		s.withdrawals.CheckWithdrawals,
-		l2ChallengesMonitor.CheckL2Challenges,
-		s.extractor.Extract,
-		s.l1Client.BlockNumber,
+		extract,
+		fetchBlockNumber,
 		blockHashFetcher,
+		events,
+		breaker,
+		customRules,
+		monitors...,
 	)
+	if s.backfillExtractor != nil {
+		s.backfill = newBackfill(
+			context.Background(),
+			s.logger,
+			s.cl,
+			s.metrics,
+			cfg.BackfillWindow,
+			cfg.GameWindow,
+			s.backfillExtractor.ExtractRange,
+			fetchBlockNumber,
+			blockHashFetcher,
+		)
+	}
 }
 
 func (s *Service) Start(ctx context.Context) error {
 	s.logger.Info("Starting scheduler")
 	s.logger.Info("Starting monitoring")
 	s.monitor.StartMonitoring()
+	if s.backfill != nil {
+		s.backfill.Start()
+	}
 	s.logger.Info("Dispute monitor game service start completed")
 	return nil
 }
@@ -252,6 +749,12 @@ func (s *Service) Stop(ctx context.Context) error {
 	s.logger.Info("Stopping dispute mon service")
 
 	var result error
+	if s.monitor != nil {
+		s.monitor.StopMonitoring()
+	}
+	if s.backfill != nil {
+		s.backfill.Stop()
+	}
 	if s.pprofService != nil {
 		if err := s.pprofService.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close pprof server: %w", err))
@@ -262,6 +765,35 @@ func (s *Service) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to close metrics server: %w", err))
 		}
 	}
+	if s.statusSrv != nil {
+		if err := s.statusSrv.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close status server: %w", err))
+		}
+	}
+	if s.overrides != nil {
+		if err := s.overrides.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close overrides: %w", err))
+		}
+	}
+	// Close after the monitor is stopped so no further history is appended.
+	if s.history != nil {
+		if err := s.history.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close history: %w", err))
+		}
+	}
+	if s.transitions != nil {
+		if err := s.transitions.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close transitions: %w", err))
+		}
+	}
+	if s.kafkaSink != nil {
+		s.kafkaSink.Close()
+	}
+	if s.resultsFile != nil {
+		if err := s.resultsFile.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close results file: %w", err))
+		}
+	}
 	s.stopped.Store(true)
 	s.logger.Info("stopped dispute mon service", "err", result)
 	return result
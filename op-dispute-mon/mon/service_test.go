@@ -0,0 +1,122 @@
+package mon
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/config"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/results"
+	monTypes "github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	games := []*monTypes.EnrichedGameData{
+		{GameMetadata: types.GameMetadata{Proxy: common.Address{0x01}}, Status: types.GameStatusDefenderWon, AgreeWithClaim: true},
+		{GameMetadata: types.GameMetadata{Proxy: common.Address{0x02}}, Status: types.GameStatusChallengerWon, AgreeWithClaim: false},
+	}
+
+	t.Run("Enabled", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		cfg := &config.Config{DryRun: true, DisagreementSmoothing: config.DefaultDisagreementSmoothing}
+		registered := metrics.NewMetrics()
+		s := &Service{logger: logger, cl: clock.NewDeterministicClock(time.Unix(0, 0)), metrics: newMetricer(cfg, registered)}
+		require.Equal(t, metrics.NoopMetrics, s.metrics)
+		s.initForecast(cfg)
+
+		before := disputeMonSeries(t, registered)
+		s.forecast.Forecast(games, 0, 0)
+		require.Equal(t, before, disputeMonSeries(t, registered))
+
+		classified := logs.FindLogs(testlog.NewLevelFilter(log.LevelInfo), testlog.NewMessageFilter("Classified game"))
+		require.Len(t, classified, len(games))
+		for i, l := range classified {
+			require.Equal(t, games[i].Proxy, l.AttrValue("game"))
+			require.Equal(t, games[i].AgreeWithClaim, l.AttrValue("agreement"))
+			require.Equal(t, games[i].Status, l.AttrValue("status"))
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		cfg := &config.Config{DisagreementSmoothing: config.DefaultDisagreementSmoothing}
+		registered := metrics.NewMetrics()
+		s := &Service{logger: logger, cl: clock.NewDeterministicClock(time.Unix(0, 0)), metrics: newMetricer(cfg, registered)}
+		require.Same(t, registered, s.metrics)
+		s.initForecast(cfg)
+
+		before := disputeMonSeries(t, registered)
+		s.forecast.Forecast(games, 0, 0)
+		require.NotEqual(t, before, disputeMonSeries(t, registered))
+		require.Nil(t, logs.FindLog(testlog.NewMessageFilter("Classified game")))
+	})
+}
+
+// disputeMonSeries returns the current state of every dispute-mon series in the registry.
+func disputeMonSeries(t *testing.T, m *metrics.Metrics) []string {
+	families, err := m.Registry().Gather()
+	require.NoError(t, err)
+	var series []string
+	for _, family := range families {
+		if strings.HasPrefix(family.GetName(), metrics.Namespace+"_") {
+			series = append(series, family.String())
+		}
+	}
+	return series
+}
+
+func TestResultSink(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		s := &Service{logger: testlog.Logger(t, log.LvlInfo)}
+		require.Nil(t, s.resultSink(&config.Config{}))
+	})
+
+	t.Run("RouteBySeverity", func(t *testing.T) {
+		logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+		s := &Service{logger: logger}
+		severity := func(result results.GameResult) results.Severity {
+			if result.Game == (common.Address{0xbb}) {
+				return results.SeverityCritical
+			}
+			return results.SeverityInfo
+		}
+		sink := s.resultSink(&config.Config{
+			ResultSeverity:      severity,
+			ResultLogSeverities: []results.Severity{results.SeverityCritical},
+		})
+		require.NotNil(t, sink)
+		sink.Publish([]results.GameResult{{Game: common.Address{0xaa}}, {Game: common.Address{0xbb}}})
+		logged := logs.FindLogs(testlog.NewMessageFilter("Game result"))
+		require.Len(t, logged, 1)
+		require.Equal(t, common.Address{0xbb}, logged[0].AttrValue("game"))
+	})
+
+	t.Run("PublishAllSeveritiesToKafka", func(t *testing.T) {
+		logger := testlog.Logger(t, log.LvlInfo)
+		producer := &stubProducer{}
+		s := &Service{logger: logger, metrics: metrics.NoopMetrics}
+		s.initKafkaSink(&config.Config{ResultProducer: producer, ResultTopic: "results"})
+		sink := s.resultSink(&config.Config{})
+		require.NotNil(t, sink)
+		sink.Publish([]results.GameResult{{Game: common.Address{0xaa}}, {Game: common.Address{0xbb}, Status: "Challenger Won"}})
+		s.kafkaSink.Close()
+		require.Equal(t, []string{common.Address{0xaa}.Hex(), common.Address{0xbb}.Hex()}, producer.keys)
+	})
+}
+
+type stubProducer struct {
+	keys []string
+}
+
+func (s *stubProducer) Produce(_ context.Context, topic string, key []byte, _ []byte) error {
+	s.keys = append(s.keys, string(key))
+	return nil
+}
@@ -0,0 +1,131 @@
+package status
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const writeTimeout = 10 * time.Second
+
+// Server publishes the latest Summary over a UNIX domain socket.
+// Each client connection is sent the current summary and then closed.
+// If a signing key is configured, a SignedSummary is sent instead.
+type Server struct {
+	logger   log.Logger
+	path     string
+	key      ed25519.PrivateKey
+	listener net.Listener
+
+	lock    sync.Mutex
+	summary Summary
+
+	wg sync.WaitGroup
+}
+
+// StartServer listens on a UNIX domain socket at path and begins serving summaries.
+// A stale socket left at path by a previous run is removed.
+// If key is not nil, each summary is signed with it.
+func StartServer(logger log.Logger, path string, key ed25519.PrivateKey) (*Server, error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on status socket: %w", err)
+	}
+	s := &Server{
+		logger:   logger,
+		path:     path,
+		key:      key,
+		listener: listener,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Update replaces the summary sent to clients.
+func (s *Server) Update(summary Summary) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.summary = summary
+}
+
+func (s *Server) latest() Summary {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.summary
+}
+
+func (s *Server) Addr() string {
+	return s.path
+}
+
+// Close stops accepting connections and waits for in-flight responses to complete.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			s.logger.Warn("Failed to accept status connection", "err", err)
+			continue
+		}
+		s.wg.Add(1)
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer s.wg.Done()
+	defer conn.Close()
+	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		s.logger.Warn("Failed to set status write deadline", "err", err)
+		return
+	}
+	if err := s.send(conn, s.latest()); err != nil {
+		s.logger.Warn("Failed to send status", "err", err)
+	}
+}
+
+func (s *Server) send(w io.Writer, summary Summary) error {
+	if s.key == nil {
+		return Encode(w, summary)
+	}
+	signed, err := Sign(s.key, summary)
+	if err != nil {
+		return err
+	}
+	return EncodeSigned(w, signed)
+}
+
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to check status socket: %w", err)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("status socket path %v exists and is not a socket", path)
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove stale status socket: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package status
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	t.Run("ServesLatestSummary", func(t *testing.T) {
+		server := startTestServer(t, socketPath(t))
+
+		require.Equal(t, Summary{}, readSummary(t, server.Addr()))
+
+		expected := Summary{Timestamp: 1234, Games: 5, InProgress: 3, Agree: 4, Disagree: 1, BeyondOutputRange: 1}
+		server.Update(expected)
+		require.Equal(t, expected, readSummary(t, server.Addr()))
+	})
+
+	t.Run("RemovesStaleSocket", func(t *testing.T) {
+		path := socketPath(t)
+		listener, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		// Leave the socket file behind as a crashed process would
+		listener.(*net.UnixListener).SetUnlinkOnClose(false)
+		require.NoError(t, listener.Close())
+
+		server := startTestServer(t, path)
+		require.Equal(t, Summary{}, readSummary(t, server.Addr()))
+	})
+
+	t.Run("RefuseToReplaceNonSocket", func(t *testing.T) {
+		path := socketPath(t)
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+		_, err := StartServer(testlog.Logger(t, log.LvlInfo), path, nil)
+		require.ErrorContains(t, err, "is not a socket")
+	})
+}
+
+func TestServer_Signed(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	server, err := StartServer(testlog.Logger(t, log.LvlInfo), socketPath(t), key)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, server.Close())
+	})
+	expected := Summary{Timestamp: 1234, Games: 5, InProgress: 3, Agree: 4, Disagree: 1, BeyondOutputRange: 1}
+	server.Update(expected)
+
+	conn, err := net.Dial("unix", server.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	signed, err := DecodeSigned(conn)
+	require.NoError(t, err)
+	actual, err := signed.Verify(key.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestSignedSummary(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	summary := Summary{Timestamp: 1234, Games: 5, InProgress: 3, Agree: 4, Disagree: 1}
+
+	t.Run("Valid", func(t *testing.T) {
+		signed, err := Sign(key, summary)
+		require.NoError(t, err)
+		require.True(t, ed25519.Verify(pub, signed.Payload, signed.Signature))
+		actual, err := signed.Verify(pub)
+		require.NoError(t, err)
+		require.Equal(t, summary, actual)
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		signed, err := Sign(key, summary)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, EncodeSigned(&buf, signed))
+		decoded, err := DecodeSigned(&buf)
+		require.NoError(t, err)
+		actual, err := decoded.Verify(pub)
+		require.NoError(t, err)
+		require.Equal(t, summary, actual)
+	})
+
+	t.Run("ModifiedPayload", func(t *testing.T) {
+		signed, err := Sign(key, summary)
+		require.NoError(t, err)
+		modified, err := Sign(key, Summary{Timestamp: 1234, Games: 5, InProgress: 3, Agree: 5})
+		require.NoError(t, err)
+		signed.Payload = modified.Payload
+		_, err = signed.Verify(pub)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("WrongKey", func(t *testing.T) {
+		otherPub, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		signed, err := Sign(key, summary)
+		require.NoError(t, err)
+		_, err = signed.Verify(otherPub)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		expected := Summary{Timestamp: 1234, Games: 5, InProgress: 3, Agree: 4, Disagree: 1}
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, expected))
+		actual, err := Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}))
+		require.ErrorIs(t, err, ErrSummaryTooLarge)
+	})
+}
+
+func socketPath(t *testing.T) string {
+	// Use a short path as unix socket paths are limited to around 100 characters
+	dir, err := os.MkdirTemp("", "status")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	return filepath.Join(dir, "status.sock")
+}
+
+func startTestServer(t *testing.T, path string) *Server {
+	server, err := StartServer(testlog.Logger(t, log.LvlInfo), path, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, server.Close())
+	})
+	return server
+}
+
+func readSummary(t *testing.T, path string) Summary {
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	summary, err := Decode(conn)
+	require.NoError(t, err)
+	return summary
+}
@@ -0,0 +1,137 @@
+package status
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MaxSummarySize is the maximum size of an encoded summary accepted by Decode.
+const MaxSummarySize = 1024 * 1024
+
+var (
+	ErrSummaryTooLarge  = errors.New("summary too large")
+	ErrInvalidSignature = errors.New("invalid summary signature")
+)
+
+// Summary is a compact overview of the games found in the latest monitoring cycle.
+type Summary struct {
+	// Timestamp is the unix time the summary was produced. Zero if no monitoring cycle has completed.
+	Timestamp uint64
+
+	Games             int
+	InProgress        int
+	Agree             int
+	Disagree          int
+	BeyondOutputRange int
+}
+
+// SignedSummary is an encoded Summary with an ed25519 signature over the encoding, so consumers can verify it was
+// produced by the holder of the signing key and has not been modified.
+type SignedSummary struct {
+	Payload   []byte
+	Signature []byte
+}
+
+// Sign encodes the summary and signs the encoding with key.
+func Sign(key ed25519.PrivateKey, summary Summary) (SignedSummary, error) {
+	payload, err := gobEncode(summary)
+	if err != nil {
+		return SignedSummary{}, fmt.Errorf("failed to encode summary: %w", err)
+	}
+	return SignedSummary{Payload: payload, Signature: ed25519.Sign(key, payload)}, nil
+}
+
+// Verify checks the summary was signed by the private key for key and returns the decoded summary.
+func (s SignedSummary) Verify(key ed25519.PublicKey) (Summary, error) {
+	if !ed25519.Verify(key, s.Payload, s.Signature) {
+		return Summary{}, ErrInvalidSignature
+	}
+	var summary Summary
+	if err := gob.NewDecoder(bytes.NewReader(s.Payload)).Decode(&summary); err != nil {
+		return Summary{}, fmt.Errorf("failed to decode summary: %w", err)
+	}
+	return summary, nil
+}
+
+// Encode writes the summary to w as a gob encoded payload prefixed by its length as a big endian uint32.
+func Encode(w io.Writer, summary Summary) error {
+	data, err := gobEncode(summary)
+	if err != nil {
+		return fmt.Errorf("failed to encode summary: %w", err)
+	}
+	return writeFrame(w, data)
+}
+
+// Decode reads a length prefixed summary written by Encode from r.
+func Decode(r io.Reader) (Summary, error) {
+	data, err := readFrame(r)
+	if err != nil {
+		return Summary{}, err
+	}
+	var summary Summary
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&summary); err != nil {
+		return Summary{}, fmt.Errorf("failed to decode summary: %w", err)
+	}
+	return summary, nil
+}
+
+// EncodeSigned writes the signed summary to w in the same length prefixed format as Encode.
+func EncodeSigned(w io.Writer, signed SignedSummary) error {
+	data, err := gobEncode(signed)
+	if err != nil {
+		return fmt.Errorf("failed to encode signed summary: %w", err)
+	}
+	return writeFrame(w, data)
+}
+
+// DecodeSigned reads a length prefixed signed summary written by EncodeSigned from r.
+// The signature is not checked, use SignedSummary.Verify to obtain the summary.
+func DecodeSigned(r io.Reader) (SignedSummary, error) {
+	data, err := readFrame(r)
+	if err != nil {
+		return SignedSummary{}, err
+	}
+	var signed SignedSummary
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&signed); err != nil {
+		return SignedSummary{}, fmt.Errorf("failed to decode signed summary: %w", err)
+	}
+	return signed, nil
+}
+
+func gobEncode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFrame(w io.Writer, data []byte) error {
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return fmt.Errorf("failed to write summary length: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+	return nil
+}
+
+func readFrame(r io.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, fmt.Errorf("failed to read summary length: %w", err)
+	}
+	if length > MaxSummarySize {
+		return nil, fmt.Errorf("%w: %v bytes", ErrSummaryTooLarge, length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("failed to read summary: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,45 @@
+package mon
+
+import (
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/status"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+)
+
+type SummaryPublisher interface {
+	Update(summary status.Summary)
+}
+
+// SummaryMonitor publishes a summary of the games found in each monitoring cycle.
+type SummaryMonitor struct {
+	clock     RClock
+	publisher SummaryPublisher
+}
+
+func NewSummaryMonitor(clock RClock, publisher SummaryPublisher) *SummaryMonitor {
+	return &SummaryMonitor{
+		clock:     clock,
+		publisher: publisher,
+	}
+}
+
+func (s *SummaryMonitor) CheckSummary(games []*types.EnrichedGameData) {
+	summary := status.Summary{
+		Timestamp: uint64(s.clock.Now().Unix()),
+		Games:     len(games),
+	}
+	for _, game := range games {
+		if game.Status == gameTypes.GameStatusInProgress {
+			summary.InProgress++
+		}
+		if game.AgreeWithClaim {
+			summary.Agree++
+		} else {
+			summary.Disagree++
+		}
+		if game.BeyondOutputRange {
+			summary.BeyondOutputRange++
+		}
+	}
+	s.publisher.Update(summary)
+}
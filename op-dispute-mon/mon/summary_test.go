@@ -0,0 +1,40 @@
+package mon
+
+import (
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/status"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryMonitor(t *testing.T) {
+	games := []*types.EnrichedGameData{
+		{Status: gameTypes.GameStatusInProgress, AgreeWithClaim: true},
+		{Status: gameTypes.GameStatusInProgress, AgreeWithClaim: false, BeyondOutputRange: true},
+		{Status: gameTypes.GameStatusDefenderWon, AgreeWithClaim: true},
+	}
+	publisher := &stubSummaryPublisher{}
+	cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+	monitor := NewSummaryMonitor(cl, publisher)
+	monitor.CheckSummary(games)
+	require.Equal(t, status.Summary{
+		Timestamp:         5000,
+		Games:             3,
+		InProgress:        2,
+		Agree:             2,
+		Disagree:          1,
+		BeyondOutputRange: 1,
+	}, publisher.summary)
+}
+
+type stubSummaryPublisher struct {
+	summary status.Summary
+}
+
+func (s *stubSummaryPublisher) Update(summary status.Summary) {
+	s.summary = summary
+}
@@ -0,0 +1,86 @@
+package mon
+
+import (
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// TransitionRecorder records classification transitions for audit.
+type TransitionRecorder interface {
+	AppendEvent(event any)
+}
+
+// Classifications a game's root claim agreement may transition between.
+const (
+	classificationAgree               = "agree"
+	classificationDisagree            = "disagree"
+	classificationPending             = "pending"
+	classificationIndeterminate       = "indeterminate"
+	classificationRollupInconsistency = "rollup_internal_inconsistency"
+)
+
+// Transition is a change in the classification of a game's root claim between monitoring cycles.
+type Transition struct {
+	Timestamp     uint64         `json:"timestamp"`
+	Game          common.Address `json:"game"`
+	L2BlockNumber uint64         `json:"l2BlockNumber"`
+	From          string         `json:"from"`
+	To            string         `json:"to"`
+}
+
+// TransitionMonitor records each change in a game's classification to provide an audit trail of
+// when the monitor's view of a game changed. Games seen for the first time are not reported.
+type TransitionMonitor struct {
+	logger   log.Logger
+	clock    RClock
+	recorder TransitionRecorder
+	previous map[common.Address]string
+}
+
+func NewTransitionMonitor(logger log.Logger, clock RClock, recorder TransitionRecorder) *TransitionMonitor {
+	return &TransitionMonitor{
+		logger:   logger,
+		clock:    clock,
+		recorder: recorder,
+		previous: make(map[common.Address]string),
+	}
+}
+
+func (m *TransitionMonitor) CheckTransitions(games []*types.EnrichedGameData) {
+	now := uint64(m.clock.Now().Unix())
+	current := make(map[common.Address]string, len(games))
+	for _, game := range games {
+		classification := classify(game)
+		current[game.Proxy] = classification
+		previous, ok := m.previous[game.Proxy]
+		if !ok || previous == classification {
+			continue
+		}
+		m.logger.Debug("Game classification transitioned", "game", game.Proxy, "from", previous, "to", classification)
+		m.recorder.AppendEvent(Transition{
+			Timestamp:     now,
+			Game:          game.Proxy,
+			L2BlockNumber: game.L2BlockNumber,
+			From:          previous,
+			To:            classification,
+		})
+	}
+	// Only the current cycle is retained so memory use remains bounded by the game window.
+	m.previous = current
+}
+
+func classify(game *types.EnrichedGameData) string {
+	switch {
+	case game.Pending:
+		return classificationPending
+	case game.Indeterminate:
+		return classificationIndeterminate
+	case game.RollupInternalInconsistency:
+		return classificationRollupInconsistency
+	case game.AgreeWithClaim:
+		return classificationAgree
+	default:
+		return classificationDisagree
+	}
+}
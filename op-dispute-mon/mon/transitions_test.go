@@ -0,0 +1,59 @@
+package mon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/metrics"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/history"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTransitions(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	cl := clock.NewDeterministicClock(time.Unix(5000, 0))
+	path := filepath.Join(t.TempDir(), "transitions.jsonl")
+	writer := history.NewWriter(logger, metrics.NoopMetrics, cl, path, history.Rotation{})
+	monitor := NewTransitionMonitor(logger, cl, writer)
+	game := func(proxy common.Address, agree bool) *types.EnrichedGameData {
+		return &types.EnrichedGameData{GameMetadata: gameTypes.GameMetadata{Proxy: proxy}, L2BlockNumber: 42, AgreeWithClaim: agree}
+	}
+
+	monitor.CheckTransitions([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, true),
+		game(common.Address{0xbb}, true),
+	})
+	cl.AdvanceTime(30 * time.Second)
+	pending := game(common.Address{0xcc}, false)
+	pending.Pending = true
+	monitor.CheckTransitions([]*types.EnrichedGameData{
+		game(common.Address{0xaa}, false), // Transitioned
+		game(common.Address{0xbb}, true),  // Unchanged
+		pending,                           // Added
+	})
+	require.NoError(t, writer.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	var transition Transition
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &transition))
+	require.Equal(t, Transition{
+		Timestamp:     5030,
+		Game:          common.Address{0xaa},
+		L2BlockNumber: 42,
+		From:          classificationAgree,
+		To:            classificationDisagree,
+	}, transition)
+}
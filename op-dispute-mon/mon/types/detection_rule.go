@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+// DetectionRule is a custom classification of games, allowing bespoke rules to be added without modifying the
+// standard monitors.
+type DetectionRule interface {
+	// Name identifies the rule in metrics and logs.
+	Name() string
+
+	// Evaluate classifies game, returning the bucket it belongs to and whether it matched the rule.
+	// meta is the metadata of the game as listed by the dispute game factory.
+	Evaluate(ctx context.Context, game *EnrichedGameData, meta types.GameMetadata) (bucket string, matched bool, err error)
+}
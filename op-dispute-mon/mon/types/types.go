@@ -1,12 +1,14 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/contracts"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -16,8 +18,112 @@ type EnrichedClaim struct {
 	Resolved bool
 }
 
+// AgreementHead identifies the rollup node head that games are classified against.
+// Games disputing blocks newer than the head are pending rather than disagreed with.
+type AgreementHead string
+
+const (
+	AgreementHeadSafe      AgreementHead = "safe"
+	AgreementHeadFinalized AgreementHead = "finalized"
+)
+
+var AgreementHeads = []AgreementHead{AgreementHeadSafe, AgreementHeadFinalized}
+
+func (h AgreementHead) String() string {
+	return string(h)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (h *AgreementHead) Set(value string) error {
+	if !ValidAgreementHead(AgreementHead(value)) {
+		return fmt.Errorf("unknown agreement head: %q", value)
+	}
+	*h = AgreementHead(value)
+	return nil
+}
+
+func (h *AgreementHead) Clone() any {
+	cpy := *h
+	return &cpy
+}
+
+func ValidAgreementHead(value AgreementHead) bool {
+	for _, h := range AgreementHeads {
+		if h == value {
+			return true
+		}
+	}
+	return false
+}
+
+// GameGrouping identifies the key games are grouped by when reporting grouped agreement counts.
+type GameGrouping string
+
+const (
+	GameGroupingNone     GameGrouping = "none"
+	GameGroupingFactory  GameGrouping = "factory"
+	GameGroupingGameType GameGrouping = "game-type"
+	GameGroupingProposer GameGrouping = "proposer"
+	GameGroupingL2Chain  GameGrouping = "l2-chain"
+)
+
+var GameGroupings = []GameGrouping{GameGroupingNone, GameGroupingFactory, GameGroupingGameType, GameGroupingProposer, GameGroupingL2Chain}
+
+func (g GameGrouping) String() string {
+	return string(g)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (g *GameGrouping) Set(value string) error {
+	if !ValidGameGrouping(GameGrouping(value)) {
+		return fmt.Errorf("unknown game grouping: %q", value)
+	}
+	*g = GameGrouping(value)
+	return nil
+}
+
+func (g *GameGrouping) Clone() any {
+	cpy := *g
+	return &cpy
+}
+
+func ValidGameGrouping(value GameGrouping) bool {
+	for _, g := range GameGroupings {
+		if g == value {
+			return true
+		}
+	}
+	return false
+}
+
+// GameStatusName is the name of a game status, used to configure the statuses of games to monitor.
+type GameStatusName string
+
+const (
+	GameStatusNameInProgress    GameStatusName = "in-progress"
+	GameStatusNameChallengerWon GameStatusName = "challenger-won"
+	GameStatusNameDefenderWon   GameStatusName = "defender-won"
+)
+
+var GameStatusNames = []GameStatusName{GameStatusNameInProgress, GameStatusNameChallengerWon, GameStatusNameDefenderWon}
+
+// GameStatus returns the game status with the name. Returns false if the name is unknown.
+func (n GameStatusName) GameStatus() (types.GameStatus, bool) {
+	switch n {
+	case GameStatusNameInProgress:
+		return types.GameStatusInProgress, true
+	case GameStatusNameChallengerWon:
+		return types.GameStatusChallengerWon, true
+	case GameStatusNameDefenderWon:
+		return types.GameStatusDefenderWon, true
+	default:
+		return 0, false
+	}
+}
+
 type EnrichedGameData struct {
 	types.GameMetadata
+	Factory               common.Address
 	L1Head                common.Hash
 	L1HeadNum             uint64
 	L2BlockNumber         uint64
@@ -31,6 +137,72 @@ type EnrichedGameData struct {
 	AgreeWithClaim    bool
 	ExpectedRootClaim common.Hash
 
+	// L2ChainID is the chain ID of the L2 chain the game disputes, as configured for its factory.
+	// Zero if the game disputes the chain of the primary rollup node.
+	L2ChainID uint64
+
+	// L1ChainID is the chain ID of the L1 chain the game's factory is deployed on.
+	L1ChainID uint64
+
+	// BeyondOutputRange is true if the game disputes a block beyond the latest block the rollup node has an output for.
+	BeyondOutputRange bool
+
+	// AgreeingClaims and DisagreeingClaims count the output root claims in an in-progress game
+	// that agree and disagree with the rollup node respectively.
+	AgreeingClaims    int
+	DisagreeingClaims int
+
+	// Indeterminate is true if the rollup node returned an empty output for the disputed block,
+	// so agreement with the root claim can't be determined.
+	Indeterminate bool
+
+	// RollupInternalInconsistency is true if the rollup node reported an output root inconsistent with the block
+	// hash, state root and withdrawal storage root it reported alongside it, so its output can't be trusted.
+	// Agreement with the root claim is not classified for these games.
+	RollupInternalInconsistency bool
+
+	// ImplausibleBlock is true if the game disputes block 0 or a block beyond the plausible range.
+	// Agreement with the root claim is not classified for these games.
+	ImplausibleBlock bool
+
+	// CrossChainRoot is true if the root claim disagrees with the game's own chain but matches the output of the
+	// chain with CrossChainRootChainID at the same block.
+	CrossChainRoot        bool
+	CrossChainRootChainID uint64
+
+	// ArchiveFallback is true if the disputed block's state was pruned by the rollup node
+	// and its output was fetched from the archive rollup node instead.
+	ArchiveFallback bool
+
+	// L2BlockTimestamp is the timestamp of the disputed L2 block as reported by the rollup node.
+	// Zero if the rollup node does not have the block.
+	L2BlockTimestamp uint64
+
+	// RollupSafeHead is the rollup node's safe head at the time the game's output root was checked.
+	RollupSafeHead eth.L2BlockRef
+
+	// Pending is true if the game disagrees with an output for a block newer than the agreement head.
+	// The output may still change so the game can't be classified yet.
+	Pending bool
+
+	// OurTurn is true if the game is in progress and an opponent made the most recent move against the honest actors.
+	OurTurn bool
+
+	// ResolvedAt is the time a resolved game was resolved. Zero if the game is in progress.
+	ResolvedAt time.Time
+
+	// Moves and Steps count the moves and steps made in an in-progress game.
+	Moves int
+	Steps int
+
+	// NextMoveBond is the bond required for the next move in an in-progress game.
+	// nil if the game is resolved or the next move is a step, which doesn't require a bond.
+	NextMoveBond *big.Int
+
+	// MaxGameDepth is the game's configured max depth and DeepestClaim is the depth of its deepest claim.
+	MaxGameDepth faultTypes.Depth
+	DeepestClaim faultTypes.Depth
+
 	// Recipients maps addresses to true if they are a bond recipient in the game.
 	Recipients map[common.Address]bool
 
@@ -53,6 +225,27 @@ type EnrichedGameData struct {
 	ETHCollateral *big.Int
 }
 
+// TotalBond returns the sum of the bonds posted on all claims in the game.
+func (g *EnrichedGameData) TotalBond() *big.Int {
+	total := new(big.Int)
+	for _, claim := range g.Claims {
+		if claim.Bond != nil {
+			total.Add(total, claim.Bond)
+		}
+	}
+	return total
+}
+
+// Age returns the number of seconds since the game was created.
+// Zero is returned for games with a creation timestamp after now so clock skew can't cause an underflow.
+func (g *EnrichedGameData) Age(now time.Time) uint64 {
+	nowSecs := uint64(now.Unix())
+	if g.Timestamp > nowSecs {
+		return 0
+	}
+	return nowSecs - g.Timestamp
+}
+
 // BidirectionalTree is a tree of claims represented as a flat list of claims.
 // This keeps the tree structure identical to how claims are stored in the contract.
 type BidirectionalTree struct {
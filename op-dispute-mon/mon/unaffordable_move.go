@@ -0,0 +1,39 @@
+package mon
+
+import (
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+type UnaffordableMoveMetrics interface {
+	RecordUnaffordableMoveGames(count int)
+}
+
+// UnaffordableMoveMonitor reports in-progress games where the bond required for the next move is more than the
+// honest actors can afford, so the game may be lost because it can't be countered.
+type UnaffordableMoveMonitor struct {
+	logger    log.Logger
+	metrics   UnaffordableMoveMetrics
+	threshold *big.Int
+}
+
+func NewUnaffordableMoveMonitor(logger log.Logger, metrics UnaffordableMoveMetrics, threshold *big.Int) *UnaffordableMoveMonitor {
+	return &UnaffordableMoveMonitor{
+		logger:    logger,
+		metrics:   metrics,
+		threshold: threshold,
+	}
+}
+
+func (m *UnaffordableMoveMonitor) CheckUnaffordableMoves(games []*types.EnrichedGameData) {
+	count := 0
+	for _, game := range games {
+		if game.NextMoveBond != nil && game.NextMoveBond.Cmp(m.threshold) > 0 {
+			m.logger.Warn("Next move in game requires an unaffordable bond", "game", game.Proxy, "bond", game.NextMoveBond, "threshold", m.threshold)
+			count++
+		}
+	}
+	m.metrics.RecordUnaffordableMoveGames(count)
+}
@@ -0,0 +1,40 @@
+package mon
+
+import (
+	"math/big"
+	"testing"
+
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-dispute-mon/mon/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUnaffordableMoves(t *testing.T) {
+	logger, logs := testlog.CaptureLogger(t, log.LvlInfo)
+	metrics := &stubUnaffordableMoveMetrics{}
+	monitor := NewUnaffordableMoveMonitor(logger, metrics, big.NewInt(1000))
+	monitor.CheckUnaffordableMoves([]*types.EnrichedGameData{
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xaa}}, NextMoveBond: big.NewInt(999)},  // Just under the threshold
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xbb}}, NextMoveBond: big.NewInt(1000)}, // At the threshold
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xcc}}, NextMoveBond: big.NewInt(1001)}, // Just over the threshold
+		{GameMetadata: gameTypes.GameMetadata{Proxy: common.Address{0xdd}}},                                 // No bond required
+	})
+	require.Equal(t, 1, metrics.count)
+	l := logs.FindLog(testlog.NewLevelFilter(log.LevelWarn), testlog.NewMessageFilter("Next move in game requires an unaffordable bond"))
+	require.NotNil(t, l)
+	require.Equal(t, common.Address{0xcc}, l.AttrValue("game"))
+
+	monitor.CheckUnaffordableMoves(nil)
+	require.Zero(t, metrics.count)
+}
+
+type stubUnaffordableMoveMetrics struct {
+	count int
+}
+
+func (s *stubUnaffordableMoveMetrics) RecordUnaffordableMoveGames(count int) {
+	s.count = count
+}
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"context"
+	"io"
 
 	"github.com/ethereum/go-ethereum/log"
 
@@ -16,3 +17,11 @@ func Main(ctx context.Context, logger log.Logger, cfg *config.Config) (cliapp.Li
 	}
 	return mon.NewService(ctx, logger, cfg)
 }
+
+// ExportGames loads the current games once and writes them and their classification to w as CSV.
+func ExportGames(ctx context.Context, logger log.Logger, cfg *config.Config, w io.Writer) error {
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	return mon.ExportGames(ctx, logger, cfg, w)
+}
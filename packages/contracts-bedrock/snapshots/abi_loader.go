@@ -28,6 +28,9 @@ var systemConfig []byte
 //go:embed abi/CrossL2Inbox.json
 var crossL2Inbox []byte
 
+//go:embed abi/AnchorStateRegistry.json
+var anchorStateRegistry []byte
+
 func LoadDisputeGameFactoryABI() *abi.ABI {
 	return loadABI(disputeGameFactory)
 }
@@ -52,6 +55,10 @@ func LoadCrossL2InboxABI() *abi.ABI {
 	return loadABI(crossL2Inbox)
 }
 
+func LoadAnchorStateRegistryABI() *abi.ABI {
+	return loadABI(anchorStateRegistry)
+}
+
 func loadABI(json []byte) *abi.ABI {
 	if parsed, err := abi.JSON(bytes.NewReader(json)); err != nil {
 		panic(err)